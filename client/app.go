@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/url"
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
@@ -9,6 +11,9 @@ import (
 	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
 )
 
+// ErrAppInstanceCrashed is returned when waiting for an app to run and one of its instances crashes
+var ErrAppInstanceCrashed = errors.New("app instance crashed")
+
 type AppClient commonClient
 
 // AppListOptions list filters
@@ -39,7 +44,7 @@ func (o AppListOptions) ToQueryString() (url.Values, error) {
 // Create a new app
 func (c *AppClient) Create(ctx context.Context, r *resource.AppCreate) (*resource.App, error) {
 	var app resource.App
-	_, err := c.client.post(ctx, "/v3/apps", r, &app)
+	_, err := c.client.post(ctx, "Applications.Create", "/v3/apps", r, &app)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +53,7 @@ func (c *AppClient) Create(ctx context.Context, r *resource.AppCreate) (*resourc
 
 // Delete the specified app asynchronously and return a jobGUID.
 func (c *AppClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/apps/%s", guid))
+	return c.client.delete(ctx, "Applications.Delete", path.Format("/v3/apps/%s", guid))
 }
 
 // First returns the first app matching the options or an error when less than 1 match
@@ -61,7 +66,7 @@ func (c *AppClient) First(ctx context.Context, opts *AppListOptions) (*resource.
 // Get the specified app
 func (c *AppClient) Get(ctx context.Context, guid string) (*resource.App, error) {
 	var app resource.App
-	err := c.client.get(ctx, path.Format("/v3/apps/%s", guid), &app)
+	err := c.client.get(ctx, "Applications.Get", path.Format("/v3/apps/%s", guid), &app)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +76,7 @@ func (c *AppClient) Get(ctx context.Context, guid string) (*resource.App, error)
 // GetIncludeSpace allows callers to fetch an app and include the parent space
 func (c *AppClient) GetIncludeSpace(ctx context.Context, guid string) (*resource.App, *resource.Space, error) {
 	var app resource.AppWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/apps/%s?include=%s", guid, resource.AppIncludeSpace), &app)
+	err := c.client.get(ctx, "Applications.GetIncludeSpace", path.Format("/v3/apps/%s?include=%s", guid, resource.AppIncludeSpace), &app)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,7 +86,7 @@ func (c *AppClient) GetIncludeSpace(ctx context.Context, guid string) (*resource
 // GetIncludeSpaceAndOrganization allows callers to fetch an app and include the parent space and organizations
 func (c *AppClient) GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.App, *resource.Space, *resource.Organization, error) {
 	var app resource.AppWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/apps/%s?include=%s", guid, resource.AppIncludeSpaceOrganization), &app)
+	err := c.client.get(ctx, "Applications.GetIncludeSpaceAndOrganization", path.Format("/v3/apps/%s?include=%s", guid, resource.AppIncludeSpaceOrganization), &app)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -92,7 +97,7 @@ func (c *AppClient) GetIncludeSpaceAndOrganization(ctx context.Context, guid str
 // It will include environment variables for Environment Variable Groups and Service Bindings.
 func (c *AppClient) GetEnvironment(ctx context.Context, guid string) (*resource.AppEnvironment, error) {
 	var appEnv resource.AppEnvironment
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/env", guid), &appEnv)
+	err := c.client.get(ctx, "Applications.GetEnvironment", path.Format("/v3/apps/%s/env", guid), &appEnv)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +107,7 @@ func (c *AppClient) GetEnvironment(ctx context.Context, guid string) (*resource.
 // GetEnvironmentVariables retrieves the environment variables that are associated with the given app
 func (c *AppClient) GetEnvironmentVariables(ctx context.Context, guid string) (map[string]*string, error) {
 	var appEnv resource.EnvVarResponse
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/environment_variables", guid), &appEnv)
+	err := c.client.get(ctx, "Applications.GetEnvironmentVariables", path.Format("/v3/apps/%s/environment_variables", guid), &appEnv)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +122,7 @@ func (c *AppClient) List(ctx context.Context, opts *AppListOptions) ([]*resource
 	opts.Include = resource.AppIncludeNone
 
 	var res resource.AppList
-	err := c.client.list(ctx, "/v3/apps", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Applications.List", "/v3/apps", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -130,7 +135,17 @@ func (c *AppClient) ListAll(ctx context.Context, opts *AppListOptions) ([]*resou
 	if opts == nil {
 		opts = NewAppListOptions()
 	}
-	return AutoPage[*AppListOptions, *resource.App](opts, func(opts *AppListOptions) ([]*resource.App, *Pager, error) {
+	return AutoPageConcurrent[*AppListOptions, *resource.App](opts, c.client.PageConcurrency(), func(opts *AppListOptions) ([]*resource.App, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all apps the user has access to
+func (c *AppClient) Iter(ctx context.Context, opts *AppListOptions) Seq2[*resource.App, error] {
+	if opts == nil {
+		opts = NewAppListOptions()
+	}
+	return AutoPageIter[*AppListOptions, *resource.App](ctx, opts, func(opts *AppListOptions) ([]*resource.App, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -143,7 +158,7 @@ func (c *AppClient) ListIncludeSpaces(ctx context.Context, opts *AppListOptions)
 	opts.Include = resource.AppIncludeSpace
 
 	var res resource.AppList
-	err := c.client.list(ctx, "/v3/apps", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Applications.ListIncludeSpaces", "/v3/apps", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -152,6 +167,9 @@ func (c *AppClient) ListIncludeSpaces(ctx context.Context, opts *AppListOptions)
 }
 
 // ListIncludeSpacesAll retrieves all apps the user has access to and include the associated spaces
+//
+// There's no iterator over the resources with their included resources, use ListIncludeSpaces to fetch them a page at
+// a time instead.
 func (c *AppClient) ListIncludeSpacesAll(ctx context.Context, opts *AppListOptions) ([]*resource.App, []*resource.Space, error) {
 	if opts == nil {
 		opts = NewAppListOptions()
@@ -182,7 +200,7 @@ func (c *AppClient) ListIncludeSpacesAndOrganizations(ctx context.Context, opts
 	opts.Include = resource.AppIncludeSpaceOrganization
 
 	var res resource.AppList
-	err := c.client.list(ctx, "/v3/apps", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Applications.ListIncludeSpacesAndOrganizations", "/v3/apps", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -191,6 +209,9 @@ func (c *AppClient) ListIncludeSpacesAndOrganizations(ctx context.Context, opts
 }
 
 // ListIncludeSpacesAndOrganizationsAll retrieves all apps the user has access to and include the associated spaces and organizations
+//
+// There's no iterator over the resources with their included resources, use ListIncludeSpacesAndOrganizations to fetch them a page at
+// a time instead.
 func (c *AppClient) ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *AppListOptions) ([]*resource.App, []*resource.Space, []*resource.Organization, error) {
 	if opts == nil {
 		opts = NewAppListOptions()
@@ -220,7 +241,7 @@ func (c *AppClient) ListIncludeSpacesAndOrganizationsAll(ctx context.Context, op
 // Only admin, read-only admins, and space developers can read sensitive data.
 func (c *AppClient) Permissions(ctx context.Context, guid string) (*resource.AppPermissions, error) {
 	var appPerms resource.AppPermissions
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/permissions", guid), &appPerms)
+	err := c.client.get(ctx, "Applications.Permissions", path.Format("/v3/apps/%s/permissions", guid), &appPerms)
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +253,7 @@ func (c *AppClient) Permissions(ctx context.Context, guid string) (*resource.App
 // For restarting applications without downtime, see the Deployments resource.
 func (c *AppClient) Restart(ctx context.Context, guid string) (*resource.App, error) {
 	var app resource.App
-	_, err := c.client.post(ctx, path.Format("/v3/apps/%s/actions/restart", guid), nil, &app)
+	_, err := c.client.post(ctx, "Applications.Restart", path.Format("/v3/apps/%s/actions/restart", guid), nil, &app)
 	if err != nil {
 		return nil, err
 	}
@@ -250,7 +271,7 @@ func (c *AppClient) SetEnvironmentVariables(ctx context.Context, guid string, en
 		Var: envRequest,
 	}
 	var res resource.EnvVarResponse
-	_, err := c.client.patch(ctx, path.Format("/v3/apps/%s/environment_variables", guid), req, &res)
+	_, err := c.client.patch(ctx, "Applications.SetEnvironmentVariables", path.Format("/v3/apps/%s/environment_variables", guid), req, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +288,7 @@ func (c *AppClient) Single(ctx context.Context, opts *AppListOptions) (*resource
 // Start the app if not already started
 func (c *AppClient) Start(ctx context.Context, guid string) (*resource.App, error) {
 	var app resource.App
-	_, err := c.client.post(ctx, path.Format("/v3/apps/%s/actions/start", guid), nil, &app)
+	_, err := c.client.post(ctx, "Applications.Start", path.Format("/v3/apps/%s/actions/start", guid), nil, &app)
 	if err != nil {
 		return nil, err
 	}
@@ -277,7 +298,7 @@ func (c *AppClient) Start(ctx context.Context, guid string) (*resource.App, erro
 // Stop the app if not already stopped
 func (c *AppClient) Stop(ctx context.Context, guid string) (*resource.App, error) {
 	var app resource.App
-	_, err := c.client.post(ctx, path.Format("/v3/apps/%s/actions/stop", guid), nil, &app)
+	_, err := c.client.post(ctx, "Applications.Stop", path.Format("/v3/apps/%s/actions/stop", guid), nil, &app)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +308,7 @@ func (c *AppClient) Stop(ctx context.Context, guid string) (*resource.App, error
 // Update the specified attributes of the app
 func (c *AppClient) Update(ctx context.Context, guid string, r *resource.AppUpdate) (*resource.App, error) {
 	var app resource.App
-	_, err := c.client.patch(ctx, path.Format("/v3/apps/%s", guid), r, &app)
+	_, err := c.client.patch(ctx, "Applications.Update", path.Format("/v3/apps/%s", guid), r, &app)
 	if err != nil {
 		return nil, err
 	}
@@ -299,9 +320,51 @@ func (c *AppClient) Update(ctx context.Context, guid string, r *resource.AppUpda
 // at the space level, or at the app level.
 func (c *AppClient) SSHEnabled(ctx context.Context, guid string) (*resource.AppSSHEnabled, error) {
 	var appSSH resource.AppSSHEnabled
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/ssh_enabled", guid), &appSSH)
+	err := c.client.get(ctx, "Applications.SSHEnabled", path.Format("/v3/apps/%s/ssh_enabled", guid), &appSSH)
 	if err != nil {
 		return nil, err
 	}
 	return &appSSH, nil
 }
+
+// WaitForRunning waits until the desired number of instances of each of the app's processes are RUNNING. An app
+// is STARTED as soon as it's started, not once its instances are healthy, so use this after starting an app to know
+// it's actually up. If any instance crashes an error wrapping ErrAppInstanceCrashed is returned straight away with
+// the instance's details.
+func (c *AppClient) WaitForRunning(ctx context.Context, guid string, opts *PollingOptions) error {
+	processes, err := c.client.Processes.ListForAppAll(ctx, guid, nil)
+	if err != nil {
+		return err
+	}
+	return PollForStateOrTimeout(func() (string, error) {
+		for _, process := range processes {
+			if process.Instances == 0 {
+				continue
+			}
+			stats, err := c.client.Processes.GetStatsForApp(ctx, guid, process.Type)
+			if err != nil {
+				return "", err
+			}
+			running := 0
+			for _, stat := range stats.Stats {
+				switch stat.State {
+				case "RUNNING":
+					running++
+				case "CRASHED":
+					return "", appInstanceCrashedError(guid, stat)
+				}
+			}
+			if running < process.Instances {
+				return "STARTING", nil
+			}
+		}
+		return "RUNNING", nil
+	}, "RUNNING", opts)
+}
+
+func appInstanceCrashedError(guid string, stat resource.ProcessStat) error {
+	if stat.Details != nil && *stat.Details != "" {
+		return fmt.Errorf("%w, app %s %s instance %d: %s", ErrAppInstanceCrashed, guid, stat.Type, stat.Index, *stat.Details)
+	}
+	return fmt.Errorf("%w, app %s %s instance %d", ErrAppInstanceCrashed, guid, stat.Type, stat.Index)
+}
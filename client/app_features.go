@@ -11,7 +11,7 @@ type AppFeatureClient commonClient
 // Get retrieves the named app feature
 func (c *AppFeatureClient) Get(ctx context.Context, appGUID, featureName string) (*resource.AppFeature, error) {
 	var a resource.AppFeature
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/features/%s", appGUID, featureName), &a)
+	err := c.client.get(ctx, "AppFeatures.Get", path.Format("/v3/apps/%s/features/%s", appGUID, featureName), &a)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +31,7 @@ func (c *AppFeatureClient) GetRevisions(ctx context.Context, appGUID string) (*r
 // List pages all app features
 func (c *AppFeatureClient) List(ctx context.Context, appGUID string) ([]*resource.AppFeature, *Pager, error) {
 	var res resource.AppFeatureList
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/features", appGUID), &res)
+	err := c.client.get(ctx, "AppFeatures.List", path.Format("/v3/apps/%s/features", appGUID), &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -45,7 +45,7 @@ func (c *AppFeatureClient) Update(ctx context.Context, appGUID, featureName stri
 		Enabled: enabled,
 	}
 	var a resource.AppFeature
-	_, err := c.client.patch(ctx, path.Format("/v3/apps/%s/features/%s", appGUID, featureName), r, &a)
+	_, err := c.client.patch(ctx, "AppFeatures.Update", path.Format("/v3/apps/%s/features/%s", appGUID, featureName), r, &a)
 	if err != nil {
 		return nil, err
 	}
@@ -2,11 +2,15 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
 	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
 	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
 	"github.com/stretchr/testify/require"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestApps(t *testing.T) {
@@ -181,6 +185,28 @@ func TestApps(t *testing.T) {
 				return c.Applications.ListAll(context.Background(), nil)
 			},
 		},
+		{
+			Description: "Iterate all apps",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/v3/apps",
+				Output:   g.Paged([]string{app1, app2}, []string{app3, app4}),
+				Status:   http.StatusOK},
+			Expected: g.Array(app1, app2, app3, app4),
+			Action: func(c *Client, t *testing.T) (any, error) {
+				var apps []*resource.App
+				var err error
+				c.Applications.Iter(context.Background(), nil)(func(app *resource.App, iterErr error) bool {
+					if iterErr != nil {
+						err = iterErr
+						return false
+					}
+					apps = append(apps, app)
+					return true
+				})
+				return apps, err
+			},
+		},
 		{
 			Description: "List all apps include spaces",
 			Route: testutil.MockRoute{
@@ -322,3 +348,58 @@ func TestApps(t *testing.T) {
 	}
 	ExecuteTests(tests, t)
 }
+
+func TestAppWaitForRunning(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(20)
+	web := strings.Replace(g.Process().JSON, `"instances": 5`, `"instances": 2`, 1)
+	worker := strings.Replace(strings.Replace(g.Process().JSON, `"instances": 5`, `"instances": 0`, 1), `"type": "web"`, `"type": "worker"`, 1)
+	stats := func(states ...string) string {
+		var instances []string
+		for i, state := range states {
+			details := "null"
+			if state == "CRASHED" {
+				details = `"APP/PROC/WEB: Exited with status 1"`
+			}
+			instances = append(instances, fmt.Sprintf(`{"type":"web","index":%d,"state":"%s","details":%s}`, i, state, details))
+		}
+		return `{"resources":[` + strings.Join(instances, ",") + `]}`
+	}
+	pollingOpts := &PollingOptions{Timeout: 5 * time.Second, CheckInterval: time.Millisecond}
+
+	setup := func(statsOutput ...string) *Client {
+		serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+			{
+				Method:   "GET",
+				Endpoint: "/v3/apps/1cb006ee-fb05-47e1-b541-c34179ddc446/processes",
+				Output:   g.Paged([]string{web, worker}),
+				Status:   http.StatusOK,
+			},
+			{
+				Method:   "GET",
+				Endpoint: "/v3/apps/1cb006ee-fb05-47e1-b541-c34179ddc446/processes/web/stats",
+				Output:   statsOutput,
+				Status:   http.StatusOK,
+			},
+		}, t)
+		cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+		require.NoError(t, err)
+		c, err := New(cfg)
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("running", func(t *testing.T) {
+		c := setup(stats("STARTING", "DOWN"), stats("RUNNING", "STARTING"), stats("RUNNING", "RUNNING"))
+		defer testutil.Teardown()
+		err := c.Applications.WaitForRunning(context.Background(), "1cb006ee-fb05-47e1-b541-c34179ddc446", pollingOpts)
+		require.NoError(t, err)
+	})
+
+	t.Run("crashed", func(t *testing.T) {
+		c := setup(stats("STARTING", "STARTING"), stats("RUNNING", "CRASHED"))
+		defer testutil.Teardown()
+		err := c.Applications.WaitForRunning(context.Background(), "1cb006ee-fb05-47e1-b541-c34179ddc446", pollingOpts)
+		require.ErrorIs(t, err, ErrAppInstanceCrashed)
+		require.ErrorContains(t, err, "web instance 1: APP/PROC/WEB: Exited with status 1")
+	})
+}
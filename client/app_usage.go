@@ -29,7 +29,7 @@ func (o AppUsageListOptions) ToQueryString() (url.Values, error) {
 // Get retrieves the specified app event
 func (c *AppUsageClient) Get(ctx context.Context, guid string) (*resource.AppUsage, error) {
 	var a resource.AppUsage
-	err := c.client.get(ctx, path.Format("/v3/app_usage_events/%s", guid), &a)
+	err := c.client.get(ctx, "AppUsageEvents.Get", path.Format("/v3/app_usage_events/%s", guid), &a)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (c *AppUsageClient) List(ctx context.Context, opts *AppUsageListOptions) ([
 		opts = NewAppUsageOptions()
 	}
 	var res resource.AppUsageList
-	err := c.client.list(ctx, "/v3/app_usage_events", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "AppUsageEvents.List", "/v3/app_usage_events", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,7 +55,17 @@ func (c *AppUsageClient) ListAll(ctx context.Context, opts *AppUsageListOptions)
 	if opts == nil {
 		opts = NewAppUsageOptions()
 	}
-	return AutoPage[*AppUsageListOptions, *resource.AppUsage](opts, func(opts *AppUsageListOptions) ([]*resource.AppUsage, *Pager, error) {
+	return AutoPageConcurrent[*AppUsageListOptions, *resource.AppUsage](opts, c.client.PageConcurrency(), func(opts *AppUsageListOptions) ([]*resource.AppUsage, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all app usage events
+func (c *AppUsageClient) Iter(ctx context.Context, opts *AppUsageListOptions) Seq2[*resource.AppUsage, error] {
+	if opts == nil {
+		opts = NewAppUsageOptions()
+	}
+	return AutoPageIter[*AppUsageListOptions, *resource.AppUsage](ctx, opts, func(opts *AppUsageListOptions) ([]*resource.AppUsage, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -66,6 +76,6 @@ func (c *AppUsageClient) ListAll(ctx context.Context, opts *AppUsageListOptions)
 // There is the potential race condition if apps are currently being started, stopped, or scaled.
 // The seeded usage events will have the same guid as the app.
 func (c *AppUsageClient) Purge(ctx context.Context) error {
-	_, err := c.client.post(ctx, "/v3/app_usage_events/actions/destructively_purge_all_and_reseed", nil, nil)
+	_, err := c.client.post(ctx, "AppUsageEvents.Purge", "/v3/app_usage_events/actions/destructively_purge_all_and_reseed", nil, nil)
 	return err
 }
@@ -41,7 +41,7 @@ func (c *AuditEventClient) First(ctx context.Context, opts *AuditEventListOption
 // Get retrieves the specified audit event
 func (c *AuditEventClient) Get(ctx context.Context, guid string) (*resource.AuditEvent, error) {
 	var a resource.AuditEvent
-	err := c.client.get(ctx, path.Format("/v3/audit_events/%s", guid), &a)
+	err := c.client.get(ctx, "AuditEvents.Get", path.Format("/v3/audit_events/%s", guid), &a)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ func (c *AuditEventClient) List(ctx context.Context, opts *AuditEventListOptions
 		opts = NewAuditEventListOptions()
 	}
 	var res resource.AuditEventList
-	err := c.client.list(ctx, "/v3/audit_events", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "AuditEvents.List", "/v3/audit_events", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,20 +67,19 @@ func (c *AuditEventClient) ListAll(ctx context.Context, opts *AuditEventListOpti
 	if opts == nil {
 		opts = NewAuditEventListOptions()
 	}
+	return AutoPageConcurrent[*AuditEventListOptions, *resource.AuditEvent](opts, c.client.PageConcurrency(), func(opts *AuditEventListOptions) ([]*resource.AuditEvent, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
 
-	var all []*resource.AuditEvent
-	for {
-		page, pager, err := c.List(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-		all = append(all, page...)
-		if !pager.HasNextPage() {
-			break
-		}
-		pager.NextPage(opts)
+// Iter returns an iterator over all audit events the user has access to
+func (c *AuditEventClient) Iter(ctx context.Context, opts *AuditEventListOptions) Seq2[*resource.AuditEvent, error] {
+	if opts == nil {
+		opts = NewAuditEventListOptions()
 	}
-	return all, nil
+	return AutoPageIter[*AuditEventListOptions, *resource.AuditEvent](ctx, opts, func(opts *AuditEventListOptions) ([]*resource.AuditEvent, *Pager, error) {
+		return c.List(ctx, opts)
+	})
 }
 
 // Single returns a single audit event matching the options or an error if not exactly 1 match
@@ -51,7 +51,7 @@ func (o BuildAppListOptions) ToQueryString() (url.Values, error) {
 // Create a new build
 func (c *BuildClient) Create(ctx context.Context, r *resource.BuildCreate) (*resource.Build, error) {
 	var build resource.Build
-	_, err := c.client.post(ctx, "/v3/builds", r, &build)
+	_, err := c.client.post(ctx, "Builds.Create", "/v3/builds", r, &build)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func (c *BuildClient) Create(ctx context.Context, r *resource.BuildCreate) (*res
 
 // Delete the specified build
 func (c *BuildClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/builds/%s", guid))
+	_, err := c.client.delete(ctx, "Builds.Delete", path.Format("/v3/builds/%s", guid))
 	return err
 }
 
@@ -81,7 +81,7 @@ func (c *BuildClient) FirstForApp(ctx context.Context, appGUID string, opts *Bui
 // Get the specified build
 func (c *BuildClient) Get(ctx context.Context, guid string) (*resource.Build, error) {
 	var build resource.Build
-	err := c.client.get(ctx, path.Format("/v3/builds/%s", guid), &build)
+	err := c.client.get(ctx, "Builds.Get", path.Format("/v3/builds/%s", guid), &build)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +94,7 @@ func (c *BuildClient) List(ctx context.Context, opts *BuildListOptions) ([]*reso
 		opts = NewBuildListOptions()
 	}
 	var res resource.BuildList
-	err := c.client.list(ctx, "/v3/builds", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Builds.List", "/v3/builds", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,7 +107,17 @@ func (c *BuildClient) ListAll(ctx context.Context, opts *BuildListOptions) ([]*r
 	if opts == nil {
 		opts = NewBuildListOptions()
 	}
-	return AutoPage[*BuildListOptions, *resource.Build](opts, func(opts *BuildListOptions) ([]*resource.Build, *Pager, error) {
+	return AutoPageConcurrent[*BuildListOptions, *resource.Build](opts, c.client.PageConcurrency(), func(opts *BuildListOptions) ([]*resource.Build, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all builds the user has access to
+func (c *BuildClient) Iter(ctx context.Context, opts *BuildListOptions) Seq2[*resource.Build, error] {
+	if opts == nil {
+		opts = NewBuildListOptions()
+	}
+	return AutoPageIter[*BuildListOptions, *resource.Build](ctx, opts, func(opts *BuildListOptions) ([]*resource.Build, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -118,7 +128,7 @@ func (c *BuildClient) ListForApp(ctx context.Context, appGUID string, opts *Buil
 		opts = NewBuildAppListOptions()
 	}
 	var res resource.BuildList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/builds", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Builds.ListForApp", "/v3/apps/"+appGUID+"/builds", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,7 +141,17 @@ func (c *BuildClient) ListForAppAll(ctx context.Context, appGUID string, opts *B
 	if opts == nil {
 		opts = NewBuildAppListOptions()
 	}
-	return AutoPage[*BuildAppListOptions, *resource.Build](opts, func(opts *BuildAppListOptions) ([]*resource.Build, *Pager, error) {
+	return AutoPageConcurrent[*BuildAppListOptions, *resource.Build](opts, c.client.PageConcurrency(), func(opts *BuildAppListOptions) ([]*resource.Build, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all builds for the app the user has access to
+func (c *BuildClient) IterForApp(ctx context.Context, appGUID string, opts *BuildAppListOptions) Seq2[*resource.Build, error] {
+	if opts == nil {
+		opts = NewBuildAppListOptions()
+	}
+	return AutoPageIter[*BuildAppListOptions, *resource.Build](ctx, opts, func(opts *BuildAppListOptions) ([]*resource.Build, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -164,7 +184,7 @@ func (c *BuildClient) SingleForApp(ctx context.Context, appGUID string, opts *Bu
 // Update the specified attributes of the build
 func (c *BuildClient) Update(ctx context.Context, guid string, r *resource.BuildUpdate) (*resource.Build, error) {
 	var build resource.Build
-	_, err := c.client.patch(ctx, path.Format("/v3/builds/%s", guid), r, &build)
+	_, err := c.client.patch(ctx, "Builds.Update", path.Format("/v3/builds/%s", guid), r, &build)
 	if err != nil {
 		return nil, err
 	}
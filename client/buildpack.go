@@ -33,7 +33,7 @@ func (o BuildpackListOptions) ToQueryString() (url.Values, error) {
 // Create a new buildpack
 func (c *BuildpackClient) Create(ctx context.Context, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error) {
 	var bp resource.Buildpack
-	_, err := c.client.post(ctx, "/v3/buildpacks", r, &bp)
+	_, err := c.client.post(ctx, "Buildpacks.Create", "/v3/buildpacks", r, &bp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (c *BuildpackClient) Create(ctx context.Context, r *resource.BuildpackCreat
 
 // Delete the specified buildpack
 func (c *BuildpackClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/buildpacks/%s", guid))
+	_, err := c.client.delete(ctx, "Buildpacks.Delete", path.Format("/v3/buildpacks/%s", guid))
 	return err
 }
 
@@ -56,7 +56,7 @@ func (c *BuildpackClient) First(ctx context.Context, opts *BuildpackListOptions)
 // Get retrieves the specified buildpack
 func (c *BuildpackClient) Get(ctx context.Context, guid string) (*resource.Buildpack, error) {
 	var bp resource.Buildpack
-	err := c.client.get(ctx, path.Format("/v3/buildpacks/%s", guid), &bp)
+	err := c.client.get(ctx, "Buildpacks.Get", path.Format("/v3/buildpacks/%s", guid), &bp)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func (c *BuildpackClient) List(ctx context.Context, opts *BuildpackListOptions)
 		opts = NewBuildpackListOptions()
 	}
 	var res resource.BuildpackList
-	err := c.client.list(ctx, "/v3/buildpacks", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Buildpacks.List", "/v3/buildpacks", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -82,20 +82,19 @@ func (c *BuildpackClient) ListAll(ctx context.Context, opts *BuildpackListOption
 	if opts == nil {
 		opts = NewBuildpackListOptions()
 	}
+	return AutoPageConcurrent[*BuildpackListOptions, *resource.Buildpack](opts, c.client.PageConcurrency(), func(opts *BuildpackListOptions) ([]*resource.Buildpack, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
 
-	var all []*resource.Buildpack
-	for {
-		page, pager, err := c.List(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-		all = append(all, page...)
-		if !pager.HasNextPage() {
-			break
-		}
-		pager.NextPage(opts)
+// Iter returns an iterator over all buildpacks the user has access to
+func (c *BuildpackClient) Iter(ctx context.Context, opts *BuildpackListOptions) Seq2[*resource.Buildpack, error] {
+	if opts == nil {
+		opts = NewBuildpackListOptions()
 	}
-	return all, nil
+	return AutoPageIter[*BuildpackListOptions, *resource.Buildpack](ctx, opts, func(opts *BuildpackListOptions) ([]*resource.Buildpack, *Pager, error) {
+		return c.List(ctx, opts)
+	})
 }
 
 // Single returns a single buildpack matching the options or an error if not exactly 1 match
@@ -108,7 +107,7 @@ func (c *BuildpackClient) Single(ctx context.Context, opts *BuildpackListOptions
 // Update the specified attributes of the buildpack
 func (c *BuildpackClient) Update(ctx context.Context, guid string, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error) {
 	var bp resource.Buildpack
-	_, err := c.client.patch(ctx, path.Format("/v3/buildpacks/%s", guid), r, &bp)
+	_, err := c.client.patch(ctx, "Buildpacks.Update", path.Format("/v3/buildpacks/%s", guid), r, &bp)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +118,7 @@ func (c *BuildpackClient) Update(ctx context.Context, guid string, r *resource.B
 func (c *BuildpackClient) Upload(ctx context.Context, guid string, zipFile io.Reader) (string, *resource.Buildpack, error) {
 	p := path.Format("/v3/buildpacks/%s/upload", guid)
 	var b resource.Buildpack
-	jobGUID, err := c.client.postFileUpload(ctx, p, "bits", "buildpack.zip", zipFile, &b)
+	jobGUID, err := c.client.postFileUpload(ctx, "Buildpacks.Upload", p, "bits", "buildpack.zip", zipFile, &b)
 	if err != nil {
 		return "", nil, err
 	}
@@ -1,3 +1,5 @@
+//go:generate go run ../tools/gen_mocks.go
+
 package client
 
 import (
@@ -11,12 +13,14 @@ import (
 	"net/url"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/config"
 	"github.com/cloudfoundry-community/go-cfclient/v3/internal/check"
 	internal "github.com/cloudfoundry-community/go-cfclient/v3/internal/http"
 	"github.com/cloudfoundry-community/go-cfclient/v3/internal/ios"
 	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
 )
 
 // Client used to communicate with Cloud Foundry
@@ -35,7 +39,10 @@ type Client struct {
 	FeatureFlags              *FeatureFlagClient
 	IsolationSegments         *IsolationSegmentClient
 	Jobs                      *JobClient
+	LogCache                  *LogCacheClient
+	Logs                      *LogStreamClient
 	Manifests                 *ManifestClient
+	NetworkPolicies           *NetworkPolicyClient
 	Organizations             *OrganizationClient
 	OrganizationQuotas        *OrganizationQuotaClient
 	Packages                  *PackageClient
@@ -45,6 +52,7 @@ type Client struct {
 	Roles                     *RoleClient
 	Root                      *RootClient
 	Routes                    *RouteClient
+	RoutingAPI                *RoutingAPIClient
 	SecurityGroups            *SecurityGroupClient
 	ServiceBrokers            *ServiceBrokerClient
 	ServiceCredentialBindings *ServiceCredentialBindingClient
@@ -64,6 +72,9 @@ type Client struct {
 
 	common commonClient // Reuse a single struct instead of allocating one for each commonClient on the heap.
 	*config.Config
+
+	rootLinksMu sync.Mutex
+	rootLinks   *resource.RootLinks // cached global API root links, see links
 }
 
 type commonClient struct {
@@ -98,7 +109,10 @@ func New(config *config.Config) (*Client, error) {
 	client.FeatureFlags = (*FeatureFlagClient)(&client.common)
 	client.IsolationSegments = (*IsolationSegmentClient)(&client.common)
 	client.Jobs = (*JobClient)(&client.common)
+	client.LogCache = (*LogCacheClient)(&client.common)
+	client.Logs = (*LogStreamClient)(&client.common)
 	client.Manifests = (*ManifestClient)(&client.common)
+	client.NetworkPolicies = (*NetworkPolicyClient)(&client.common)
 	client.Organizations = (*OrganizationClient)(&client.common)
 	client.OrganizationQuotas = (*OrganizationQuotaClient)(&client.common)
 	client.Packages = (*PackageClient)(&client.common)
@@ -108,6 +122,7 @@ func New(config *config.Config) (*Client, error) {
 	client.Roles = (*RoleClient)(&client.common)
 	client.Root = (*RootClient)(&client.common)
 	client.Routes = (*RouteClient)(&client.common)
+	client.RoutingAPI = (*RoutingAPIClient)(&client.common)
 	client.SecurityGroups = (*SecurityGroupClient)(&client.common)
 	client.ServiceBrokers = (*ServiceBrokerClient)(&client.common)
 	client.ServiceCredentialBindings = (*ServiceCredentialBindingClient)(&client.common)
@@ -176,22 +191,32 @@ func (c *Client) SSHCode(ctx context.Context) (string, error) {
 //
 // This function takes the relative API resource path. If the resource returns an async job ID
 // then the function returns the job GUID which the caller can reference via the job endpoint.
-func (c *Client) delete(ctx context.Context, resourcePath string) (string, error) {
+func (c *Client) delete(ctx context.Context, operation, resourcePath string) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.ApiURL(resourcePath), nil)
 	if err != nil {
 		return "", fmt.Errorf("creating DELETE request for %s failed: %w", resourcePath, err)
 	}
-	resp, err := c.ExecuteAuthRequest(req)
-	if err != nil {
-		return "", fmt.Errorf("executing DELETE request for %s failed: %w", resourcePath, err)
-	}
-	defer ios.Close(resp.Body)
-	return internal.DecodeJobIDOrBody(resp, nil)
+	call := &config.Call{
+		Method:       http.MethodDelete,
+		Operation:    operation,
+		ResourcePath: resourcePath,
+		Request:      req,
+	}
+	err = c.invoke(ctx, call, func(ctx context.Context, call *config.Call) error {
+		resp, err := c.executeCall(call)
+		if err != nil {
+			return fmt.Errorf("executing DELETE request for %s failed: %w", resourcePath, err)
+		}
+		defer ios.Close(resp.Body)
+		call.JobGUID, err = internal.DecodeJobIDOrBody(resp, nil)
+		return err
+	})
+	return call.JobGUID, err
 }
 
 // get does an HTTP GET to the specified endpoint and automatically handles unmarshalling
 // the result JSON body
-func (c *Client) get(ctx context.Context, resourcePath string, result any) error {
+func (c *Client) get(ctx context.Context, operation, resourcePath string, result any) error {
 	if !check.IsNil(result) && !check.IsPointer(result) {
 		return errors.New("expected result to be nil or a pointer type")
 	}
@@ -201,18 +226,26 @@ func (c *Client) get(ctx context.Context, resourcePath string, result any) error
 		return fmt.Errorf("error creating GET request for %s: %w", resourcePath, err)
 	}
 
-	resp, err := c.ExecuteAuthRequest(req)
-	if err != nil {
-		return fmt.Errorf("error executing GET request for %s: %w", resourcePath, err)
-	}
-	defer ios.Close(resp.Body)
-
-	return internal.DecodeBody(resp, result)
+	call := &config.Call{
+		Method:       http.MethodGet,
+		Operation:    operation,
+		ResourcePath: resourcePath,
+		Request:      req,
+		Result:       result,
+	}
+	return c.invoke(ctx, call, func(ctx context.Context, call *config.Call) error {
+		resp, err := c.executeCall(call)
+		if err != nil {
+			return fmt.Errorf("error executing GET request for %s: %w", resourcePath, err)
+		}
+		defer ios.Close(resp.Body)
+		return internal.DecodeBody(resp, call.Result)
+	})
 }
 
 // list does an HTTP GET to the specified endpoint and automatically handles unmarshalling the result JSON body.
 // This is a utility function to support list functions.
-func (c *Client) list(ctx context.Context, urlPathFormat string, queryStrFunc func() (url.Values, error), result any) error {
+func (c *Client) list(ctx context.Context, operation, urlPathFormat string, queryStrFunc func() (url.Values, error), result any) error {
 	params, err := queryStrFunc()
 	if err != nil {
 		return fmt.Errorf("error while generate query params: %w", err)
@@ -220,7 +253,7 @@ func (c *Client) list(ctx context.Context, urlPathFormat string, queryStrFunc fu
 	if len(params) > 0 {
 		urlPathFormat = strings.TrimSuffix(urlPathFormat+"?"+params.Encode(), "?")
 	}
-	return c.get(ctx, urlPathFormat, result)
+	return c.get(ctx, operation, urlPathFormat, result)
 }
 
 // patch does an HTTP PATCH to the specified endpoint and automatically handles the result
@@ -230,8 +263,8 @@ func (c *Client) list(ctx context.Context, urlPathFormat string, queryStrFunc fu
 // struct to unmarshall the result body. If the resource returns an async job ID instead of a
 // response body, then the body won't be unmarshalled and the function returns the job GUID
 // which the caller can reference via the job endpoint.
-func (c *Client) patch(ctx context.Context, resourcePath string, params any, result any) (string, error) {
-	return c.createOrUpdate(ctx, http.MethodPatch, resourcePath, params, result)
+func (c *Client) patch(ctx context.Context, operation, resourcePath string, params any, result any) (string, error) {
+	return c.createOrUpdate(ctx, operation, http.MethodPatch, resourcePath, params, result)
 }
 
 // post does an HTTP POST to the specified endpoint and automatically handles the result
@@ -240,27 +273,39 @@ func (c *Client) patch(ctx context.Context, resourcePath string, params any, res
 // This function takes the relative API resource path, any parameters to POST and an optional
 // struct to unmarshall the result body. If the resource returns an async job ID in the Location
 // header then the job GUID is returned which the caller can reference via the job endpoint.
-func (c *Client) post(ctx context.Context, resourcePath string, params, result any) (string, error) {
-	return c.createOrUpdate(ctx, http.MethodPost, resourcePath, params, result)
+func (c *Client) post(ctx context.Context, operation, resourcePath string, params, result any) (string, error) {
+	return c.createOrUpdate(ctx, operation, http.MethodPost, resourcePath, params, result)
 }
 
 // Download the bits of an existing package or droplet
 // It is the caller's responsibility to close the io.ReadCloser
-func (c *Client) download(ctx context.Context, resourcePath string) (io.ReadCloser, error) {
+func (c *Client) download(ctx context.Context, operation, resourcePath string) (io.ReadCloser, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.ApiURL(resourcePath), nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating download request for %s failed: %w", resourcePath, err)
 	}
-	resp, err := c.ExecuteAuthRequest(internal.IgnoreRedirect(req))
+	call := &config.Call{
+		Method:       http.MethodGet,
+		Operation:    operation,
+		ResourcePath: resourcePath,
+		Request:      internal.IgnoreRedirect(req),
+	}
+	err = c.invoke(ctx, call, func(ctx context.Context, call *config.Call) error {
+		resp, err := c.executeCall(call)
+		if err != nil {
+			return fmt.Errorf("executing download request for %s failed: %w", resourcePath, err)
+		}
+		ios.Close(resp.Body)
+		if !internal.IsResponseRedirect(resp.StatusCode) {
+			return fmt.Errorf("error downloading `%s` bits, expected redirect to blobstore", resourcePath)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("executing download request for %s failed: %w", resourcePath, err)
-	}
-	ios.Close(resp.Body)
-	if !internal.IsResponseRedirect(resp.StatusCode) {
-		return nil, fmt.Errorf("error downloading `%s` bits, expected redirect to blobstore", resourcePath)
+		return nil, err
 	}
 	// get the full URL to the blobstore via the Location header
-	blobStoreLocation := resp.Header.Get("Location")
+	blobStoreLocation := call.Response.Header.Get("Location")
 	if blobStoreLocation == "" {
 		return nil, errors.New("response redirect Location header was empty")
 	}
@@ -268,7 +313,7 @@ func (c *Client) download(ctx context.Context, resourcePath string) (io.ReadClos
 	if err != nil {
 		return nil, fmt.Errorf("creating blob download request for %s failed: %w", blobStoreLocation, err)
 	}
-	resp, err = c.ExecuteRequest(req)
+	resp, err := c.ExecuteRequest(req)
 	if err != nil {
 		return nil, fmt.Errorf("executing blob download request for %s failed: %w", blobStoreLocation, err)
 	}
@@ -281,30 +326,42 @@ func (c *Client) download(ctx context.Context, resourcePath string) (io.ReadClos
 // This function takes the relative API resource path, any parameters to POST and an optional
 // struct to unmarshall the result body. If the resource returns an async job ID in the Location
 // header then the job GUID is returned which the caller can reference via the job endpoint.
-func (c *Client) postFileUpload(ctx context.Context, path, fieldName, fileName string, fileContent io.Reader, result any) (string, error) {
-	// Validate input parameters
-	if path == "" || fieldName == "" || fileName == "" {
-		return "", errors.New("path, fieldName, and fileName are required")
-	}
+func (c *Client) postFileUpload(ctx context.Context, operation, path, fieldName, fileName string, fileContent io.Reader, result any) (string, error) {
 	if fileContent == nil {
 		return "", fmt.Errorf("no content was provided for the %s file", fileName)
 	}
+	return c.postMultipartForm(ctx, operation, path, nil, fieldName, fileName, fileContent, result)
+}
 
+// postMultipartForm does an HTTP POST of a multipart form containing the specified fields, in order, followed by
+// the file if fileContent isn't nil. The result is handled the same as postFileUpload.
+func (c *Client) postMultipartForm(ctx context.Context, operation, path string, fields []formField, fieldName, fileName string, fileContent io.Reader, result any) (string, error) {
+	// Validate input parameters
+	if path == "" || fieldName == "" || fileName == "" {
+		return "", errors.New("path, fieldName, and fileName are required")
+	}
 	if !check.IsNil(result) && !check.IsPointer(result) {
 		return "", errors.New("expected result to be a pointer type or nil")
 	}
-	//
+
 	// Prepare multipart form data
 	body := &bytes.Buffer{}
 	formWriter := multipart.NewWriter(body)
-	part, err := formWriter.CreateFormFile(fieldName, filepath.Base(fileName))
-	if err != nil {
-		return "", fmt.Errorf("error uploading file to %s: %w", path, err)
-	}
-	if _, err = io.Copy(part, fileContent); err != nil {
-		return "", fmt.Errorf("error uploading file to %s, failed on copy: %w", path, err)
-	}
-	if err = formWriter.Close(); err != nil {
+	for _, f := range fields {
+		if err := formWriter.WriteField(f.name, f.value); err != nil {
+			return "", fmt.Errorf("error uploading to %s, failed to write %s field: %w", path, f.name, err)
+		}
+	}
+	if fileContent != nil {
+		part, err := formWriter.CreateFormFile(fieldName, filepath.Base(fileName))
+		if err != nil {
+			return "", fmt.Errorf("error uploading file to %s: %w", path, err)
+		}
+		if _, err = io.Copy(part, fileContent); err != nil {
+			return "", fmt.Errorf("error uploading file to %s, failed on copy: %w", path, err)
+		}
+	}
+	if err := formWriter.Close(); err != nil {
 		return "", fmt.Errorf("error uploading file to %s, failed to close multipart form writer: %w", path, err)
 	}
 
@@ -315,14 +372,31 @@ func (c *Client) postFileUpload(ctx context.Context, path, fieldName, fileName s
 	}
 	req.Header.Set("Content-Type", formWriter.FormDataContentType())
 
-	resp, err := c.ExecuteAuthRequest(req)
-	if err != nil {
-		return "", fmt.Errorf("error executing request: %w", err)
-	}
-	defer ios.Close(resp.Body) // Ensure closure of the response body
+	call := &config.Call{
+		Method:       http.MethodPost,
+		Operation:    operation,
+		ResourcePath: path,
+		Request:      req,
+		Result:       result,
+	}
+	err = c.invoke(ctx, call, func(ctx context.Context, call *config.Call) error {
+		resp, err := c.executeCall(call)
+		if err != nil {
+			return fmt.Errorf("error executing request: %w", err)
+		}
+		defer ios.Close(resp.Body) // Ensure closure of the response body
+
+		// Decode the response
+		call.JobGUID, err = internal.DecodeJobIDAndBody(resp, call.Result)
+		return err
+	})
+	return call.JobGUID, err
+}
 
-	// Decode the response
-	return internal.DecodeJobIDAndBody(resp, result)
+// formField is a multipart form field
+type formField struct {
+	name  string
+	value string
 }
 
 // createOrUpdate is a utility function for patch and post that does an HTTP POST or PATCH to the specified
@@ -331,7 +405,7 @@ func (c *Client) postFileUpload(ctx context.Context, path, fieldName, fileName s
 // This function takes the relative API resource path, any parameters to POST/PATCH and an optional
 // struct to unmarshall the result body. If the resource returns an async job ID in the Location
 // header then the job GUID is returned which the caller can reference via the job endpoint.
-func (c *Client) createOrUpdate(ctx context.Context, method, resourcePath string, params, result any) (string, error) {
+func (c *Client) createOrUpdate(ctx context.Context, operation, method, resourcePath string, params, result any) (string, error) {
 	if !check.IsNil(result) && !check.IsPointer(result) {
 		return "", errors.New("expected result to be a pointer type, or nil")
 	}
@@ -346,30 +420,123 @@ func (c *Client) createOrUpdate(ctx context.Context, method, resourcePath string
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	resp, err := c.ExecuteAuthRequest(req)
+	call := &config.Call{
+		Method:       method,
+		Operation:    operation,
+		ResourcePath: resourcePath,
+		Request:      req,
+		Result:       result,
+	}
+	err = c.invoke(ctx, call, func(ctx context.Context, call *config.Call) error {
+		resp, err := c.executeCall(call)
+		if err != nil {
+			return fmt.Errorf("executing %s request for %s failed: %w", method, resourcePath, err)
+		}
+		defer ios.Close(resp.Body)
+		call.JobGUID, err = internal.DecodeJobIDOrBody(resp, call.Result)
+		return err
+	})
+	return call.JobGUID, err
+}
+
+// external does an HTTP request to the specified endpoint of a Cloud Foundry component other than the Cloud
+// Controller, like log cache, and automatically handles unmarshalling the result JSON body.
+//
+// This function takes the component's base URL, as discovered from the global API root links, the relative
+// resource path, any parameters to send as the JSON body and an optional struct to unmarshall the result body.
+func (c *Client) external(ctx context.Context, operation, method, baseURL, resourcePath string, params, result any) error {
+	if !check.IsNil(result) && !check.IsPointer(result) {
+		return errors.New("expected result to be a pointer type, or nil")
+	}
+	body, err := internal.EncodeBody(params)
 	if err != nil {
-		return "", fmt.Errorf("executing %s request for %s failed: %w", method, resourcePath, err)
+		return fmt.Errorf("failed to encode params: %w", err)
 	}
-	defer ios.Close(resp.Body)
-	return internal.DecodeJobIDOrBody(resp, result)
+	u := strings.TrimSuffix(baseURL, "/") + resourcePath
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return fmt.Errorf("creating %s request for %s failed: %w", method, u, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	call := &config.Call{
+		Method:       method,
+		Operation:    operation,
+		ResourcePath: resourcePath,
+		Request:      req,
+		Result:       result,
+	}
+	return c.invoke(ctx, call, func(ctx context.Context, call *config.Call) error {
+		resp, err := c.executeCall(call)
+		if err != nil {
+			return fmt.Errorf("executing %s request for %s failed: %w", method, u, err)
+		}
+		defer ios.Close(resp.Body)
+		return internal.DecodeBody(resp, call.Result)
+	})
+}
+
+// links returns the global API root links, the API root is only queried the first time links are needed
+func (c *Client) links(ctx context.Context) (*resource.RootLinks, error) {
+	c.rootLinksMu.Lock()
+	defer c.rootLinksMu.Unlock()
+	if c.rootLinks == nil {
+		root, err := c.Root.Get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error discovering the API root links: %w", err)
+		}
+		c.rootLinks = &root.Links
+	}
+	return c.rootLinks, nil
+}
+
+// invoke executes the call through the chain of configured interceptors
+func (c *Client) invoke(ctx context.Context, call *config.Call, invoker config.Invoker) error {
+	interceptors := c.Interceptors()
+	if len(interceptors) == 0 {
+		return invoker(ctx, call)
+	}
+	return config.Chain(invoker, interceptors...)(ctx, call)
+}
+
+// executeCall executes the call's request with authentication. Unlike ExecuteAuthRequest the call's
+// Response is set before the status is checked so interceptors can inspect unsuccessful responses.
+func (c *Client) executeCall(call *config.Call) (*http.Response, error) {
+	resp, err := c.sendHTTPRequest(call.Request, true)
+	if err != nil {
+		return nil, err
+	}
+	call.Response = resp
+	if !internal.IsStatusSuccess(resp.StatusCode) {
+		return nil, internal.DecodeError(resp)
+	}
+	return resp, nil
 }
 
 // executeHTTPRequest is the low level client function that handles executing the request against the
 // correct http.Client.
-func (c *Client) executeHTTPRequest(req *http.Request, includeAuthHeader bool) (resp *http.Response, err error) {
+func (c *Client) executeHTTPRequest(req *http.Request, includeAuthHeader bool) (*http.Response, error) {
+	resp, err := c.sendHTTPRequest(req, includeAuthHeader)
+	if err != nil {
+		return nil, err
+	}
+	if !internal.IsStatusSuccess(resp.StatusCode) {
+		return nil, internal.DecodeError(resp)
+	}
+	return resp, nil
+}
+
+// sendHTTPRequest sends the request using the correct http.Client without checking the response status
+func (c *Client) sendHTTPRequest(req *http.Request, includeAuthHeader bool) (resp *http.Response, err error) {
 	req.Header.Set("User-Agent", c.UserAgent())
 	if includeAuthHeader {
 		resp, err = c.HTTPAuthClient().Do(req)
 	} else {
 		resp, err = c.HTTPClient().Do(req)
 	}
-
 	if err != nil {
 		return nil, fmt.Errorf("error executing request, failed during HTTP request send: %w", err)
 	}
-	if !internal.IsStatusSuccess(resp.StatusCode) {
-		return nil, internal.DecodeError(resp)
-	}
-
-	return resp, err
+	return resp, nil
 }
@@ -1,10 +1,15 @@
 package client_test
 
 import (
+	"context"
+	"net/http"
+	"testing"
+
 	"github.com/cloudfoundry-community/go-cfclient/v3/client"
 	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestClientWithInvalidConfig(t *testing.T) {
@@ -17,3 +22,143 @@ func TestClientWithInvalidConfig(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, config.ErrConfigInvalid, err)
 }
+
+func TestClientMiddleware(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(1)
+	app := g.Application()
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:   "GET",
+			Endpoint: "/v3/apps/" + app.GUID,
+			Output:   g.Single(app.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:           "DELETE",
+			Endpoint:         "/v3/apps/" + app.GUID,
+			Status:           http.StatusAccepted,
+			RedirectLocation: "https://api.example.org/api/v3/jobs/c33a5caf-77e0-4d6e-b587-5555d339bc9a",
+		},
+		{
+			Method:   "GET",
+			Endpoint: "/v3/apps/not-found",
+			Output:   []string{`{"errors":[{"detail":"App not found","title":"CF-ResourceNotFound","code":10010}]}`},
+			Status:   http.StatusNotFound,
+		},
+	}, t)
+	defer testutil.Teardown()
+
+	var calls []string
+	var correlationIDs []string
+	var operations []string
+	var errs []error
+	outer := func(ctx context.Context, call *config.Call, next config.Invoker) error {
+		calls = append(calls, "outer:"+call.Method+" "+call.ResourcePath)
+		operations = append(operations, call.Operation)
+		call.Request.Header.Set("X-Correlation-ID", "1234")
+		err := next(ctx, call)
+		errs = append(errs, err)
+		if call.JobGUID != "" {
+			calls = append(calls, "job:"+call.JobGUID)
+		}
+		return err
+	}
+	inner := func(ctx context.Context, call *config.Call, next config.Invoker) error {
+		calls = append(calls, "inner:"+call.Method+" "+call.ResourcePath)
+		correlationIDs = append(correlationIDs, call.Request.Header.Get("X-Correlation-ID"))
+		err := next(ctx, call)
+		if err == nil {
+			require.NotNil(t, call.Response)
+		}
+		return err
+	}
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"), config.Middleware(outer, inner))
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+
+	a, err := cf.Applications.Get(context.Background(), app.GUID)
+	require.NoError(t, err)
+	require.Equal(t, app.GUID, a.GUID)
+
+	jobGUID, err := cf.Applications.Delete(context.Background(), app.GUID)
+	require.NoError(t, err)
+	require.Equal(t, "c33a5caf-77e0-4d6e-b587-5555d339bc9a", jobGUID)
+
+	_, err = cf.Applications.Get(context.Background(), "not-found")
+	require.True(t, resource.IsResourceNotFoundError(err))
+
+	require.Equal(t, []string{
+		"outer:GET /v3/apps/" + app.GUID,
+		"inner:GET /v3/apps/" + app.GUID,
+		"outer:DELETE /v3/apps/" + app.GUID,
+		"inner:DELETE /v3/apps/" + app.GUID,
+		"job:c33a5caf-77e0-4d6e-b587-5555d339bc9a",
+		"outer:GET /v3/apps/not-found",
+		"inner:GET /v3/apps/not-found",
+	}, calls)
+	require.Equal(t, []string{"1234", "1234", "1234"}, correlationIDs)
+	require.Equal(t, []string{"Applications.Get", "Applications.Delete", "Applications.Get"}, operations)
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.True(t, resource.IsResourceNotFoundError(errs[2]))
+}
+
+func TestClientMiddlewareManifests(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(1)
+	manifest := g.Manifest().JSON
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:   "GET",
+			Endpoint: "/v3/apps/389f0d73-04ee-455b-b63c-513c7c78d5ff/manifest",
+			Output:   g.Single(manifest),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:           "POST",
+			Endpoint:         "/v3/spaces/8d1f1d2e-08b1-4a10-a8df-471a1418cb8b/actions/apply_manifest",
+			Status:           http.StatusAccepted,
+			RedirectLocation: "https://api.example.org/api/v3/jobs/c33a5caf-77e0-4d6e-b587-5555d339bc9a",
+		},
+		{
+			Method:   "POST",
+			Endpoint: "/v3/spaces/8d1f1d2e-08b1-4a10-a8df-471a1418cb8b/manifest_diff",
+			Output:   g.Single(g.ManifestDiff().JSON),
+			Status:   http.StatusCreated,
+		},
+	}, t)
+	defer testutil.Teardown()
+
+	var operations []string
+	var jobGUIDs []string
+	interceptor := func(ctx context.Context, call *config.Call, next config.Invoker) error {
+		err := next(ctx, call)
+		require.NotNil(t, call.Response)
+		operations = append(operations, call.Operation+" "+call.Method+" "+call.ResourcePath)
+		jobGUIDs = append(jobGUIDs, call.JobGUID)
+		return err
+	}
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"), config.Middleware(interceptor))
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+
+	actual, err := cf.Manifests.Generate(context.Background(), "389f0d73-04ee-455b-b63c-513c7c78d5ff")
+	require.NoError(t, err)
+	require.Equal(t, manifest, actual)
+	jobGUID, err := cf.Manifests.ApplyManifest(context.Background(), "8d1f1d2e-08b1-4a10-a8df-471a1418cb8b", manifest)
+	require.NoError(t, err)
+	require.Equal(t, "c33a5caf-77e0-4d6e-b587-5555d339bc9a", jobGUID)
+	_, err = cf.Manifests.ManifestDiff(context.Background(), "8d1f1d2e-08b1-4a10-a8df-471a1418cb8b", manifest)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"Manifests.Generate GET /v3/apps/389f0d73-04ee-455b-b63c-513c7c78d5ff/manifest",
+		"Manifests.ApplyManifest POST /v3/spaces/8d1f1d2e-08b1-4a10-a8df-471a1418cb8b/actions/apply_manifest",
+		"Manifests.ManifestDiff POST /v3/spaces/8d1f1d2e-08b1-4a10-a8df-471a1418cb8b/manifest_diff",
+	}, operations)
+	require.Equal(t, []string{"", "c33a5caf-77e0-4d6e-b587-5555d339bc9a", ""}, jobGUIDs)
+}
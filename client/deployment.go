@@ -3,12 +3,17 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
 	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
 )
 
+// ErrDeploymentCanceled is returned when polling a deployment that finalized without being deployed because it
+// was canceled or superseded by another deployment
+var ErrDeploymentCanceled = errors.New("deployment was not deployed")
+
 type DeploymentClient commonClient
 
 // DeploymentListOptions list filters
@@ -34,7 +39,13 @@ func (o DeploymentListOptions) ToQueryString() (url.Values, error) {
 
 // Cancel the ongoing deployment
 func (c *DeploymentClient) Cancel(ctx context.Context, guid string) error {
-	_, err := c.client.post(ctx, path.Format("/v3/deployments/%s/actions/cancel", guid), nil, nil)
+	_, err := c.client.post(ctx, "Deployments.Cancel", path.Format("/v3/deployments/%s/actions/cancel", guid), nil, nil)
+	return err
+}
+
+// Continue the paused canary deployment to its next step
+func (c *DeploymentClient) Continue(ctx context.Context, guid string) error {
+	_, err := c.client.post(ctx, "Deployments.Continue", path.Format("/v3/deployments/%s/actions/continue", guid), nil, nil)
 	return err
 }
 
@@ -46,7 +57,7 @@ func (c *DeploymentClient) Create(ctx context.Context, r *resource.DeploymentCre
 	}
 
 	var d resource.Deployment
-	_, err := c.client.post(ctx, "/v3/deployments", r, &d)
+	_, err := c.client.post(ctx, "Deployments.Create", "/v3/deployments", r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +74,7 @@ func (c *DeploymentClient) First(ctx context.Context, opts *DeploymentListOption
 // Get the specified deployment
 func (c *DeploymentClient) Get(ctx context.Context, guid string) (*resource.Deployment, error) {
 	var d resource.Deployment
-	err := c.client.get(ctx, path.Format("/v3/deployments/%s", guid), &d)
+	err := c.client.get(ctx, "Deployments.Get", path.Format("/v3/deployments/%s", guid), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +87,7 @@ func (c *DeploymentClient) List(ctx context.Context, opts *DeploymentListOptions
 		opts = NewDeploymentListOptions()
 	}
 	var res resource.DeploymentList
-	err := c.client.list(ctx, "/v3/deployments", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Deployments.List", "/v3/deployments", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,11 +100,38 @@ func (c *DeploymentClient) ListAll(ctx context.Context, opts *DeploymentListOpti
 	if opts == nil {
 		opts = NewDeploymentListOptions()
 	}
-	return AutoPage[*DeploymentListOptions, *resource.Deployment](opts, func(opts *DeploymentListOptions) ([]*resource.Deployment, *Pager, error) {
+	return AutoPageConcurrent[*DeploymentListOptions, *resource.Deployment](opts, c.client.PageConcurrency(), func(opts *DeploymentListOptions) ([]*resource.Deployment, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
 
+// Iter returns an iterator over all deployments the user has access to
+func (c *DeploymentClient) Iter(ctx context.Context, opts *DeploymentListOptions) Seq2[*resource.Deployment, error] {
+	if opts == nil {
+		opts = NewDeploymentListOptions()
+	}
+	return AutoPageIter[*DeploymentListOptions, *resource.Deployment](ctx, opts, func(opts *DeploymentListOptions) ([]*resource.Deployment, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// PollFinalized waits until the deployment is finalized or times out and returns the final deployment. If the
+// deployment was canceled or superseded an error wrapping ErrDeploymentCanceled is returned with the status reason
+// and any error details.
+func (c *DeploymentClient) PollFinalized(ctx context.Context, guid string, opts *PollingOptions) (*resource.Deployment, error) {
+	return c.pollStatus(ctx, guid, opts, func(d *resource.Deployment) bool {
+		return false
+	})
+}
+
+// PollPaused waits until the canary deployment pauses at its next step or is finalized and returns the deployment.
+// Errors are handled the same as PollFinalized.
+func (c *DeploymentClient) PollPaused(ctx context.Context, guid string, opts *PollingOptions) (*resource.Deployment, error) {
+	return c.pollStatus(ctx, guid, opts, func(d *resource.Deployment) bool {
+		return d.Status.Reason == resource.DeploymentStatusReasonPaused.String()
+	})
+}
+
 // Single returns a single deployment matching the options or an error if not exactly 1 match
 func (c *DeploymentClient) Single(ctx context.Context, opts *DeploymentListOptions) (*resource.Deployment, error) {
 	return Single[*DeploymentListOptions, *resource.Deployment](opts, func(opts *DeploymentListOptions) ([]*resource.Deployment, *Pager, error) {
@@ -104,9 +142,42 @@ func (c *DeploymentClient) Single(ctx context.Context, opts *DeploymentListOptio
 // Update the specified attributes of the deployment
 func (c *DeploymentClient) Update(ctx context.Context, guid string, r *resource.DeploymentUpdate) (*resource.Deployment, error) {
 	var d resource.Deployment
-	_, err := c.client.patch(ctx, path.Format("/v3/deployments/%s", guid), r, &d)
+	_, err := c.client.patch(ctx, "Deployments.Update", path.Format("/v3/deployments/%s", guid), r, &d)
 	if err != nil {
 		return nil, err
 	}
 	return &d, nil
 }
+
+// pollStatus polls the deployment until it's finalized, or done returns true for the active deployment
+func (c *DeploymentClient) pollStatus(ctx context.Context, guid string, opts *PollingOptions, done func(d *resource.Deployment) bool) (*resource.Deployment, error) {
+	var d *resource.Deployment
+	err := PollForStateOrTimeout(func() (string, error) {
+		var err error
+		d, err = c.Get(ctx, guid)
+		if err != nil {
+			return "", err
+		}
+		if d.Status.Value == resource.DeploymentStatusValueFinalized.String() {
+			if d.Status.Reason != resource.DeploymentStatusReasonDeployed.String() {
+				return "", deploymentCanceledError(d)
+			}
+			return "done", nil
+		}
+		if done(d) {
+			return "done", nil
+		}
+		return d.Status.Reason, nil
+	}, "done", opts)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func deploymentCanceledError(d *resource.Deployment) error {
+	if detail := d.Status.Details["error"]; detail != "" {
+		return fmt.Errorf("%w, deployment %s finalized with status reason %s: %s", ErrDeploymentCanceled, d.GUID, d.Status.Reason, detail)
+	}
+	return fmt.Errorf("%w, deployment %s finalized with status reason %s", ErrDeploymentCanceled, d.GUID, d.Status.Reason)
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
 	"github.com/stretchr/testify/require"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDeployments(t *testing.T) {
@@ -15,6 +17,10 @@ func TestDeployments(t *testing.T) {
 	deployment2 := g.Deployment().JSON
 	deployment3 := g.Deployment().JSON
 	deployment4 := g.Deployment().JSON
+	paused := strings.Replace(deployment, `"reason": "DEPLOYING"`, `"reason": "PAUSED"`, 1)
+	deployed := strings.Replace(strings.Replace(deployment, `"ACTIVE"`, `"FINALIZED"`, 1), `"DEPLOYING"`, `"DEPLOYED"`, 1)
+	superseded := strings.Replace(strings.Replace(deployment, `"ACTIVE"`, `"FINALIZED"`, 1), `"DEPLOYING"`, `"SUPERSEDED"`, 1)
+	pollingOpts := &PollingOptions{Timeout: 5 * time.Second, CheckInterval: time.Millisecond}
 
 	tests := []RouteTest{
 		{
@@ -53,6 +59,29 @@ func TestDeployments(t *testing.T) {
 				return c.Deployments.Create(context.Background(), r)
 			},
 		},
+		{
+			Description: "Create canary deployment",
+			Route: testutil.MockRoute{
+				Method:   "POST",
+				Endpoint: "/v3/deployments",
+				Output:   g.Single(deployment),
+				Status:   http.StatusCreated,
+				PostForm: `{"relationships":{"app":{"data":{"guid":"305cea31-5a44-45ca-b51b-e89c7a8ef8b2"}}}, "droplet": {"guid": "c2941033-4575-486d-bf2c-3ae49e8b4ca1"},
+					"strategy": "canary", "options": {"max_in_flight": 2, "canary": {"steps": [{"instance_weight": 10}, {"instance_weight": 50}]}}}`,
+			},
+			Expected: deployment,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				r := resource.NewDeploymentCreateWithStrategy("305cea31-5a44-45ca-b51b-e89c7a8ef8b2",
+					"c2941033-4575-486d-bf2c-3ae49e8b4ca1", resource.DeploymentStrategyCanary)
+				r.Options = &resource.DeploymentOptions{
+					MaxInFlight: 2,
+					Canary: &resource.DeploymentCanaryOptions{
+						Steps: []resource.DeploymentCanaryStep{{InstanceWeight: 10}, {InstanceWeight: 50}},
+					},
+				}
+				return c.Deployments.Create(context.Background(), r)
+			},
+		},
 		{
 			Description: "Create deployment with revision and droplet",
 			Action: func(c *Client, t *testing.T) (any, error) {
@@ -80,6 +109,58 @@ func TestDeployments(t *testing.T) {
 				return nil, c.Deployments.Cancel(context.Background(), "2b56dc7b-2a14-49ea-be29-ca182b14a998")
 			},
 		},
+		{
+			Description: "Continue deployment",
+			Route: testutil.MockRoute{
+				Method:   "POST",
+				Endpoint: "/v3/deployments/2b56dc7b-2a14-49ea-be29-ca182b14a998/actions/continue",
+				Status:   http.StatusOK,
+			},
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return nil, c.Deployments.Continue(context.Background(), "2b56dc7b-2a14-49ea-be29-ca182b14a998")
+			},
+		},
+		{
+			Description: "Poll deployment until finalized",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/v3/deployments/2b56dc7b-2a14-49ea-be29-ca182b14a998",
+				Output:   []string{deployment, paused, deployed},
+				Status:   http.StatusOK,
+			},
+			Expected: deployed,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.Deployments.PollFinalized(context.Background(), "2b56dc7b-2a14-49ea-be29-ca182b14a998", pollingOpts)
+			},
+		},
+		{
+			Description: "Poll superseded deployment until finalized",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/v3/deployments/2b56dc7b-2a14-49ea-be29-ca182b14a998",
+				Output:   []string{deployment, superseded},
+				Status:   http.StatusOK,
+			},
+			Action: func(c *Client, t *testing.T) (any, error) {
+				_, err := c.Deployments.PollFinalized(context.Background(), "2b56dc7b-2a14-49ea-be29-ca182b14a998", pollingOpts)
+				require.ErrorIs(t, err, ErrDeploymentCanceled)
+				require.ErrorContains(t, err, "finalized with status reason SUPERSEDED")
+				return nil, nil
+			},
+		},
+		{
+			Description: "Poll canary deployment until paused",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/v3/deployments/2b56dc7b-2a14-49ea-be29-ca182b14a998",
+				Output:   []string{deployment, paused},
+				Status:   http.StatusOK,
+			},
+			Expected: paused,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.Deployments.PollPaused(context.Background(), "2b56dc7b-2a14-49ea-be29-ca182b14a998", pollingOpts)
+			},
+		},
 		{
 			Description: "Get deployment",
 			Route: testutil.MockRoute{
@@ -33,7 +33,7 @@ func (o DomainListOptions) ToQueryString() (url.Values, error) {
 // Create a new domain
 func (c *DomainClient) Create(ctx context.Context, r *resource.DomainCreate) (*resource.Domain, error) {
 	var d resource.Domain
-	_, err := c.client.post(ctx, "/v3/domains", r, &d)
+	_, err := c.client.post(ctx, "Domains.Create", "/v3/domains", r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (c *DomainClient) Create(ctx context.Context, r *resource.DomainCreate) (*r
 
 // Delete the specified domain asynchronously and return a jobGUID.
 func (c *DomainClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/domains/%s", guid))
+	return c.client.delete(ctx, "Domains.Delete", path.Format("/v3/domains/%s", guid))
 }
 
 // First returns the first domain matching the options or an error when less than 1 match
@@ -62,7 +62,7 @@ func (c *DomainClient) FirstForOrganization(ctx context.Context, organizationGUI
 // Get the specified domain
 func (c *DomainClient) Get(ctx context.Context, guid string) (*resource.Domain, error) {
 	var d resource.Domain
-	err := c.client.get(ctx, path.Format("/v3/domains/%s", guid), &d)
+	err := c.client.get(ctx, "Domains.Get", path.Format("/v3/domains/%s", guid), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +72,7 @@ func (c *DomainClient) Get(ctx context.Context, guid string) (*resource.Domain,
 // List pages Domains the user has access to
 func (c *DomainClient) List(ctx context.Context, opts *DomainListOptions) ([]*resource.Domain, *Pager, error) {
 	var res resource.DomainList
-	err := c.client.list(ctx, "/v3/domains", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Domains.List", "/v3/domains", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +85,17 @@ func (c *DomainClient) ListAll(ctx context.Context, opts *DomainListOptions) ([]
 	if opts == nil {
 		opts = NewDomainListOptions()
 	}
-	return AutoPage[*DomainListOptions, *resource.Domain](opts, func(opts *DomainListOptions) ([]*resource.Domain, *Pager, error) {
+	return AutoPageConcurrent[*DomainListOptions, *resource.Domain](opts, c.client.PageConcurrency(), func(opts *DomainListOptions) ([]*resource.Domain, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all domains the user has access to
+func (c *DomainClient) Iter(ctx context.Context, opts *DomainListOptions) Seq2[*resource.Domain, error] {
+	if opts == nil {
+		opts = NewDomainListOptions()
+	}
+	return AutoPageIter[*DomainListOptions, *resource.Domain](ctx, opts, func(opts *DomainListOptions) ([]*resource.Domain, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -96,7 +106,7 @@ func (c *DomainClient) ListForOrganization(ctx context.Context, organizationGUID
 		opts = NewDomainListOptions()
 	}
 	var res resource.DomainList
-	err := c.client.list(ctx, "/v3/organizations/"+organizationGUID+"/domains", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Domains.ListForOrganization", "/v3/organizations/"+organizationGUID+"/domains", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,7 +119,17 @@ func (c *DomainClient) ListForOrganizationAll(ctx context.Context, organizationG
 	if opts == nil {
 		opts = NewDomainListOptions()
 	}
-	return AutoPage[*DomainListOptions, *resource.Domain](opts, func(opts *DomainListOptions) ([]*resource.Domain, *Pager, error) {
+	return AutoPageConcurrent[*DomainListOptions, *resource.Domain](opts, c.client.PageConcurrency(), func(opts *DomainListOptions) ([]*resource.Domain, *Pager, error) {
+		return c.ListForOrganization(ctx, organizationGUID, opts)
+	})
+}
+
+// IterForOrganization returns an iterator over all domains for the specified org that the user has access to
+func (c *DomainClient) IterForOrganization(ctx context.Context, organizationGUID string, opts *DomainListOptions) Seq2[*resource.Domain, error] {
+	if opts == nil {
+		opts = NewDomainListOptions()
+	}
+	return AutoPageIter[*DomainListOptions, *resource.Domain](ctx, opts, func(opts *DomainListOptions) ([]*resource.Domain, *Pager, error) {
 		return c.ListForOrganization(ctx, organizationGUID, opts)
 	})
 }
@@ -125,7 +145,7 @@ func (c *DomainClient) Share(ctx context.Context, domainGUID, organizationGUID s
 // This will allow any of the other organizations to use the organization-scoped domain.
 func (c *DomainClient) ShareMany(ctx context.Context, guid string, r *resource.ToManyRelationships) (*resource.ToManyRelationships, error) {
 	var d resource.ToManyRelationships
-	_, err := c.client.post(ctx, path.Format("/v3/domains/%s/relationships/shared_organizations", guid), r, &d)
+	_, err := c.client.post(ctx, "Domains.ShareMany", path.Format("/v3/domains/%s/relationships/shared_organizations", guid), r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -149,14 +169,14 @@ func (c *DomainClient) SingleForOrganization(ctx context.Context, organizationGU
 // UnShare an organization-scoped domain to other organizations specified by a list of organization guids
 // This will allow any of the other organizations to use the organization-scoped domain.
 func (c *DomainClient) UnShare(ctx context.Context, domainGUID, organizationGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/domains/%s/relationships/shared_organizations/%s", domainGUID, organizationGUID))
+	_, err := c.client.delete(ctx, "Domains.UnShare", path.Format("/v3/domains/%s/relationships/shared_organizations/%s", domainGUID, organizationGUID))
 	return err
 }
 
 // Update the specified attributes of the domain
 func (c *DomainClient) Update(ctx context.Context, guid string, r *resource.DomainUpdate) (*resource.Domain, error) {
 	var d resource.Domain
-	_, err := c.client.patch(ctx, path.Format("/v3/domains/%s", guid), r, &d)
+	_, err := c.client.patch(ctx, "Domains.Update", path.Format("/v3/domains/%s", guid), r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (o DropletAppListOptions) ToQueryString() (url.Values, error) {
 func (c *DropletClient) Copy(ctx context.Context, srcDropletGUID string, destAppGUID string) (any, error) {
 	var d resource.Droplet
 	r := resource.NewDropletCopy(destAppGUID)
-	_, err := c.client.post(ctx, path.Format("/v3/droplets?source_guid=%s", srcDropletGUID), r, &d)
+	_, err := c.client.post(ctx, "Droplets.Copy", path.Format("/v3/droplets?source_guid=%s", srcDropletGUID), r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +86,7 @@ func (c *DropletClient) Copy(ctx context.Context, srcDropletGUID string, destApp
 // Create a droplet without a package. To create a droplet based on a package, see Create a build
 func (c *DropletClient) Create(ctx context.Context, r *resource.DropletCreate) (*resource.Droplet, error) {
 	var d resource.Droplet
-	if _, err := c.client.post(ctx, "/v3/droplets", r, &d); err != nil {
+	if _, err := c.client.post(ctx, "Droplets.Create", "/v3/droplets", r, &d); err != nil {
 		return nil, err
 	}
 	return &d, nil
@@ -94,7 +94,7 @@ func (c *DropletClient) Create(ctx context.Context, r *resource.DropletCreate) (
 
 // Delete the specified droplet asynchronously and return a jobGUID.
 func (c *DropletClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/droplets/%s", guid))
+	return c.client.delete(ctx, "Droplets.Delete", path.Format("/v3/droplets/%s", guid))
 }
 
 // Download a gzip compressed tarball file containing a Cloud Foundry compatible droplet
@@ -104,7 +104,7 @@ func (c *DropletClient) Download(ctx context.Context, guid string) (io.ReadClose
 	// The client will not automatically follow this redirect and uses a secondary
 	// unauthenticated client to download the bits
 	// https://v3-apidocs.cloudfoundry.org/version/3.127.0/index.html#download-droplet-bits
-	return c.client.download(ctx, path.Format("/v3/droplets/%s/download", guid))
+	return c.client.download(ctx, "Droplets.Download", path.Format("/v3/droplets/%s/download", guid))
 }
 
 // First returns the first droplet matching the options or an error when less than 1 match
@@ -131,7 +131,7 @@ func (c *DropletClient) FirstForPackage(ctx context.Context, packageGUID string,
 // Get retrieves the droplet by ID
 func (c *DropletClient) Get(ctx context.Context, guid string) (*resource.Droplet, error) {
 	var d resource.Droplet
-	err := c.client.get(ctx, path.Format("/v3/droplets/%s", guid), &d)
+	err := c.client.get(ctx, "Droplets.Get", path.Format("/v3/droplets/%s", guid), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +144,7 @@ func (c *DropletClient) List(ctx context.Context, opts *DropletListOptions) ([]*
 		opts = NewDropletListOptions()
 	}
 	var res resource.DropletList
-	err := c.client.list(ctx, "/v3/droplets", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Droplets.List", "/v3/droplets", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -157,7 +157,17 @@ func (c *DropletClient) ListAll(ctx context.Context, opts *DropletListOptions) (
 	if opts == nil {
 		opts = NewDropletListOptions()
 	}
-	return AutoPage[*DropletListOptions, *resource.Droplet](opts, func(opts *DropletListOptions) ([]*resource.Droplet, *Pager, error) {
+	return AutoPageConcurrent[*DropletListOptions, *resource.Droplet](opts, c.client.PageConcurrency(), func(opts *DropletListOptions) ([]*resource.Droplet, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all droplets the user has access to
+func (c *DropletClient) Iter(ctx context.Context, opts *DropletListOptions) Seq2[*resource.Droplet, error] {
+	if opts == nil {
+		opts = NewDropletListOptions()
+	}
+	return AutoPageIter[*DropletListOptions, *resource.Droplet](ctx, opts, func(opts *DropletListOptions) ([]*resource.Droplet, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -168,7 +178,7 @@ func (c *DropletClient) ListForApp(ctx context.Context, appGUID string, opts *Dr
 		opts = NewDropletAppListOptions()
 	}
 	var res resource.DropletList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/droplets", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Droplets.ListForApp", "/v3/apps/"+appGUID+"/droplets", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,7 +191,17 @@ func (c *DropletClient) ListForAppAll(ctx context.Context, appGUID string, opts
 	if opts == nil {
 		opts = NewDropletAppListOptions()
 	}
-	return AutoPage[*DropletAppListOptions, *resource.Droplet](opts, func(opts *DropletAppListOptions) ([]*resource.Droplet, *Pager, error) {
+	return AutoPageConcurrent[*DropletAppListOptions, *resource.Droplet](opts, c.client.PageConcurrency(), func(opts *DropletAppListOptions) ([]*resource.Droplet, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all droplets for the specified app
+func (c *DropletClient) IterForApp(ctx context.Context, appGUID string, opts *DropletAppListOptions) Seq2[*resource.Droplet, error] {
+	if opts == nil {
+		opts = NewDropletAppListOptions()
+	}
+	return AutoPageIter[*DropletAppListOptions, *resource.Droplet](ctx, opts, func(opts *DropletAppListOptions) ([]*resource.Droplet, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -192,7 +212,7 @@ func (c *DropletClient) ListForPackage(ctx context.Context, packageGUID string,
 		opts = NewDropletPackageListOptions()
 	}
 	var res resource.DropletList
-	err := c.client.list(ctx, "/v3/packages/"+packageGUID+"/droplets", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Droplets.ListForPackage", "/v3/packages/"+packageGUID+"/droplets", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -205,7 +225,17 @@ func (c *DropletClient) ListForPackageAll(ctx context.Context, packageGUID strin
 	if opts == nil {
 		opts = NewDropletPackageListOptions()
 	}
-	return AutoPage[*DropletPackageListOptions, *resource.Droplet](opts, func(opts *DropletPackageListOptions) ([]*resource.Droplet, *Pager, error) {
+	return AutoPageConcurrent[*DropletPackageListOptions, *resource.Droplet](opts, c.client.PageConcurrency(), func(opts *DropletPackageListOptions) ([]*resource.Droplet, *Pager, error) {
+		return c.ListForPackage(ctx, packageGUID, opts)
+	})
+}
+
+// IterForPackage returns an iterator over all droplets for the specified package
+func (c *DropletClient) IterForPackage(ctx context.Context, packageGUID string, opts *DropletPackageListOptions) Seq2[*resource.Droplet, error] {
+	if opts == nil {
+		opts = NewDropletPackageListOptions()
+	}
+	return AutoPageIter[*DropletPackageListOptions, *resource.Droplet](ctx, opts, func(opts *DropletPackageListOptions) ([]*resource.Droplet, *Pager, error) {
 		return c.ListForPackage(ctx, packageGUID, opts)
 	})
 }
@@ -213,7 +243,7 @@ func (c *DropletClient) ListForPackageAll(ctx context.Context, packageGUID strin
 // GetCurrentAssociationForApp retrieves the current droplet relationship for an app
 func (c *DropletClient) GetCurrentAssociationForApp(ctx context.Context, appGUID string) (*resource.DropletCurrent, error) {
 	var d resource.DropletCurrent
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/relationships/current_droplet", appGUID), &d)
+	err := c.client.get(ctx, "Droplets.GetCurrentAssociationForApp", path.Format("/v3/apps/%s/relationships/current_droplet", appGUID), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +253,7 @@ func (c *DropletClient) GetCurrentAssociationForApp(ctx context.Context, appGUID
 // GetCurrentForApp retrieves the current droplet for an app
 func (c *DropletClient) GetCurrentForApp(ctx context.Context, appGUID string) (*resource.Droplet, error) {
 	var d resource.Droplet
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/droplets/current", appGUID), &d)
+	err := c.client.get(ctx, "Droplets.GetCurrentForApp", path.Format("/v3/apps/%s/droplets/current", appGUID), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +264,7 @@ func (c *DropletClient) GetCurrentForApp(ctx context.Context, appGUID string) (*
 func (c *DropletClient) SetCurrentAssociationForApp(ctx context.Context, appGUID, dropletGUID string) (*resource.DropletCurrent, error) {
 	var d resource.DropletCurrent
 	r := resource.ToOneRelationship{Data: &resource.Relationship{GUID: dropletGUID}}
-	_, err := c.client.patch(ctx, path.Format("/v3/apps/%s/relationships/current_droplet", appGUID), r, &d)
+	_, err := c.client.patch(ctx, "Droplets.SetCurrentAssociationForApp", path.Format("/v3/apps/%s/relationships/current_droplet", appGUID), r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +295,7 @@ func (c *DropletClient) SingleForPackage(ctx context.Context, packageGUID string
 // Update an existing droplet
 func (c *DropletClient) Update(ctx context.Context, guid string, r *resource.DropletUpdate) (*resource.Droplet, error) {
 	var d resource.Droplet
-	_, err := c.client.patch(ctx, path.Format("/v3/droplets/%s", guid), r, &d)
+	_, err := c.client.patch(ctx, "Droplets.Update", path.Format("/v3/droplets/%s", guid), r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -276,7 +306,7 @@ func (c *DropletClient) Update(ctx context.Context, guid string, r *resource.Dro
 func (c *DropletClient) Upload(ctx context.Context, guid string, tgzDroplet io.Reader) (string, *resource.Droplet, error) {
 	p := path.Format("/v3/droplets/%s/upload", guid)
 	var d resource.Droplet
-	jobGUID, err := c.client.postFileUpload(ctx, p, "bits", "droplet.tgz", tgzDroplet, &d)
+	jobGUID, err := c.client.postFileUpload(ctx, "Droplets.Upload", p, "bits", "droplet.tgz", tgzDroplet, &d)
 	if err != nil {
 		return "", nil, err
 	}
@@ -11,7 +11,7 @@ type EnvVarGroupClient commonClient
 // Get retrieves the specified envvar group
 func (c *EnvVarGroupClient) Get(ctx context.Context, name string) (*resource.EnvVarGroup, error) {
 	var e resource.EnvVarGroup
-	err := c.client.get(ctx, path.Format("/v3/environment_variable_groups/%s", name), &e)
+	err := c.client.get(ctx, "EnvVarGroups.Get", path.Format("/v3/environment_variable_groups/%s", name), &e)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +31,7 @@ func (c *EnvVarGroupClient) GetStaging(ctx context.Context) (*resource.EnvVarGro
 // Update the specified attributes of the envar group
 func (c *EnvVarGroupClient) Update(ctx context.Context, name string, r *resource.EnvVarGroupUpdate) (*resource.EnvVarGroup, error) {
 	var e resource.EnvVarGroup
-	_, err := c.client.patch(ctx, path.Format("/v3/environment_variable_groups/%s", name), r, &e)
+	_, err := c.client.patch(ctx, "EnvVarGroups.Update", path.Format("/v3/environment_variable_groups/%s", name), r, &e)
 	if err != nil {
 		return nil, err
 	}
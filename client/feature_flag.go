@@ -29,7 +29,7 @@ func (o FeatureFlagListOptions) ToQueryString() (url.Values, error) {
 // Get the specified feature flag
 func (c *FeatureFlagClient) Get(ctx context.Context, featureFlag resource.FeatureFlagType) (*resource.FeatureFlag, error) {
 	var ff resource.FeatureFlag
-	err := c.client.get(ctx, path.Format("/v3/feature_flags/%s", featureFlag), &ff)
+	err := c.client.get(ctx, "FeatureFlags.Get", path.Format("/v3/feature_flags/%s", featureFlag), &ff)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (c *FeatureFlagClient) List(ctx context.Context, opts *FeatureFlagListOptio
 		opts = NewFeatureFlagListOptions()
 	}
 	var res resource.FeatureFlagList
-	err := c.client.list(ctx, "/v3/feature_flags", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "FeatureFlags.List", "/v3/feature_flags", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,7 +55,17 @@ func (c *FeatureFlagClient) ListAll(ctx context.Context, opts *FeatureFlagListOp
 	if opts == nil {
 		opts = NewFeatureFlagListOptions()
 	}
-	return AutoPage[*FeatureFlagListOptions, *resource.FeatureFlag](opts, func(opts *FeatureFlagListOptions) ([]*resource.FeatureFlag, *Pager, error) {
+	return AutoPageConcurrent[*FeatureFlagListOptions, *resource.FeatureFlag](opts, c.client.PageConcurrency(), func(opts *FeatureFlagListOptions) ([]*resource.FeatureFlag, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all feature flags
+func (c *FeatureFlagClient) Iter(ctx context.Context, opts *FeatureFlagListOptions) Seq2[*resource.FeatureFlag, error] {
+	if opts == nil {
+		opts = NewFeatureFlagListOptions()
+	}
+	return AutoPageIter[*FeatureFlagListOptions, *resource.FeatureFlag](ctx, opts, func(opts *FeatureFlagListOptions) ([]*resource.FeatureFlag, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -63,7 +73,7 @@ func (c *FeatureFlagClient) ListAll(ctx context.Context, opts *FeatureFlagListOp
 // Update the specified attributes of the feature flag
 func (c *FeatureFlagClient) Update(ctx context.Context, featureFlag resource.FeatureFlagType, r *resource.FeatureFlagUpdate) (*resource.FeatureFlag, error) {
 	var d resource.FeatureFlag
-	_, err := c.client.patch(ctx, path.Format("/v3/feature_flags/%s", featureFlag), r, &d)
+	_, err := c.client.patch(ctx, "FeatureFlags.Update", path.Format("/v3/feature_flags/%s", featureFlag), r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,1841 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// ClientInterface is the interface implemented by Client, it allows code that uses the client to be tested
+// with the mocks in the client/mocks package
+type ClientInterface interface {
+	// ExecuteAuthRequest executes an HTTP request with authentication.
+	ExecuteAuthRequest(req *http.Request) (*http.Response, error)
+	// ExecuteRequest executes an HTTP request without authentication.
+	ExecuteRequest(req *http.Request) (*http.Response, error)
+	// SSHCode generates an SSH code that can be used by generic SSH clients to SSH into app instances
+	SSHCode(ctx context.Context) (string, error)
+
+	// AdminService returns the AdminService used to communicate with the Admin endpoints
+	AdminService() AdminService
+	// ApplicationsService returns the AppService used to communicate with the Applications endpoints
+	ApplicationsService() AppService
+	// AppFeaturesService returns the AppFeatureService used to communicate with the AppFeatures endpoints
+	AppFeaturesService() AppFeatureService
+	// AppUsageEventsService returns the AppUsageService used to communicate with the AppUsageEvents endpoints
+	AppUsageEventsService() AppUsageService
+	// AuditEventsService returns the AuditEventService used to communicate with the AuditEvents endpoints
+	AuditEventsService() AuditEventService
+	// BuildpacksService returns the BuildpackService used to communicate with the Buildpacks endpoints
+	BuildpacksService() BuildpackService
+	// BuildsService returns the BuildService used to communicate with the Builds endpoints
+	BuildsService() BuildService
+	// DeploymentsService returns the DeploymentService used to communicate with the Deployments endpoints
+	DeploymentsService() DeploymentService
+	// DomainsService returns the DomainService used to communicate with the Domains endpoints
+	DomainsService() DomainService
+	// DropletsService returns the DropletService used to communicate with the Droplets endpoints
+	DropletsService() DropletService
+	// EnvVarGroupsService returns the EnvVarGroupService used to communicate with the EnvVarGroups endpoints
+	EnvVarGroupsService() EnvVarGroupService
+	// FeatureFlagsService returns the FeatureFlagService used to communicate with the FeatureFlags endpoints
+	FeatureFlagsService() FeatureFlagService
+	// IsolationSegmentsService returns the IsolationSegmentService used to communicate with the IsolationSegments endpoints
+	IsolationSegmentsService() IsolationSegmentService
+	// JobsService returns the JobService used to communicate with the Jobs endpoints
+	JobsService() JobService
+	// LogCacheService returns the LogCacheService used to communicate with the LogCache endpoints
+	LogCacheService() LogCacheService
+	// LogsService returns the LogStreamService used to communicate with the Logs endpoints
+	LogsService() LogStreamService
+	// ManifestsService returns the ManifestService used to communicate with the Manifests endpoints
+	ManifestsService() ManifestService
+	// NetworkPoliciesService returns the NetworkPolicyService used to communicate with the NetworkPolicies endpoints
+	NetworkPoliciesService() NetworkPolicyService
+	// OrganizationsService returns the OrganizationService used to communicate with the Organizations endpoints
+	OrganizationsService() OrganizationService
+	// OrganizationQuotasService returns the OrganizationQuotaService used to communicate with the OrganizationQuotas endpoints
+	OrganizationQuotasService() OrganizationQuotaService
+	// PackagesService returns the PackageService used to communicate with the Packages endpoints
+	PackagesService() PackageService
+	// ProcessesService returns the ProcessService used to communicate with the Processes endpoints
+	ProcessesService() ProcessService
+	// RevisionsService returns the RevisionService used to communicate with the Revisions endpoints
+	RevisionsService() RevisionService
+	// ResourceMatchesService returns the ResourceMatchService used to communicate with the ResourceMatches endpoints
+	ResourceMatchesService() ResourceMatchService
+	// RolesService returns the RoleService used to communicate with the Roles endpoints
+	RolesService() RoleService
+	// RootService returns the RootService used to communicate with the Root endpoints
+	RootService() RootService
+	// RoutesService returns the RouteService used to communicate with the Routes endpoints
+	RoutesService() RouteService
+	// RoutingAPIService returns the RoutingAPIService used to communicate with the RoutingAPI endpoints
+	RoutingAPIService() RoutingAPIService
+	// SecurityGroupsService returns the SecurityGroupService used to communicate with the SecurityGroups endpoints
+	SecurityGroupsService() SecurityGroupService
+	// ServiceBrokersService returns the ServiceBrokerService used to communicate with the ServiceBrokers endpoints
+	ServiceBrokersService() ServiceBrokerService
+	// ServiceCredentialBindingsService returns the ServiceCredentialBindingService used to communicate with the ServiceCredentialBindings endpoints
+	ServiceCredentialBindingsService() ServiceCredentialBindingService
+	// ServiceInstancesService returns the ServiceInstanceService used to communicate with the ServiceInstances endpoints
+	ServiceInstancesService() ServiceInstanceService
+	// ServiceOfferingsService returns the ServiceOfferingService used to communicate with the ServiceOfferings endpoints
+	ServiceOfferingsService() ServiceOfferingService
+	// ServicePlansService returns the ServicePlanService used to communicate with the ServicePlans endpoints
+	ServicePlansService() ServicePlanService
+	// ServicePlansVisibilityService returns the ServicePlanVisibilityService used to communicate with the ServicePlansVisibility endpoints
+	ServicePlansVisibilityService() ServicePlanVisibilityService
+	// ServiceRouteBindingsService returns the ServiceRouteBindingService used to communicate with the ServiceRouteBindings endpoints
+	ServiceRouteBindingsService() ServiceRouteBindingService
+	// ServiceUsageEventsService returns the ServiceUsageService used to communicate with the ServiceUsageEvents endpoints
+	ServiceUsageEventsService() ServiceUsageService
+	// SidecarsService returns the SidecarService used to communicate with the Sidecars endpoints
+	SidecarsService() SidecarService
+	// SpacesService returns the SpaceService used to communicate with the Spaces endpoints
+	SpacesService() SpaceService
+	// SpaceFeaturesService returns the SpaceFeatureService used to communicate with the SpaceFeatures endpoints
+	SpaceFeaturesService() SpaceFeatureService
+	// SpaceQuotasService returns the SpaceQuotaService used to communicate with the SpaceQuotas endpoints
+	SpaceQuotasService() SpaceQuotaService
+	// StacksService returns the StackService used to communicate with the Stacks endpoints
+	StacksService() StackService
+	// TasksService returns the TaskService used to communicate with the Tasks endpoints
+	TasksService() TaskService
+	// UsersService returns the UserService used to communicate with the Users endpoints
+	UsersService() UserService
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// AdminService returns the AdminService used to communicate with the Admin endpoints
+func (c *Client) AdminService() AdminService {
+	return c.Admin
+}
+
+// ApplicationsService returns the AppService used to communicate with the Applications endpoints
+func (c *Client) ApplicationsService() AppService {
+	return c.Applications
+}
+
+// AppFeaturesService returns the AppFeatureService used to communicate with the AppFeatures endpoints
+func (c *Client) AppFeaturesService() AppFeatureService {
+	return c.AppFeatures
+}
+
+// AppUsageEventsService returns the AppUsageService used to communicate with the AppUsageEvents endpoints
+func (c *Client) AppUsageEventsService() AppUsageService {
+	return c.AppUsageEvents
+}
+
+// AuditEventsService returns the AuditEventService used to communicate with the AuditEvents endpoints
+func (c *Client) AuditEventsService() AuditEventService {
+	return c.AuditEvents
+}
+
+// BuildpacksService returns the BuildpackService used to communicate with the Buildpacks endpoints
+func (c *Client) BuildpacksService() BuildpackService {
+	return c.Buildpacks
+}
+
+// BuildsService returns the BuildService used to communicate with the Builds endpoints
+func (c *Client) BuildsService() BuildService {
+	return c.Builds
+}
+
+// DeploymentsService returns the DeploymentService used to communicate with the Deployments endpoints
+func (c *Client) DeploymentsService() DeploymentService {
+	return c.Deployments
+}
+
+// DomainsService returns the DomainService used to communicate with the Domains endpoints
+func (c *Client) DomainsService() DomainService {
+	return c.Domains
+}
+
+// DropletsService returns the DropletService used to communicate with the Droplets endpoints
+func (c *Client) DropletsService() DropletService {
+	return c.Droplets
+}
+
+// EnvVarGroupsService returns the EnvVarGroupService used to communicate with the EnvVarGroups endpoints
+func (c *Client) EnvVarGroupsService() EnvVarGroupService {
+	return c.EnvVarGroups
+}
+
+// FeatureFlagsService returns the FeatureFlagService used to communicate with the FeatureFlags endpoints
+func (c *Client) FeatureFlagsService() FeatureFlagService {
+	return c.FeatureFlags
+}
+
+// IsolationSegmentsService returns the IsolationSegmentService used to communicate with the IsolationSegments endpoints
+func (c *Client) IsolationSegmentsService() IsolationSegmentService {
+	return c.IsolationSegments
+}
+
+// JobsService returns the JobService used to communicate with the Jobs endpoints
+func (c *Client) JobsService() JobService {
+	return c.Jobs
+}
+
+// LogCacheService returns the LogCacheService used to communicate with the LogCache endpoints
+func (c *Client) LogCacheService() LogCacheService {
+	return c.LogCache
+}
+
+// LogsService returns the LogStreamService used to communicate with the Logs endpoints
+func (c *Client) LogsService() LogStreamService {
+	return c.Logs
+}
+
+// ManifestsService returns the ManifestService used to communicate with the Manifests endpoints
+func (c *Client) ManifestsService() ManifestService {
+	return c.Manifests
+}
+
+// NetworkPoliciesService returns the NetworkPolicyService used to communicate with the NetworkPolicies endpoints
+func (c *Client) NetworkPoliciesService() NetworkPolicyService {
+	return c.NetworkPolicies
+}
+
+// OrganizationsService returns the OrganizationService used to communicate with the Organizations endpoints
+func (c *Client) OrganizationsService() OrganizationService {
+	return c.Organizations
+}
+
+// OrganizationQuotasService returns the OrganizationQuotaService used to communicate with the OrganizationQuotas endpoints
+func (c *Client) OrganizationQuotasService() OrganizationQuotaService {
+	return c.OrganizationQuotas
+}
+
+// PackagesService returns the PackageService used to communicate with the Packages endpoints
+func (c *Client) PackagesService() PackageService {
+	return c.Packages
+}
+
+// ProcessesService returns the ProcessService used to communicate with the Processes endpoints
+func (c *Client) ProcessesService() ProcessService {
+	return c.Processes
+}
+
+// RevisionsService returns the RevisionService used to communicate with the Revisions endpoints
+func (c *Client) RevisionsService() RevisionService {
+	return c.Revisions
+}
+
+// ResourceMatchesService returns the ResourceMatchService used to communicate with the ResourceMatches endpoints
+func (c *Client) ResourceMatchesService() ResourceMatchService {
+	return c.ResourceMatches
+}
+
+// RolesService returns the RoleService used to communicate with the Roles endpoints
+func (c *Client) RolesService() RoleService {
+	return c.Roles
+}
+
+// RootService returns the RootService used to communicate with the Root endpoints
+func (c *Client) RootService() RootService {
+	return c.Root
+}
+
+// RoutesService returns the RouteService used to communicate with the Routes endpoints
+func (c *Client) RoutesService() RouteService {
+	return c.Routes
+}
+
+// RoutingAPIService returns the RoutingAPIService used to communicate with the RoutingAPI endpoints
+func (c *Client) RoutingAPIService() RoutingAPIService {
+	return c.RoutingAPI
+}
+
+// SecurityGroupsService returns the SecurityGroupService used to communicate with the SecurityGroups endpoints
+func (c *Client) SecurityGroupsService() SecurityGroupService {
+	return c.SecurityGroups
+}
+
+// ServiceBrokersService returns the ServiceBrokerService used to communicate with the ServiceBrokers endpoints
+func (c *Client) ServiceBrokersService() ServiceBrokerService {
+	return c.ServiceBrokers
+}
+
+// ServiceCredentialBindingsService returns the ServiceCredentialBindingService used to communicate with the ServiceCredentialBindings endpoints
+func (c *Client) ServiceCredentialBindingsService() ServiceCredentialBindingService {
+	return c.ServiceCredentialBindings
+}
+
+// ServiceInstancesService returns the ServiceInstanceService used to communicate with the ServiceInstances endpoints
+func (c *Client) ServiceInstancesService() ServiceInstanceService {
+	return c.ServiceInstances
+}
+
+// ServiceOfferingsService returns the ServiceOfferingService used to communicate with the ServiceOfferings endpoints
+func (c *Client) ServiceOfferingsService() ServiceOfferingService {
+	return c.ServiceOfferings
+}
+
+// ServicePlansService returns the ServicePlanService used to communicate with the ServicePlans endpoints
+func (c *Client) ServicePlansService() ServicePlanService {
+	return c.ServicePlans
+}
+
+// ServicePlansVisibilityService returns the ServicePlanVisibilityService used to communicate with the ServicePlansVisibility endpoints
+func (c *Client) ServicePlansVisibilityService() ServicePlanVisibilityService {
+	return c.ServicePlansVisibility
+}
+
+// ServiceRouteBindingsService returns the ServiceRouteBindingService used to communicate with the ServiceRouteBindings endpoints
+func (c *Client) ServiceRouteBindingsService() ServiceRouteBindingService {
+	return c.ServiceRouteBindings
+}
+
+// ServiceUsageEventsService returns the ServiceUsageService used to communicate with the ServiceUsageEvents endpoints
+func (c *Client) ServiceUsageEventsService() ServiceUsageService {
+	return c.ServiceUsageEvents
+}
+
+// SidecarsService returns the SidecarService used to communicate with the Sidecars endpoints
+func (c *Client) SidecarsService() SidecarService {
+	return c.Sidecars
+}
+
+// SpacesService returns the SpaceService used to communicate with the Spaces endpoints
+func (c *Client) SpacesService() SpaceService {
+	return c.Spaces
+}
+
+// SpaceFeaturesService returns the SpaceFeatureService used to communicate with the SpaceFeatures endpoints
+func (c *Client) SpaceFeaturesService() SpaceFeatureService {
+	return c.SpaceFeatures
+}
+
+// SpaceQuotasService returns the SpaceQuotaService used to communicate with the SpaceQuotas endpoints
+func (c *Client) SpaceQuotasService() SpaceQuotaService {
+	return c.SpaceQuotas
+}
+
+// StacksService returns the StackService used to communicate with the Stacks endpoints
+func (c *Client) StacksService() StackService {
+	return c.Stacks
+}
+
+// TasksService returns the TaskService used to communicate with the Tasks endpoints
+func (c *Client) TasksService() TaskService {
+	return c.Tasks
+}
+
+// UsersService returns the UserService used to communicate with the Users endpoints
+func (c *Client) UsersService() UserService {
+	return c.Users
+}
+
+// AdminService is the interface implemented by AdminClient
+type AdminService interface {
+	// ClearBuildpackCache will delete all the existing buildpack caches in the blobstore. Success returns a JobID.
+	//
+	// The buildpack cache is used during staging by buildpacks as a way to cache certain resources, e.g. downloaded
+	// Ruby gems. An admin who wants to decrease the size of their blobstore could use this endpoint to delete
+	// unnecessary blobs.
+	ClearBuildpackCache(ctx context.Context) (string, error)
+}
+
+var _ AdminService = (*AdminClient)(nil)
+
+// AppService is the interface implemented by AppClient
+type AppService interface {
+	// Create a new app
+	Create(ctx context.Context, r *resource.AppCreate) (*resource.App, error)
+	// Delete the specified app asynchronously and return a jobGUID.
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first app matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *AppListOptions) (*resource.App, error)
+	// Get the specified app
+	Get(ctx context.Context, guid string) (*resource.App, error)
+	// GetEnvironment retrieves the environment variables that will be provided to an app at runtime.
+	// It will include environment variables for Environment Variable Groups and Service Bindings.
+	GetEnvironment(ctx context.Context, guid string) (*resource.AppEnvironment, error)
+	// GetEnvironmentVariables retrieves the environment variables that are associated with the given app
+	GetEnvironmentVariables(ctx context.Context, guid string) (map[string]*string, error)
+	// GetIncludeSpace allows callers to fetch an app and include the parent space
+	GetIncludeSpace(ctx context.Context, guid string) (*resource.App, *resource.Space, error)
+	// GetIncludeSpaceAndOrganization allows callers to fetch an app and include the parent space and organizations
+	GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.App, *resource.Space, *resource.Organization, error)
+	// Iter returns an iterator over all apps the user has access to
+	Iter(ctx context.Context, opts *AppListOptions) Seq2[*resource.App, error]
+	// List pages all the apps the user has access to
+	List(ctx context.Context, opts *AppListOptions) ([]*resource.App, *Pager, error)
+	// ListAll retrieves all apps the user has access to
+	ListAll(ctx context.Context, opts *AppListOptions) ([]*resource.App, error)
+	// ListIncludeSpaces page all apps the user has access to and include the associated spaces
+	ListIncludeSpaces(ctx context.Context, opts *AppListOptions) ([]*resource.App, []*resource.Space, *Pager, error)
+	// ListIncludeSpacesAll retrieves all apps the user has access to and include the associated spaces
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeSpaces to fetch them a page at
+	// a time instead.
+	ListIncludeSpacesAll(ctx context.Context, opts *AppListOptions) ([]*resource.App, []*resource.Space, error)
+	// ListIncludeSpacesAndOrganizations page all apps the user has access to and include the associated spaces and organizations
+	ListIncludeSpacesAndOrganizations(ctx context.Context, opts *AppListOptions) ([]*resource.App, []*resource.Space, []*resource.Organization, *Pager, error)
+	// ListIncludeSpacesAndOrganizationsAll retrieves all apps the user has access to and include the associated spaces and organizations
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeSpacesAndOrganizations to fetch them a page at
+	// a time instead.
+	ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *AppListOptions) ([]*resource.App, []*resource.Space, []*resource.Organization, error)
+	// Permissions gets the current user’s permissions for the given app.
+	// If a user can see an app, then they can see its basic data.
+	// Only admin, read-only admins, and space developers can read sensitive data.
+	Permissions(ctx context.Context, guid string) (*resource.AppPermissions, error)
+	// Restart will synchronously stop and start an application.
+	// Unlike the start and stop actions, this endpoint will error if the app is not successfully stopped in the runtime.
+	// For restarting applications without downtime, see the Deployments resource.
+	Restart(ctx context.Context, guid string) (*resource.App, error)
+	// SSHEnabled returns if an application’s runtime environment will accept ssh connections.
+	// If ssh is disabled, the reason field will describe whether it is disabled globally,
+	// at the space level, or at the app level.
+	SSHEnabled(ctx context.Context, guid string) (*resource.AppSSHEnabled, error)
+	// SetEnvironmentVariables updates the environment variables associated with the given app.
+	// The variables given in the request will be merged with the existing app environment variables.
+	// Any requested variables with a value of null will be removed from the app.
+	//
+	// Environment variable names may not start with VCAP_
+	// PORT is not a valid environment variable.
+	SetEnvironmentVariables(ctx context.Context, guid string, envRequest map[string]*string) (map[string]*string, error)
+	// Single returns a single app matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *AppListOptions) (*resource.App, error)
+	// Start the app if not already started
+	Start(ctx context.Context, guid string) (*resource.App, error)
+	// Stop the app if not already stopped
+	Stop(ctx context.Context, guid string) (*resource.App, error)
+	// Update the specified attributes of the app
+	Update(ctx context.Context, guid string, r *resource.AppUpdate) (*resource.App, error)
+	// WaitForRunning waits until the desired number of instances of each of the app's processes are RUNNING. An app
+	// is STARTED as soon as it's started, not once its instances are healthy, so use this after starting an app to know
+	// it's actually up. If any instance crashes an error wrapping ErrAppInstanceCrashed is returned straight away with
+	// the instance's details.
+	WaitForRunning(ctx context.Context, guid string, opts *PollingOptions) error
+}
+
+var _ AppService = (*AppClient)(nil)
+
+// AppFeatureService is the interface implemented by AppFeatureClient
+type AppFeatureService interface {
+	// Get retrieves the named app feature
+	Get(ctx context.Context, appGUID string, featureName string) (*resource.AppFeature, error)
+	// GetRevisions retrieves the revisions app feature
+	GetRevisions(ctx context.Context, appGUID string) (*resource.AppFeature, error)
+	// GetSSH retrieves the SSH app feature
+	GetSSH(ctx context.Context, appGUID string) (*resource.AppFeature, error)
+	// List pages all app features
+	List(ctx context.Context, appGUID string) ([]*resource.AppFeature, *Pager, error)
+	// Update the enabled attribute of the named app feature
+	Update(ctx context.Context, appGUID string, featureName string, enabled bool) (*resource.AppFeature, error)
+	// UpdateRevisions updated the enabled attribute of the revisions app feature
+	UpdateRevisions(ctx context.Context, appGUID string, enabled bool) (*resource.AppFeature, error)
+	// UpdateSSH updated the enabled attribute of the SSH app feature
+	UpdateSSH(ctx context.Context, appGUID string, enabled bool) (*resource.AppFeature, error)
+}
+
+var _ AppFeatureService = (*AppFeatureClient)(nil)
+
+// AppUsageService is the interface implemented by AppUsageClient
+type AppUsageService interface {
+	// Get retrieves the specified app event
+	Get(ctx context.Context, guid string) (*resource.AppUsage, error)
+	// Iter returns an iterator over all app usage events
+	Iter(ctx context.Context, opts *AppUsageListOptions) Seq2[*resource.AppUsage, error]
+	// List pages all app usage events
+	List(ctx context.Context, opts *AppUsageListOptions) ([]*resource.AppUsage, *Pager, error)
+	// ListAll retrieves all app usage events
+	ListAll(ctx context.Context, opts *AppUsageListOptions) ([]*resource.AppUsage, error)
+	// Purge destroys all existing events. Populates new usage events, one for each started app.
+	// All populated events will have a created_at value of current time.
+	//
+	// There is the potential race condition if apps are currently being started, stopped, or scaled.
+	// The seeded usage events will have the same guid as the app.
+	Purge(ctx context.Context) error
+}
+
+var _ AppUsageService = (*AppUsageClient)(nil)
+
+// AuditEventService is the interface implemented by AuditEventClient
+type AuditEventService interface {
+	// First returns the first audit event matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *AuditEventListOptions) (*resource.AuditEvent, error)
+	// Get retrieves the specified audit event
+	Get(ctx context.Context, guid string) (*resource.AuditEvent, error)
+	// Iter returns an iterator over all audit events the user has access to
+	Iter(ctx context.Context, opts *AuditEventListOptions) Seq2[*resource.AuditEvent, error]
+	// List pages all audit events the user has access to
+	List(ctx context.Context, opts *AuditEventListOptions) ([]*resource.AuditEvent, *Pager, error)
+	// ListAll retrieves all audit events the user has access to
+	ListAll(ctx context.Context, opts *AuditEventListOptions) ([]*resource.AuditEvent, error)
+	// Single returns a single audit event matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *AuditEventListOptions) (*resource.AuditEvent, error)
+}
+
+var _ AuditEventService = (*AuditEventClient)(nil)
+
+// BuildpackService is the interface implemented by BuildpackClient
+type BuildpackService interface {
+	// Create a new buildpack
+	Create(ctx context.Context, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error)
+	// Delete the specified buildpack
+	Delete(ctx context.Context, guid string) error
+	// First returns the first buildpack matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *BuildpackListOptions) (*resource.Buildpack, error)
+	// Get retrieves the specified buildpack
+	Get(ctx context.Context, guid string) (*resource.Buildpack, error)
+	// Iter returns an iterator over all buildpacks the user has access to
+	Iter(ctx context.Context, opts *BuildpackListOptions) Seq2[*resource.Buildpack, error]
+	// List pages all buildpacks the user has access to
+	List(ctx context.Context, opts *BuildpackListOptions) ([]*resource.Buildpack, *Pager, error)
+	// ListAll retrieves all buildpacks the user has access to
+	ListAll(ctx context.Context, opts *BuildpackListOptions) ([]*resource.Buildpack, error)
+	// Single returns a single buildpack matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *BuildpackListOptions) (*resource.Buildpack, error)
+	// Update the specified attributes of the buildpack
+	Update(ctx context.Context, guid string, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error)
+	// Upload a gzip compressed (zip) file containing a Cloud Foundry compatible buildpack
+	Upload(ctx context.Context, guid string, zipFile io.Reader) (string, *resource.Buildpack, error)
+}
+
+var _ BuildpackService = (*BuildpackClient)(nil)
+
+// BuildService is the interface implemented by BuildClient
+type BuildService interface {
+	// Create a new build
+	Create(ctx context.Context, r *resource.BuildCreate) (*resource.Build, error)
+	// Delete the specified build
+	Delete(ctx context.Context, guid string) error
+	// First returns the first build matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *BuildListOptions) (*resource.Build, error)
+	// FirstForApp returns the first build matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *BuildAppListOptions) (*resource.Build, error)
+	// Get the specified build
+	Get(ctx context.Context, guid string) (*resource.Build, error)
+	// Iter returns an iterator over all builds the user has access to
+	Iter(ctx context.Context, opts *BuildListOptions) Seq2[*resource.Build, error]
+	// IterForApp returns an iterator over all builds for the app the user has access to
+	IterForApp(ctx context.Context, appGUID string, opts *BuildAppListOptions) Seq2[*resource.Build, error]
+	// List pages all builds the user has access to
+	List(ctx context.Context, opts *BuildListOptions) ([]*resource.Build, *Pager, error)
+	// ListAll retrieves all builds the user has access to
+	ListAll(ctx context.Context, opts *BuildListOptions) ([]*resource.Build, error)
+	// ListForApp pages all builds for the app the user has access to
+	ListForApp(ctx context.Context, appGUID string, opts *BuildAppListOptions) ([]*resource.Build, *Pager, error)
+	// ListForAppAll retrieves all builds for the app the user has access to
+	ListForAppAll(ctx context.Context, appGUID string, opts *BuildAppListOptions) ([]*resource.Build, error)
+	// PollStaged waits until the build is staged, fails, or times out
+	PollStaged(ctx context.Context, guid string, opts *PollingOptions) error
+	// Single returns a single build matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *BuildListOptions) (*resource.Build, error)
+	// SingleForApp returns a single build matching the options and app or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *BuildAppListOptions) (*resource.Build, error)
+	// Update the specified attributes of the build
+	Update(ctx context.Context, guid string, r *resource.BuildUpdate) (*resource.Build, error)
+}
+
+var _ BuildService = (*BuildClient)(nil)
+
+// DeploymentService is the interface implemented by DeploymentClient
+type DeploymentService interface {
+	// Cancel the ongoing deployment
+	Cancel(ctx context.Context, guid string) error
+	// Continue the paused canary deployment to its next step
+	Continue(ctx context.Context, guid string) error
+	// Create a new deployment
+	Create(ctx context.Context, r *resource.DeploymentCreate) (*resource.Deployment, error)
+	// First returns the first deployment matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *DeploymentListOptions) (*resource.Deployment, error)
+	// Get the specified deployment
+	Get(ctx context.Context, guid string) (*resource.Deployment, error)
+	// Iter returns an iterator over all deployments the user has access to
+	Iter(ctx context.Context, opts *DeploymentListOptions) Seq2[*resource.Deployment, error]
+	// List pages deployments the user has access to
+	List(ctx context.Context, opts *DeploymentListOptions) ([]*resource.Deployment, *Pager, error)
+	// ListAll retrieves all deployments the user has access to
+	ListAll(ctx context.Context, opts *DeploymentListOptions) ([]*resource.Deployment, error)
+	// PollFinalized waits until the deployment is finalized or times out and returns the final deployment. If the
+	// deployment was canceled or superseded an error wrapping ErrDeploymentCanceled is returned with the status reason
+	// and any error details.
+	PollFinalized(ctx context.Context, guid string, opts *PollingOptions) (*resource.Deployment, error)
+	// PollPaused waits until the canary deployment pauses at its next step or is finalized and returns the deployment.
+	// Errors are handled the same as PollFinalized.
+	PollPaused(ctx context.Context, guid string, opts *PollingOptions) (*resource.Deployment, error)
+	// Single returns a single deployment matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *DeploymentListOptions) (*resource.Deployment, error)
+	// Update the specified attributes of the deployment
+	Update(ctx context.Context, guid string, r *resource.DeploymentUpdate) (*resource.Deployment, error)
+}
+
+var _ DeploymentService = (*DeploymentClient)(nil)
+
+// DomainService is the interface implemented by DomainClient
+type DomainService interface {
+	// Create a new domain
+	Create(ctx context.Context, r *resource.DomainCreate) (*resource.Domain, error)
+	// Delete the specified domain asynchronously and return a jobGUID.
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first domain matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *DomainListOptions) (*resource.Domain, error)
+	// FirstForOrganization returns the first domain matching the options and organization or an error when less than 1 match
+	FirstForOrganization(ctx context.Context, organizationGUID string, opts *DomainListOptions) (*resource.Domain, error)
+	// Get the specified domain
+	Get(ctx context.Context, guid string) (*resource.Domain, error)
+	// Iter returns an iterator over all domains the user has access to
+	Iter(ctx context.Context, opts *DomainListOptions) Seq2[*resource.Domain, error]
+	// IterForOrganization returns an iterator over all domains for the specified org that the user has access to
+	IterForOrganization(ctx context.Context, organizationGUID string, opts *DomainListOptions) Seq2[*resource.Domain, error]
+	// List pages Domains the user has access to
+	List(ctx context.Context, opts *DomainListOptions) ([]*resource.Domain, *Pager, error)
+	// ListAll retrieves all domains the user has access to
+	ListAll(ctx context.Context, opts *DomainListOptions) ([]*resource.Domain, error)
+	// ListForOrganization pages all domains for the specified org that the user has access to
+	ListForOrganization(ctx context.Context, organizationGUID string, opts *DomainListOptions) ([]*resource.Domain, *Pager, error)
+	// ListForOrganizationAll retrieves all domains for the specified org that the user has access to
+	ListForOrganizationAll(ctx context.Context, organizationGUID string, opts *DomainListOptions) ([]*resource.Domain, error)
+	// Share an organization-scoped domain to the organization specified by the org guid
+	// This will allow the organization to use the organization-scoped domain
+	Share(ctx context.Context, domainGUID string, organizationGUID string) (*resource.ToManyRelationships, error)
+	// ShareMany shares an organization-scoped domain to other organizations specified by a list of organization guids
+	// This will allow any of the other organizations to use the organization-scoped domain.
+	ShareMany(ctx context.Context, guid string, r *resource.ToManyRelationships) (*resource.ToManyRelationships, error)
+	// Single returns a single domain matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *DomainListOptions) (*resource.Domain, error)
+	// SingleForOrganization returns a single domain matching the options and org or an error if not exactly 1 match
+	SingleForOrganization(ctx context.Context, organizationGUID string, opts *DomainListOptions) (*resource.Domain, error)
+	// UnShare an organization-scoped domain to other organizations specified by a list of organization guids
+	// This will allow any of the other organizations to use the organization-scoped domain.
+	UnShare(ctx context.Context, domainGUID string, organizationGUID string) error
+	// Update the specified attributes of the domain
+	Update(ctx context.Context, guid string, r *resource.DomainUpdate) (*resource.Domain, error)
+}
+
+var _ DomainService = (*DomainClient)(nil)
+
+// DropletService is the interface implemented by DropletClient
+type DropletService interface {
+	// Copy a droplet to a different app. The copied droplet excludes the environment variables listed on the source droplet
+	Copy(ctx context.Context, srcDropletGUID string, destAppGUID string) (any, error)
+	// Create a droplet without a package. To create a droplet based on a package, see Create a build
+	Create(ctx context.Context, r *resource.DropletCreate) (*resource.Droplet, error)
+	// Delete the specified droplet asynchronously and return a jobGUID.
+	Delete(ctx context.Context, guid string) (string, error)
+	// Download a gzip compressed tarball file containing a Cloud Foundry compatible droplet
+	// It is the caller's responsibility to close the io.ReadCloser
+	Download(ctx context.Context, guid string) (io.ReadCloser, error)
+	// First returns the first droplet matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *DropletListOptions) (*resource.Droplet, error)
+	// FirstForApp returns the first droplet matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *DropletAppListOptions) (*resource.Droplet, error)
+	// FirstForPackage returns the first droplet matching the options and package or an error when less than 1 match
+	FirstForPackage(ctx context.Context, packageGUID string, opts *DropletPackageListOptions) (*resource.Droplet, error)
+	// Get retrieves the droplet by ID
+	Get(ctx context.Context, guid string) (*resource.Droplet, error)
+	// GetCurrentAssociationForApp retrieves the current droplet relationship for an app
+	GetCurrentAssociationForApp(ctx context.Context, appGUID string) (*resource.DropletCurrent, error)
+	// GetCurrentForApp retrieves the current droplet for an app
+	GetCurrentForApp(ctx context.Context, appGUID string) (*resource.Droplet, error)
+	// Iter returns an iterator over all droplets the user has access to
+	Iter(ctx context.Context, opts *DropletListOptions) Seq2[*resource.Droplet, error]
+	// IterForApp returns an iterator over all droplets for the specified app
+	IterForApp(ctx context.Context, appGUID string, opts *DropletAppListOptions) Seq2[*resource.Droplet, error]
+	// IterForPackage returns an iterator over all droplets for the specified package
+	IterForPackage(ctx context.Context, packageGUID string, opts *DropletPackageListOptions) Seq2[*resource.Droplet, error]
+	// List pages all droplets the user has access to
+	List(ctx context.Context, opts *DropletListOptions) ([]*resource.Droplet, *Pager, error)
+	// ListAll retrieves all droplets the user has access to
+	ListAll(ctx context.Context, opts *DropletListOptions) ([]*resource.Droplet, error)
+	// ListForApp pages all droplets for the specified app
+	ListForApp(ctx context.Context, appGUID string, opts *DropletAppListOptions) ([]*resource.Droplet, *Pager, error)
+	// ListForAppAll retrieves all droplets for the specified app
+	ListForAppAll(ctx context.Context, appGUID string, opts *DropletAppListOptions) ([]*resource.Droplet, error)
+	// ListForPackage pages all droplets for the specified package
+	ListForPackage(ctx context.Context, packageGUID string, opts *DropletPackageListOptions) ([]*resource.Droplet, *Pager, error)
+	// ListForPackageAll retrieves all droplets for the specified package
+	ListForPackageAll(ctx context.Context, packageGUID string, opts *DropletPackageListOptions) ([]*resource.Droplet, error)
+	// SetCurrentAssociationForApp sets the current droplet for an app. The current droplet is the droplet that the app will use when running
+	SetCurrentAssociationForApp(ctx context.Context, appGUID string, dropletGUID string) (*resource.DropletCurrent, error)
+	// Single returns a single droplet matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *DropletListOptions) (*resource.Droplet, error)
+	// SingleForApp returns a single droplet matching the options and app or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *DropletAppListOptions) (*resource.Droplet, error)
+	// SingleForPackage returns a single droplet matching the options and package or an error if not exactly 1 match
+	SingleForPackage(ctx context.Context, packageGUID string, opts *DropletPackageListOptions) (*resource.Droplet, error)
+	// Update an existing droplet
+	Update(ctx context.Context, guid string, r *resource.DropletUpdate) (*resource.Droplet, error)
+	// Upload a gzip compressed tarball (tgz) file containing a Cloud Foundry compatible droplet
+	Upload(ctx context.Context, guid string, tgzDroplet io.Reader) (string, *resource.Droplet, error)
+}
+
+var _ DropletService = (*DropletClient)(nil)
+
+// EnvVarGroupService is the interface implemented by EnvVarGroupClient
+type EnvVarGroupService interface {
+	// Get retrieves the specified envvar group
+	Get(ctx context.Context, name string) (*resource.EnvVarGroup, error)
+	// GetRunning retrieves the running envvar group
+	GetRunning(ctx context.Context) (*resource.EnvVarGroup, error)
+	// GetStaging retrieves the running envvar group
+	GetStaging(ctx context.Context) (*resource.EnvVarGroup, error)
+	// Update the specified attributes of the envar group
+	Update(ctx context.Context, name string, r *resource.EnvVarGroupUpdate) (*resource.EnvVarGroup, error)
+	// UpdateRunning updates the specified attributes of the running envar group
+	UpdateRunning(ctx context.Context, r *resource.EnvVarGroupUpdate) (*resource.EnvVarGroup, error)
+	// UpdateStaging updates the specified attributes of the staging envar group
+	UpdateStaging(ctx context.Context, r *resource.EnvVarGroupUpdate) (*resource.EnvVarGroup, error)
+}
+
+var _ EnvVarGroupService = (*EnvVarGroupClient)(nil)
+
+// FeatureFlagService is the interface implemented by FeatureFlagClient
+type FeatureFlagService interface {
+	// Get the specified feature flag
+	Get(ctx context.Context, featureFlag resource.FeatureFlagType) (*resource.FeatureFlag, error)
+	// Iter returns an iterator over all feature flags
+	Iter(ctx context.Context, opts *FeatureFlagListOptions) Seq2[*resource.FeatureFlag, error]
+	// List pages feature flags
+	List(ctx context.Context, opts *FeatureFlagListOptions) ([]*resource.FeatureFlag, *Pager, error)
+	// ListAll retrieves all feature flags
+	ListAll(ctx context.Context, opts *FeatureFlagListOptions) ([]*resource.FeatureFlag, error)
+	// Update the specified attributes of the feature flag
+	Update(ctx context.Context, featureFlag resource.FeatureFlagType, r *resource.FeatureFlagUpdate) (*resource.FeatureFlag, error)
+}
+
+var _ FeatureFlagService = (*FeatureFlagClient)(nil)
+
+// IsolationSegmentService is the interface implemented by IsolationSegmentClient
+type IsolationSegmentService interface {
+	// Create a new isolation segment
+	Create(ctx context.Context, r *resource.IsolationSegmentCreate) (*resource.IsolationSegment, error)
+	// Delete the specified isolation segments
+	//
+	// An isolation segment cannot be deleted if it is entitled to any organization.
+	Delete(ctx context.Context, guid string) error
+	// EntitleOrganization entitles the specified organization for the isolation segment.
+	//
+	// In the case where the specified isolation segment is the system-wide shared segment,
+	// and if an organization is not already entitled for any other isolation segment, then
+	// the shared isolation segment automatically gets assigned as the default for that organization.
+	EntitleOrganization(ctx context.Context, guid string, organizationGUID string) (*resource.IsolationSegmentRelationship, error)
+	// EntitleOrganizations entitles the specified organizations for the isolation segment.
+	//
+	// In the case where the specified isolation segment is the system-wide shared segment,
+	// and if an organization is not already entitled for any other isolation segment, then
+	// the shared isolation segment automatically gets assigned as the default for that organization.
+	EntitleOrganizations(ctx context.Context, guid string, organizationGUIDs []string) (*resource.IsolationSegmentRelationship, error)
+	// First returns the first isolation segment matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *IsolationSegmentListOptions) (*resource.IsolationSegment, error)
+	// Get the specified isolation segment
+	Get(ctx context.Context, guid string) (*resource.IsolationSegment, error)
+	// Iter returns an iterator over all isolation segments the user has access to
+	//
+	// For admin, this is all the isolation segments in the system. For anyone else,  this is
+	// the isolation segments in the allowed list for any organization to which the user belongs.
+	Iter(ctx context.Context, opts *IsolationSegmentListOptions) Seq2[*resource.IsolationSegment, error]
+	// List all isolation segments the user has access to in paged results
+	//
+	// For admin, this is all the isolation segments in the system. For anyone else,  this is
+	// the isolation segments in the allowed list for any organization to which the user belongs.
+	List(ctx context.Context, opts *IsolationSegmentListOptions) ([]*resource.IsolationSegment, *Pager, error)
+	// ListAll retrieves all isolation segments the user has access to
+	//
+	// For admin, this is all the isolation segments in the system. For anyone else,  this is
+	// the isolation segments in the allowed list for any organization to which the user belongs.
+	ListAll(ctx context.Context, opts *IsolationSegmentListOptions) ([]*resource.IsolationSegment, error)
+	// ListOrganizationRelationships lists the organizations entitled for the isolation segment.
+	//
+	// For an Admin, this will list all entitled organizations in the system. For any other user,
+	// this will list only the entitled organizations to which the user belongs.
+	ListOrganizationRelationships(ctx context.Context, guid string) ([]string, error)
+	// ListSpaceRelationships lists the spaces to which the isolation segment is assigned.
+	//
+	// For an Admin, this will list all associated spaces in the system. For an organization manager,
+	// this will list only those associated spaces belonging to orgs for which the user is a
+	// manager. For any other user, this will list only those associated spaces to which the
+	// user has access.
+	ListSpaceRelationships(ctx context.Context, guid string) ([]string, error)
+	// RevokeOrganization revokes the entitlement for the specified organization to the isolation segment
+	//
+	// If the isolation segment is assigned to a space within an organization, the entitlement cannot be revoked.
+	// If the isolation segment is the organization’s default, the entitlement cannot be revoked.
+	RevokeOrganization(ctx context.Context, guid string, organizationGUID string) error
+	// RevokeOrganizations revokes the entitlement for all the specified organizations to the isolation segment
+	//
+	// If the isolation segment is assigned to a space within an organization, the entitlement cannot be revoked.
+	// If the isolation segment is the organization’s default, the entitlement cannot be revoked.
+	RevokeOrganizations(ctx context.Context, guid string, organizationGUIDs []string) error
+	// Single returns a single iso segment matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *IsolationSegmentListOptions) (*resource.IsolationSegment, error)
+	// Update the specified attributes of the isolation segments
+	Update(ctx context.Context, guid string, r *resource.IsolationSegmentUpdate) (*resource.IsolationSegment, error)
+}
+
+var _ IsolationSegmentService = (*IsolationSegmentClient)(nil)
+
+// JobService is the interface implemented by JobClient
+type JobService interface {
+	// Get the specified job
+	Get(ctx context.Context, guid string) (*resource.Job, error)
+	// PollComplete waits until the job completes, fails, or times out
+	PollComplete(ctx context.Context, jobGUID string, opts *PollingOptions) error
+}
+
+var _ JobService = (*JobClient)(nil)
+
+// LogCacheService is the interface implemented by LogCacheClient
+type LogCacheService interface {
+	// InstantQuery evaluates the PromQL query at a single point in time, for example the CPU usage of each app
+	// instance: cpu{source_id="<app-guid>"}. A zero time evaluates the query at the current time.
+	InstantQuery(ctx context.Context, query string, at time.Time) (*resource.PromQLResult, error)
+	// RangeQuery evaluates the PromQL query over the time range at each step, for example the memory usage of each
+	// app instance over the last hour: memory{source_id="<app-guid>"}
+	RangeQuery(ctx context.Context, query string, start time.Time, end time.Time, step time.Duration) (*resource.PromQLResult, error)
+	// Read the envelopes of the specified source, usually an app GUID, that match the options
+	Read(ctx context.Context, sourceID string, opts *LogCacheReadOptions) ([]*resource.Envelope, error)
+	// RecentLogs returns up to the last 1000 log lines of the specified app in chronological order, the same as
+	// cf logs --recent
+	RecentLogs(ctx context.Context, appGUID string) ([]*resource.Envelope, error)
+}
+
+var _ LogCacheService = (*LogCacheClient)(nil)
+
+// LogStreamService is the interface implemented by LogStreamClient
+type LogStreamService interface {
+	// Stream envelopes emitted by the specified source, usually an app GUID, as they're emitted. The returned channel
+	// is closed once the context is done or the stream can't be reconnected.
+	//
+	// The initial connection is made before Stream returns so any error connecting, like insufficient permissions,
+	// is returned immediately. Once connected, a dropped stream is automatically reconnected although any
+	// envelopes emitted while disconnected are lost, use LogCache to read those.
+	Stream(ctx context.Context, sourceID string, opts *LogStreamOptions) (<-chan *resource.Envelope, error)
+}
+
+var _ LogStreamService = (*LogStreamClient)(nil)
+
+// ManifestService is the interface implemented by ManifestClient
+type ManifestService interface {
+	// ApplyManifest applies the changes specified in a manifest to the named apps and their underlying processes
+	// asynchronously and returns a jobGUID.
+	//
+	// The apps must reside in the space. These changes are additive and will not modify any unspecified
+	// properties or remove any existing environment variables, routes, or services.
+	ApplyManifest(ctx context.Context, spaceGUID string, manifest string) (string, error)
+	// Generate the specified app manifest as a yaml text string
+	Generate(ctx context.Context, appGUID string) (string, error)
+	// ManifestDiff compares the provided manifest against the current state of the space.
+	ManifestDiff(ctx context.Context, spaceGUID string, manifest string) (*resource.ManifestDiff, error)
+}
+
+var _ ManifestService = (*ManifestClient)(nil)
+
+// NetworkPolicyService is the interface implemented by NetworkPolicyClient
+type NetworkPolicyService interface {
+	// AppGUIDs resolves the names of apps in the space to their GUIDs keyed by app name, an error is returned if
+	// any of the apps don't exist
+	AppGUIDs(ctx context.Context, spaceGUID string, appNames ...string) (map[string]string, error)
+	// Create the policies, creating a policy that already exists isn't an error
+	Create(ctx context.Context, policies ...*resource.NetworkPolicy) error
+	// CreateForAppNames creates a policy between apps in the space, identified by name, allowing the source app to
+	// reach the destination app on the inclusive port range
+	CreateForAppNames(ctx context.Context, spaceGUID string, sourceAppName string, destinationAppName string, protocol resource.NetworkPolicyProtocol, startPort int, endPort int) (*resource.NetworkPolicy, error)
+	// Delete the policies, deleting a policy that doesn't exist isn't an error
+	Delete(ctx context.Context, policies ...*resource.NetworkPolicy) error
+	// DeleteForAppNames deletes the policy between apps in the space, identified by name, for the inclusive port range
+	DeleteForAppNames(ctx context.Context, spaceGUID string, sourceAppName string, destinationAppName string, protocol resource.NetworkPolicyProtocol, startPort int, endPort int) error
+	// List the policies the user can see, if app GUIDs are specified only the policies where one of the apps is the
+	// source or destination are returned
+	List(ctx context.Context, appGUIDs ...string) ([]*resource.NetworkPolicy, error)
+	// ListForAppNames lists the policies where one of the apps in the space, identified by name, is the source
+	// or destination
+	ListForAppNames(ctx context.Context, spaceGUID string, appNames ...string) ([]*resource.NetworkPolicy, error)
+}
+
+var _ NetworkPolicyService = (*NetworkPolicyClient)(nil)
+
+// OrganizationService is the interface implemented by OrganizationClient
+type OrganizationService interface {
+	// AssignDefaultIsolationSegment assigns a default iso segment to the specified organization
+	//
+	// Apps will not run in the new default isolation segment until they are restarted
+	// An empty isolationSegmentGUID will un-assign the default isolation segment
+	AssignDefaultIsolationSegment(ctx context.Context, guid string, isolationSegmentGUID string) error
+	// Create an organization
+	Create(ctx context.Context, r *resource.OrganizationCreate) (*resource.Organization, error)
+	// Delete the specified organization asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first organization matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *OrganizationListOptions) (*resource.Organization, error)
+	// FirstForIsolationSegment returns the first organization matching the options and iso segment or an error when less than 1 match
+	FirstForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *OrganizationListOptions) (*resource.Organization, error)
+	// Get the specified organization
+	Get(ctx context.Context, guid string) (*resource.Organization, error)
+	// GetDefaultDomain gets the specified organization's default domain if any
+	GetDefaultDomain(ctx context.Context, guid string) (*resource.Domain, error)
+	// GetDefaultIsolationSegment gets the specified organization's default iso segment GUID if any
+	GetDefaultIsolationSegment(ctx context.Context, guid string) (string, error)
+	// GetUsageSummary gets the specified organization's usage summary
+	GetUsageSummary(ctx context.Context, guid string) (*resource.OrganizationUsageSummary, error)
+	// Iter returns an iterator over all organizations the user has access to
+	Iter(ctx context.Context, opts *OrganizationListOptions) Seq2[*resource.Organization, error]
+	// IterForIsolationSegment returns an iterator over all organizations for the specified isolation segment
+	IterForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *OrganizationListOptions) Seq2[*resource.Organization, error]
+	// IterUsers returns an iterator over all users that are members of the specified organization
+	IterUsers(ctx context.Context, guid string, opts *UserListOptions) Seq2[*resource.User, error]
+	// List pages all organizations the user has access to
+	List(ctx context.Context, opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error)
+	// ListAll retrieves all organizations the user has access to
+	ListAll(ctx context.Context, opts *OrganizationListOptions) ([]*resource.Organization, error)
+	// ListForIsolationSegment pages all organizations for the specified isolation segment
+	ListForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error)
+	// ListForIsolationSegmentAll retrieves all organizations for the specified isolation segment
+	ListForIsolationSegmentAll(ctx context.Context, isolationSegmentGUID string, opts *OrganizationListOptions) ([]*resource.Organization, error)
+	// ListUsers pages of all users that are members of the specified organization
+	ListUsers(ctx context.Context, guid string, opts *UserListOptions) ([]*resource.User, *Pager, error)
+	// ListUsersAll retrieves all users that are members of the specified organization
+	ListUsersAll(ctx context.Context, guid string, opts *UserListOptions) ([]*resource.User, error)
+	// Single returns a single organization matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *OrganizationListOptions) (*resource.Organization, error)
+	// SingleForIsolationSegment returns a single organization matching the options and iso segment or an error if not exactly 1 match
+	SingleForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *OrganizationListOptions) (*resource.Organization, error)
+	// Update the organization's specified attributes
+	Update(ctx context.Context, guid string, r *resource.OrganizationUpdate) (*resource.Organization, error)
+}
+
+var _ OrganizationService = (*OrganizationClient)(nil)
+
+// OrganizationQuotaService is the interface implemented by OrganizationQuotaClient
+type OrganizationQuotaService interface {
+	// Apply the specified organization quota to the organizations
+	Apply(ctx context.Context, guid string, organizationGUIDs []string) ([]string, error)
+	// Create a new organization quota
+	Create(ctx context.Context, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error)
+	// Delete the specified organization quota
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first organization quota matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *OrganizationQuotaListOptions) (*resource.OrganizationQuota, error)
+	// Get the specified organization quota
+	Get(ctx context.Context, guid string) (*resource.OrganizationQuota, error)
+	// Iter returns an iterator over all organization quotas the user has access to
+	Iter(ctx context.Context, opts *OrganizationQuotaListOptions) Seq2[*resource.OrganizationQuota, error]
+	// List pages all organization quotas the user has access to
+	List(ctx context.Context, opts *OrganizationQuotaListOptions) ([]*resource.OrganizationQuota, *Pager, error)
+	// ListAll retrieves all organization quotas the user has access to
+	ListAll(ctx context.Context, opts *OrganizationQuotaListOptions) ([]*resource.OrganizationQuota, error)
+	// Single returns a single organization quota matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *OrganizationQuotaListOptions) (*resource.OrganizationQuota, error)
+	// Update the specified attributes of the organization quota
+	Update(ctx context.Context, guid string, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error)
+}
+
+var _ OrganizationQuotaService = (*OrganizationQuotaClient)(nil)
+
+// PackageService is the interface implemented by PackageClient
+type PackageService interface {
+	// Copy the bits of a source package to a target package
+	Copy(ctx context.Context, srcPackageGUID string, destAppGUID string) (*resource.Package, error)
+	// Create a new package
+	Create(ctx context.Context, r *resource.PackageCreate) (*resource.Package, error)
+	// Delete the specified package asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// Download the bits of an existing package
+	// It is the caller's responsibility to close the io.ReadCloser
+	Download(ctx context.Context, guid string) (io.ReadCloser, error)
+	// First returns the first package matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *PackageListOptions) (*resource.Package, error)
+	// FirstForApp returns the first package matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *PackageListOptions) (*resource.Package, error)
+	// Get the specified build
+	Get(ctx context.Context, guid string) (*resource.Package, error)
+	// Iter returns an iterator over all packages the user has access to
+	Iter(ctx context.Context, opts *PackageListOptions) Seq2[*resource.Package, error]
+	// IterForApp returns an iterator over all packages the user has access to
+	IterForApp(ctx context.Context, appGUID string, opts *PackageListOptions) Seq2[*resource.Package, error]
+	// List pages all the packages the user has access to
+	List(ctx context.Context, opts *PackageListOptions) ([]*resource.Package, *Pager, error)
+	// ListAll retrieves all the packages the user has access to
+	ListAll(ctx context.Context, opts *PackageListOptions) ([]*resource.Package, error)
+	// ListForApp pages all the packages the user has access to
+	ListForApp(ctx context.Context, appGUID string, opts *PackageListOptions) ([]*resource.Package, *Pager, error)
+	// ListForAppAll retrieves all the packages the user has access to
+	ListForAppAll(ctx context.Context, appGUID string, opts *PackageListOptions) ([]*resource.Package, error)
+	// PollReady waits until the package is ready, fails, or times out
+	PollReady(ctx context.Context, guid string, opts *PollingOptions) error
+	// Single returns a single package matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *PackageListOptions) (*resource.Package, error)
+	// SingleForApp returns a single package matching the options for the app or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *PackageListOptions) (*resource.Package, error)
+	// Update the specified attributes of the package
+	Update(ctx context.Context, guid string, r *resource.PackageUpdate) (*resource.Package, error)
+	// Upload an app's zip file contents
+	Upload(ctx context.Context, guid string, zipFile io.Reader) (*resource.Package, error)
+	// UploadWithResources uploads an app's zip file containing only the files that aren't already cached, along with the
+	// resources previously matched by ResourceMatches.Create which are copied from the cache. The zip file may be nil
+	// if all the app's files were matched.
+	UploadWithResources(ctx context.Context, guid string, zipFile io.Reader, resources []resource.ResourceMatch) (*resource.Package, error)
+}
+
+var _ PackageService = (*PackageClient)(nil)
+
+// ProcessService is the interface implemented by ProcessClient
+type ProcessService interface {
+	// First returns the first process matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ProcessListOptions) (*resource.Process, error)
+	// FirstForApp returns the first process matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *ProcessListOptions) (*resource.Process, error)
+	// Get the specified process
+	Get(ctx context.Context, guid string) (*resource.Process, error)
+	// GetStats for the specified process
+	GetStats(ctx context.Context, guid string) (*resource.ProcessStats, error)
+	// GetStatsForApp for the specified app
+	GetStatsForApp(ctx context.Context, appGUID string, processType string) (*resource.ProcessStats, error)
+	// Iter returns an iterator over all processes
+	Iter(ctx context.Context, opts *ProcessListOptions) Seq2[*resource.Process, error]
+	// IterForApp returns an iterator over all processes for the specified app
+	IterForApp(ctx context.Context, appGUID string, opts *ProcessListOptions) Seq2[*resource.Process, error]
+	// List pages all processes
+	List(ctx context.Context, opts *ProcessListOptions) ([]*resource.Process, *Pager, error)
+	// ListAll retrieves all processes
+	ListAll(ctx context.Context, opts *ProcessListOptions) ([]*resource.Process, error)
+	// ListForApp pages all processes for the specified app
+	ListForApp(ctx context.Context, appGUID string, opts *ProcessListOptions) ([]*resource.Process, *Pager, error)
+	// ListForAppAll retrieves all processes for the specified app
+	ListForAppAll(ctx context.Context, appGUID string, opts *ProcessListOptions) ([]*resource.Process, error)
+	// Scale the process using the specified scaling requirements
+	Scale(ctx context.Context, guid string, scale *resource.ProcessScale) (*resource.Process, error)
+	// Single returns a single package matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ProcessListOptions) (*resource.Process, error)
+	// SingleForApp returns a single package matching the options for the app or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *ProcessListOptions) (*resource.Process, error)
+	// Terminate an instance of a specific process. Health management will eventually restart the instance.
+	Terminate(ctx context.Context, guid string, index int) error
+	// Update the specified attributes of the process
+	Update(ctx context.Context, guid string, r *resource.ProcessUpdate) (*resource.Process, error)
+}
+
+var _ ProcessService = (*ProcessClient)(nil)
+
+// RevisionService is the interface implemented by RevisionClient
+type RevisionService interface {
+	// FirstForApp returns the first revision matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *RevisionListOptions) (*resource.Revision, error)
+	// Get the specified revision
+	Get(ctx context.Context, guid string) (*resource.Revision, error)
+	// GetEnvironmentVariables retrieves the specified revision's environment variables
+	GetEnvironmentVariables(ctx context.Context, guid string) (map[string]*string, error)
+	// IterForApp returns an iterator over all revisions that are associated with the specified app
+	IterForApp(ctx context.Context, appGUID string, opts *RevisionListOptions) Seq2[*resource.Revision, error]
+	// IterForAppDeployed returns an iterator over all deployed revisions that are associated with the specified app
+	IterForAppDeployed(ctx context.Context, appGUID string, opts *RevisionListOptions) Seq2[*resource.Revision, error]
+	// ListForApp pages revisions that are associated with the specified app
+	ListForApp(ctx context.Context, appGUID string, opts *RevisionListOptions) ([]*resource.Revision, *Pager, error)
+	// ListForAppAll retrieves all revisions that are associated with the specified app
+	ListForAppAll(ctx context.Context, appGUID string, opts *RevisionListOptions) ([]*resource.Revision, error)
+	// ListForAppDeployed pages deployed revisions that are associated with the specified app
+	ListForAppDeployed(ctx context.Context, appGUID string, opts *RevisionListOptions) ([]*resource.Revision, *Pager, error)
+	// ListForAppDeployedAll pages deployed revisions that are associated with the specified app
+	ListForAppDeployedAll(ctx context.Context, appGUID string, opts *RevisionListOptions) ([]*resource.Revision, error)
+	// SingleForApp returns a single revision matching the options and app or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *RevisionListOptions) (*resource.Revision, error)
+	// SingleForAppDeployed returns a single deployed revision matching the options and app or an error if not exactly 1 match
+	SingleForAppDeployed(ctx context.Context, appGUID string, opts *RevisionListOptions) (*resource.Revision, error)
+	// Update the specified attributes of the deployment
+	Update(ctx context.Context, guid string, r *resource.RevisionUpdate) (*resource.Revision, error)
+}
+
+var _ RevisionService = (*RevisionClient)(nil)
+
+// ResourceMatchService is the interface implemented by ResourceMatchClient
+type ResourceMatchService interface {
+	// Create a list of cached resources from the input list
+	Create(ctx context.Context, toMatch *resource.ResourceMatches) (*resource.ResourceMatches, error)
+}
+
+var _ ResourceMatchService = (*ResourceMatchClient)(nil)
+
+// RoleService is the interface implemented by RoleClient
+type RoleService interface {
+	// CreateOrganizationRole creates a new role for a user in the organization
+	//
+	// To create an organization role you must be an admin or organization
+	// manager in the organization associated with the role.
+	CreateOrganizationRole(ctx context.Context, organizationGUID string, userGUID string, roleType resource.OrganizationRoleType) (*resource.Role, error)
+	// CreateSpaceRole creates a new role for a user in the space
+	//
+	// To create a space role you must be an admin, an organization manager
+	// in the parent organization of the space associated with the role,
+	// or a space manager in the space associated with the role.
+	//
+	// For a user to be assigned a space role, the user must already
+	// have an organization role in the parent organization.
+	CreateSpaceRole(ctx context.Context, spaceGUID string, userGUID string, roleType resource.SpaceRoleType) (*resource.Role, error)
+	// Delete the specified role asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first role matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *RoleListOptions) (*resource.Role, error)
+	// Get the specified role
+	Get(ctx context.Context, guid string) (*resource.Role, error)
+	// GetIncludeOrganizations allows callers to fetch a role and include any assigned organizations
+	GetIncludeOrganizations(ctx context.Context, guid string) (*resource.Role, []*resource.Organization, error)
+	// GetIncludeSpaces allows callers to fetch a role and include any assigned spaces
+	GetIncludeSpaces(ctx context.Context, guid string) (*resource.Role, []*resource.Space, error)
+	// GetIncludeUsers allows callers to fetch a role and include any assigned users
+	GetIncludeUsers(ctx context.Context, guid string) (*resource.Role, []*resource.User, error)
+	// Iter returns an iterator over all roles the user has access to
+	Iter(ctx context.Context, opts *RoleListOptions) Seq2[*resource.Role, error]
+	// List all roles the user has access to in paged results
+	List(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, *Pager, error)
+	// ListAll retrieves all roles the user has access to
+	ListAll(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, error)
+	// ListIncludeOrganizations pages all roles and specified and includes organizations that have the roles
+	ListIncludeOrganizations(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.Organization, *Pager, error)
+	// ListIncludeOrganizationsAll retrieves all roles and specified and includes organizations that have the roles
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeOrganizations to fetch them a page at
+	// a time instead.
+	ListIncludeOrganizationsAll(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.Organization, error)
+	// ListIncludeSpaces pages all roles and specified and includes spaces that have the roles
+	ListIncludeSpaces(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.Space, *Pager, error)
+	// ListIncludeSpacesAll retrieves all roles and specified and includes spaces that have the roles
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeSpaces to fetch them a page at
+	// a time instead.
+	ListIncludeSpacesAll(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.Space, error)
+	// ListIncludeUsers pages all roles and specified and includes users that belong to the roles
+	ListIncludeUsers(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.User, *Pager, error)
+	// ListIncludeUsersAll retrieves all roles and all the users that belong to those roles
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeUsers to fetch them a page at
+	// a time instead.
+	ListIncludeUsersAll(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.User, error)
+	// Single returns a single role matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *RoleListOptions) (*resource.Role, error)
+}
+
+var _ RoleService = (*RoleClient)(nil)
+
+// RootService is the interface implemented by RootClient
+type RootService interface {
+	// Get queries the global API root /
+	//
+	// These endpoints link to other resources, endpoints, and external services that are relevant to
+	// authenticated API clients.
+	Get(ctx context.Context) (*resource.Root, error)
+	// GetV3 queries the V3 API root /v3
+	//
+	// This endpoint returns links to all the resources available on the v3 API.
+	GetV3(ctx context.Context) (*resource.V3Root, error)
+}
+
+var _ RootService = (*RootClient)(nil)
+
+// RouteService is the interface implemented by RouteClient
+type RouteService interface {
+	// Create a new route
+	Create(ctx context.Context, r *resource.RouteCreate) (*resource.Route, error)
+	// Delete the specified route asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// DeleteUnmappedRoutesForSpace deletes all routes in a space that are not mapped to any applications and not
+	// bound to any service instances and returns the async JobGUID
+	DeleteUnmappedRoutesForSpace(ctx context.Context, spaceGUID string) (string, error)
+	// First returns the first route matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *RouteListOptions) (*resource.Route, error)
+	// FirstForApp returns the first route matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *RouteListOptions) (*resource.Route, error)
+	// Get the specified route
+	Get(ctx context.Context, guid string) (*resource.Route, error)
+	// GetDestinations retrieves all destinations associated with a route
+	GetDestinations(ctx context.Context, guid string) (*resource.RouteDestinations, error)
+	// GetIncludeDomain allows callers to fetch a route and include the parent domain
+	GetIncludeDomain(ctx context.Context, guid string) (*resource.Route, *resource.Domain, error)
+	// GetIncludeSpace allows callers to fetch a route and include the parent space
+	GetIncludeSpace(ctx context.Context, guid string) (*resource.Route, *resource.Space, error)
+	// GetIncludeSpaceAndOrganization allows callers to fetch a route and include the parent space and organization
+	GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.Route, *resource.Space, *resource.Organization, error)
+	// GetSharedSpacesRelationships retrieves the spaces that the route has been shared to
+	GetSharedSpacesRelationships(ctx context.Context, guid string) (*resource.RouteSharedSpaceRelationships, error)
+	// InsertDestinations add one or more destinations to a route, preserving any existing destinations
+	//
+	// Note that weighted destinations cannot be added with this endpoint. To add weighted destinations, replace
+	// all destinations for a route at once using the replace destinations endpoint.
+	InsertDestinations(ctx context.Context, guid string, dest []*resource.RouteDestinationInsertOrReplace) (*resource.RouteDestinations, error)
+	// IsRouteReserved checks if a specific route for a domain exists, regardless of the user’s visibility for the
+	// route in case the route belongs to a space the user does not belong to
+	IsRouteReserved(ctx context.Context, domainGUID string, opts *RouteReservationListOptions) (bool, error)
+	// Iter returns an iterator over all routes the user has access to
+	Iter(ctx context.Context, opts *RouteListOptions) Seq2[*resource.Route, error]
+	// IterForApp returns an iterator over all routes for the specified app the user has access to
+	IterForApp(ctx context.Context, appGUID string, opts *RouteListOptions) Seq2[*resource.Route, error]
+	// List pages routes the user has access to
+	List(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, *Pager, error)
+	// ListAll retrieves all routes the user has access to
+	ListAll(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, error)
+	// ListForApp pages routes for the specified app the user has access to
+	ListForApp(ctx context.Context, appGUID string, opts *RouteListOptions) ([]*resource.Route, *Pager, error)
+	// ListForAppAll retrieves all routes for the specified app the user has access to
+	ListForAppAll(ctx context.Context, appGUID string, opts *RouteListOptions) ([]*resource.Route, error)
+	// ListIncludeDomains page all routes the user has access to and include the parent domains
+	ListIncludeDomains(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Domain, *Pager, error)
+	// ListIncludeDomainsAll retrieves all routes the user has access to and includes the parent domains
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeDomains to fetch them a page at
+	// a time instead.
+	ListIncludeDomainsAll(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Domain, error)
+	// ListIncludeSpaces page all routes the user has access to and include the parent spaces
+	ListIncludeSpaces(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Space, *Pager, error)
+	// ListIncludeSpacesAll retrieves all routes the user has access to and includes the parent spaces
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeSpaces to fetch them a page at
+	// a time instead.
+	ListIncludeSpacesAll(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Space, error)
+	// ListIncludeSpacesAndOrganizations page all routes the user has access to and include the parent spaces and organizations
+	ListIncludeSpacesAndOrganizations(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Space, []*resource.Organization, *Pager, error)
+	// ListIncludeSpacesAndOrganizationsAll retrieves all routes the user has access to and includes the parent spaces and organization
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeSpacesAndOrganizations to fetch them a page at
+	// a time instead.
+	ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Space, []*resource.Organization, error)
+	// RemoveDestination removes a destination from a route
+	RemoveDestination(ctx context.Context, guid string, destinationGUID string) error
+	// ReplaceDestinations replaces all destinations for a route, removing any destinations not included in the provided list
+	//
+	// If using weighted destinations, all destinations provided here must have a weight specified, and all weights for
+	// this route must sum to 100. If not, all provided destinations must not have a weight. Mixing weighted and unweighted
+	// destinations for a route is not allowed.
+	ReplaceDestinations(ctx context.Context, guid string, dest []*resource.RouteDestinationInsertOrReplace) (*resource.RouteDestinations, error)
+	// ShareWithSpace shares the route with the specified space
+	//
+	// In order to share into a space the requesting user must be a space developer in the target space
+	ShareWithSpace(ctx context.Context, guid string, spaceGUID string) (*resource.RouteSharedSpaceRelationships, error)
+	// ShareWithSpaces shares the route with the specified spaces
+	//
+	// In order to share into a space the requesting user must be a space developer in the target space
+	ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.RouteSharedSpaceRelationships, error)
+	// Single returns a single route matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *RouteListOptions) (*resource.Route, error)
+	// SingleForApp returns a single route matching the options and app or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *RouteListOptions) (*resource.Route, error)
+	// TransferOwnership transfers the ownership of a route to another space
+	//
+	// Users must have write access for both spaces to perform this action. The original owning space will still
+	// retain access to the route as a shared space. To completely remove a space from a route, users will have
+	// to un-share the route.
+	TransferOwnership(ctx context.Context, guid string, spaceGUID string) error
+	// UnShareWithSpace un-shares the route with the specified space
+	//
+	// This will automatically unbind any applications bound to this route in the specified space
+	// Un-sharing a route from a space will not delete any service keys
+	UnShareWithSpace(ctx context.Context, guid string, spaceGUID string) error
+	// UnShareWithSpaces un-shares the route with the specified spaces
+	//
+	// This will automatically unbind any applications bound to this route in the specified space
+	// Un-sharing a route from a space will not delete any service keys
+	UnShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) error
+	// Update the specified attributes of the app
+	Update(ctx context.Context, guid string, r *resource.RouteUpdate) (*resource.Route, error)
+	// UpdateDestinationProtocol updates the protocol of a route destination (app, port and weight cannot be updated)
+	//
+	// Protocol the destination will use. Valid protocols are http1 or http2 if route protocol is http, tcp if route
+	// protocol is tcp. An empty string will set it to either http1 or tcp based on the route protocol
+	UpdateDestinationProtocol(ctx context.Context, guid string, destinationGUID string, protocol string) (*resource.RouteDestinationWithLinks, error)
+}
+
+var _ RouteService = (*RouteClient)(nil)
+
+// RoutingAPIService is the interface implemented by RoutingAPIClient
+type RoutingAPIService interface {
+	// CreateTCPRoutes creates or refreshes the TCP route mappings
+	CreateTCPRoutes(ctx context.Context, routes ...*resource.TCPRoute) error
+	// DeleteTCPRoutes deletes the TCP route mappings
+	DeleteTCPRoutes(ctx context.Context, routes ...*resource.TCPRoute) error
+	// FreePort returns the lowest reservable port of the TCP domain's router group that isn't used by a route of any
+	// domain sharing the router group, for use with Routes.Create
+	FreePort(ctx context.Context, domainGUID string) (int, error)
+	// GetRouterGroup gets the specified router group
+	GetRouterGroup(ctx context.Context, guid string) (*resource.RouterGroup, error)
+	// GetRouterGroupByName gets the router group with the specified name, e.g. default-tcp
+	GetRouterGroupByName(ctx context.Context, name string) (*resource.RouterGroup, error)
+	// ListRouterGroups lists all the router groups
+	ListRouterGroups(ctx context.Context) ([]*resource.RouterGroup, error)
+	// ListTCPRoutes lists all the TCP route mappings
+	ListTCPRoutes(ctx context.Context) ([]*resource.TCPRoute, error)
+	// ListTCPRoutesForRouterGroup lists the TCP route mappings of the specified router group
+	ListTCPRoutesForRouterGroup(ctx context.Context, routerGroupGUID string) ([]*resource.TCPRoute, error)
+	// UpdateRouterGroup updates the reservable ports of the specified router group
+	UpdateRouterGroup(ctx context.Context, guid string, r *resource.RouterGroupUpdate) (*resource.RouterGroup, error)
+}
+
+var _ RoutingAPIService = (*RoutingAPIClient)(nil)
+
+// SecurityGroupService is the interface implemented by SecurityGroupClient
+type SecurityGroupService interface {
+	// BindRunningSecurityGroup binds one or more spaces to a security group with the running lifecycle and returns
+	// the space GUIDs bound to the security group
+	//
+	// Running app containers within these spaces will inherit the rules specified by this security group. Apps within
+	// these spaces must be restarted for these changes to take effect. Unless a security group is globally-enabled,
+	// an admin must add it to a space for it to be visible for the org and space managers. Once it’s visible, org and
+	// space managers can add it to additional spaces.
+	BindRunningSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error)
+	// BindStagingSecurityGroup binds one or more spaces to a security group with the staging lifecycle and returns
+	// the space GUIDs bound to the security group
+	//
+	// Staging app containers within these spaces will inherit the rules specified by this security group. Apps within
+	// these spaces must be restaged for these changes to take effect. Unless a security group is globally-enabled,
+	// an admin must add it to a space for it to be visible for the org and space managers. Once it’s visible, org and
+	// space managers can add it to additional spaces.
+	BindStagingSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error)
+	// Create a new domain
+	Create(ctx context.Context, r *resource.SecurityGroupCreate) (*resource.SecurityGroup, error)
+	// Delete the specified security group asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first security group matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *SecurityGroupListOptions) (*resource.SecurityGroup, error)
+	// Get the specified security group
+	Get(ctx context.Context, guid string) (*resource.SecurityGroup, error)
+	// Iter returns an iterator over all SecurityGroups the user has access to
+	Iter(ctx context.Context, opts *SecurityGroupListOptions) Seq2[*resource.SecurityGroup, error]
+	// IterRunningForSpace returns an iterator over all security groups that are enabled for running globally or at the space level for the given space
+	IterRunningForSpace(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) Seq2[*resource.SecurityGroup, error]
+	// IterStagingForSpace returns an iterator over all security groups that are enabled for staging globally or at the space level for the given space
+	IterStagingForSpace(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) Seq2[*resource.SecurityGroup, error]
+	// List pages SecurityGroups the user has access to
+	List(ctx context.Context, opts *SecurityGroupListOptions) ([]*resource.SecurityGroup, *Pager, error)
+	// ListAll retrieves all SecurityGroups the user has access to
+	ListAll(ctx context.Context, opts *SecurityGroupListOptions) ([]*resource.SecurityGroup, error)
+	// ListRunningForSpace pages security groups that are enabled for running globally or at the space level for the given space
+	ListRunningForSpace(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error)
+	// ListRunningForSpaceAll retrieves all security groups that are enabled for running globally or at the space level for the given space
+	ListRunningForSpaceAll(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, error)
+	// ListStagingForSpace pages security groups that are enabled for staging globally or at the space level for the given space
+	ListStagingForSpace(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error)
+	// ListStagingForSpaceAll retrieves all security groups that are enabled for staging globally or at the space level for the given space
+	ListStagingForSpaceAll(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, error)
+	// Single returns a single security group matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *SecurityGroupListOptions) (*resource.SecurityGroup, error)
+	// UnBindRunningSecurityGroup removes a space from a security group with the running lifecycle
+	//
+	// Apps within this space must be restarted for these changes to take effect.
+	UnBindRunningSecurityGroup(ctx context.Context, guid string, spaceGUID string) error
+	// UnBindStagingSecurityGroup removes a space from a security group with the staging lifecycle
+	//
+	// Apps within this space must be restarted for these changes to take effect.
+	UnBindStagingSecurityGroup(ctx context.Context, guid string, spaceGUID string) error
+	// Update the specified attributes of the app
+	Update(ctx context.Context, guid string, r *resource.SecurityGroupUpdate) (*resource.SecurityGroup, error)
+}
+
+var _ SecurityGroupService = (*SecurityGroupClient)(nil)
+
+// ServiceBrokerService is the interface implemented by ServiceBrokerClient
+type ServiceBrokerService interface {
+	// Create a new service broker asynchronously and return a jobGUID
+	Create(ctx context.Context, r *resource.ServiceBrokerCreate) (string, error)
+	// Delete the specified service broker asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first service broker matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ServiceBrokerListOptions) (*resource.ServiceBroker, error)
+	// Get the specified service broker
+	Get(ctx context.Context, guid string) (*resource.ServiceBroker, error)
+	// Iter returns an iterator over all service brokers the user has access to
+	Iter(ctx context.Context, opts *ServiceBrokerListOptions) Seq2[*resource.ServiceBroker, error]
+	// List pages all the service brokers the user has access to
+	List(ctx context.Context, opts *ServiceBrokerListOptions) ([]*resource.ServiceBroker, *Pager, error)
+	// ListAll retrieves all service brokers the user has access to
+	ListAll(ctx context.Context, opts *ServiceBrokerListOptions) ([]*resource.ServiceBroker, error)
+	// Single returns a single service broker matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ServiceBrokerListOptions) (*resource.ServiceBroker, error)
+	// Update the specified attributes of the service broker returning either a jobGUID or a service broker instance.
+	// Only metadata updates synchronously and return a service broker instance, all other updates return a jobGUID
+	Update(ctx context.Context, guid string, r *resource.ServiceBrokerUpdate) (string, *resource.ServiceBroker, error)
+}
+
+var _ ServiceBrokerService = (*ServiceBrokerClient)(nil)
+
+// ServiceCredentialBindingService is the interface implemented by ServiceCredentialBindingClient
+type ServiceCredentialBindingService interface {
+	// Create a new service credential binding
+	Create(ctx context.Context, r *resource.ServiceCredentialBindingCreate) (string, *resource.ServiceCredentialBinding, error)
+	// Delete the specified service credential binding
+	Delete(ctx context.Context, guid string) error
+	// First returns the first service credential binding matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ServiceCredentialBindingListOptions) (*resource.ServiceCredentialBinding, error)
+	// Get the specified service credential binding
+	Get(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, error)
+	// GetDetails the specified service credential binding details
+	GetDetails(ctx context.Context, guid string) (*resource.ServiceCredentialBindingDetails, error)
+	// GetIncludeApp allows callers to fetch a service credential binding and include the associated app
+	GetIncludeApp(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, *resource.App, error)
+	// GetIncludeServiceInstance allows callers to fetch a service credential binding and include the associated service instance
+	GetIncludeServiceInstance(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, *resource.ServiceInstance, error)
+	// GetParameters the specified service credential binding details
+	GetParameters(ctx context.Context, guid string) (map[string]string, error)
+	// Iter returns an iterator over all ServiceCredentialBindings the user has access to
+	Iter(ctx context.Context, opts *ServiceCredentialBindingListOptions) Seq2[*resource.ServiceCredentialBinding, error]
+	// List pages ServiceCredentialBindings the user has access to
+	List(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, *Pager, error)
+	// ListAll retrieves all ServiceCredentialBindings the user has access to
+	ListAll(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, error)
+	// ListIncludeApps pages all service credential bindings the user has access to and include the associated apps
+	ListIncludeApps(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.App, *Pager, error)
+	// ListIncludeAppsAll retrieves all service credential bindings the user has access to and include the associated apps
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeApps to fetch them a page at
+	// a time instead.
+	ListIncludeAppsAll(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.App, error)
+	// ListIncludeServiceInstances pages all service credential bindings the user has access to and include the associated SIs
+	ListIncludeServiceInstances(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.ServiceInstance, *Pager, error)
+	// ListIncludeServiceInstancesAll retrieves all service credential bindings the user has access to and include the associated SIs
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeServiceInstances to fetch them a page at
+	// a time instead.
+	ListIncludeServiceInstancesAll(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.ServiceInstance, error)
+	// Single returns a single service credential binding matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ServiceCredentialBindingListOptions) (*resource.ServiceCredentialBinding, error)
+	// Update the specified attributes of the app
+	Update(ctx context.Context, guid string, r *resource.ServiceCredentialBindingUpdate) (*resource.ServiceCredentialBinding, error)
+}
+
+var _ ServiceCredentialBindingService = (*ServiceCredentialBindingClient)(nil)
+
+// ServiceInstanceService is the interface implemented by ServiceInstanceClient
+type ServiceInstanceService interface {
+	// CreateManaged requests a new service instance asynchronously from a broker. The result
+	// of this call is an error or the jobGUID.
+	CreateManaged(ctx context.Context, r *resource.ServiceInstanceManagedCreate) (string, error)
+	// CreateUserProvided creates a new user provided service instance. User provided service instances
+	// do not require interactions with service brokers.
+	CreateUserProvided(ctx context.Context, r *resource.ServiceInstanceUserProvidedCreate) (*resource.ServiceInstance, error)
+	// Delete the specified service instance returning the async deletion jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first service instance matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ServiceInstanceListOptions) (*resource.ServiceInstance, error)
+	// Get the specified service instance
+	Get(ctx context.Context, guid string) (*resource.ServiceInstance, error)
+	// GetManagedParameters queries the service broker for the parameters associated with this managed service instance
+	//
+	// The broker catalog must have enabled the instances_retrievable feature for the Service Offering.
+	// Check the Service Offering object for the value of this feature flag.
+	GetManagedParameters(ctx context.Context, guid string) (*json.RawMessage, error)
+	// GetSharedSpaceRelationships lists the spaces that the service instance has been shared to
+	GetSharedSpaceRelationships(ctx context.Context, guid string) (*resource.ServiceInstanceSharedSpaceRelationships, error)
+	// GetSharedSpaceUsageSummary retrieves the number of bound apps in spaces where the service instance has been shared to
+	GetSharedSpaceUsageSummary(ctx context.Context, guid string) (*resource.ServiceInstanceUsageSummary, error)
+	// GetUserPermissions retrieves the current user’s permissions for the given service instance
+	//
+	// If a user can get a service instance then they can ‘read’ it. Users who can update a service instance can ‘manage’ it.
+	//
+	// This endpoint’s primary purpose is to enable third-party service dashboards to determine the permissions of a
+	// given Cloud Foundry user that has authenticated with the dashboard via single sign-on (SSO). For more information,
+	// see the Cloud Foundry documentation on Dashboard Single Sign-On.
+	GetUserPermissions(ctx context.Context, guid string) (*resource.ServiceInstanceUserPermissions, error)
+	// GetUserProvidedCredentials the specified user provided service instance credentials
+	GetUserProvidedCredentials(ctx context.Context, guid string) (*json.RawMessage, error)
+	// Iter returns an iterator over all service instances the user has access to
+	Iter(ctx context.Context, opts *ServiceInstanceListOptions) Seq2[*resource.ServiceInstance, error]
+	// List pages all service instances the user has access to
+	List(ctx context.Context, opts *ServiceInstanceListOptions) ([]*resource.ServiceInstance, *Pager, error)
+	// ListAll retrieves all service instances the user has access to
+	ListAll(ctx context.Context, opts *ServiceInstanceListOptions) ([]*resource.ServiceInstance, error)
+	// ShareWithSpace shares the service instance with the specified space
+	//
+	// In order to share into a space the requesting user must be a space developer in the target space
+	ShareWithSpace(ctx context.Context, guid string, spaceGUID string) (*resource.ServiceInstanceSharedSpaceRelationships, error)
+	// ShareWithSpaces shares the service instance with the specified spaces
+	//
+	// In order to share into a space the requesting user must be a space developer in the target space
+	ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.ServiceInstanceSharedSpaceRelationships, error)
+	// Single returns a single service instance matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ServiceInstanceListOptions) (*resource.ServiceInstance, error)
+	// UnShareWithSpace un=shares the service instance with the specified space
+	//
+	// This will automatically unbind any applications bound to this service instance in the specified space
+	// Un-sharing a service instance from a space will not delete any service keys
+	UnShareWithSpace(ctx context.Context, guid string, spaceGUID string) error
+	// UnShareWithSpaces un-shares the service instance with the specified spaces
+	//
+	// This will automatically unbind any applications bound to this service instance in the specified space
+	// Un-sharing a service instance from a space will not delete any service keys
+	UnShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) error
+	// UpdateManaged updates the specified attributes of the managed service instance returning either a jobGUID or a
+	// service instance object
+	//
+	// Only metadata, tags, and name (when allow_context_updates feature disabled) updates synchronously and return a service
+	// instance object, all other updates return a jobGUID
+	UpdateManaged(ctx context.Context, guid string, r *resource.ServiceInstanceManagedUpdate) (string, *resource.ServiceInstance, error)
+	// UpdateUserProvided updates the specified attributes of the user-provided service instance returning a
+	// service instance object
+	UpdateUserProvided(ctx context.Context, guid string, r *resource.ServiceInstanceUserProvidedUpdate) (*resource.ServiceInstance, error)
+}
+
+var _ ServiceInstanceService = (*ServiceInstanceClient)(nil)
+
+// ServiceOfferingService is the interface implemented by ServiceOfferingClient
+type ServiceOfferingService interface {
+	// Delete the specified service offering
+	Delete(ctx context.Context, guid string) error
+	// First returns the first service offering matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ServiceOfferingListOptions) (*resource.ServiceOffering, error)
+	// Get the specified service offering
+	Get(ctx context.Context, guid string) (*resource.ServiceOffering, error)
+	// Iter returns an iterator over all service offerings the user has access to
+	Iter(ctx context.Context, opts *ServiceOfferingListOptions) Seq2[*resource.ServiceOffering, error]
+	// List pages service offerings the user has access to
+	List(ctx context.Context, opts *ServiceOfferingListOptions) ([]*resource.ServiceOffering, *Pager, error)
+	// ListAll retrieves all service offerings the user has access to
+	ListAll(ctx context.Context, opts *ServiceOfferingListOptions) ([]*resource.ServiceOffering, error)
+	// Single returns a single service offering matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ServiceOfferingListOptions) (*resource.ServiceOffering, error)
+	// Update the specified attributes of the service offering
+	Update(ctx context.Context, guid string, r *resource.ServiceOfferingUpdate) (*resource.ServiceOffering, error)
+}
+
+var _ ServiceOfferingService = (*ServiceOfferingClient)(nil)
+
+// ServicePlanService is the interface implemented by ServicePlanClient
+type ServicePlanService interface {
+	// Delete the specified service plan
+	Delete(ctx context.Context, guid string) error
+	// First returns the first service plan matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ServicePlanListOptions) (*resource.ServicePlan, error)
+	// Get the specified service plan
+	Get(ctx context.Context, guid string) (*resource.ServicePlan, error)
+	// GetIncludeServicePlan allows callers to fetch a service plan and include the associated service offering
+	GetIncludeServicePlan(ctx context.Context, guid string) (*resource.ServicePlan, *resource.ServiceOffering, error)
+	// GetIncludeSpaceAndOrganization allows callers to fetch a service plan and include the parent space and organization
+	GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.ServicePlan, *resource.Space, *resource.Organization, error)
+	// Iter returns an iterator over all service plans the user has access to
+	Iter(ctx context.Context, opts *ServicePlanListOptions) Seq2[*resource.ServicePlan, error]
+	// List pages service plans the user has access to
+	List(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, *Pager, error)
+	// ListAll retrieves all service plans the user has access to
+	ListAll(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, error)
+	// ListIncludeServiceOffering page all service plans the user has access to and include the associated service offerings
+	ListIncludeServiceOffering(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.ServiceOffering, *Pager, error)
+	// ListIncludeServiceOfferingAll retrieves all service plans the user has access to and include the associated service offerings
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeServiceOffering to fetch them a page at
+	// a time instead.
+	ListIncludeServiceOfferingAll(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.ServiceOffering, error)
+	// ListIncludeSpacesAndOrganizations page all service plans the user has access to and include the associated spaces and organizations
+	ListIncludeSpacesAndOrganizations(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.Space, []*resource.Organization, *Pager, error)
+	// ListIncludeSpacesAndOrganizationsAll retrieves all service plans the user has access to and include the associated spaces and organizations
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeSpacesAndOrganizations to fetch them a page at
+	// a time instead.
+	ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.Space, []*resource.Organization, error)
+	// Single returns a single service plan matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ServicePlanListOptions) (*resource.ServicePlan, error)
+	// Update the specified attributes of the service plan
+	Update(ctx context.Context, guid string, r *resource.ServicePlanUpdate) (*resource.ServicePlan, error)
+}
+
+var _ ServicePlanService = (*ServicePlanClient)(nil)
+
+// ServicePlanVisibilityService is the interface implemented by ServicePlanVisibilityClient
+type ServicePlanVisibilityService interface {
+	// Apply a service plan visibility. It behaves similar to the Update service plan visibility endpoint
+	// but this endpoint will append to the existing list of organizations when the service plan is
+	// organization visible
+	Apply(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error)
+	// Delete an organization from a service plan visibility list of organizations
+	// It is only defined for service plans which are organization restricted
+	Delete(ctx context.Context, servicePlanGUID string, organizationGUID string) error
+	// Get the specified service plan visibility
+	Get(ctx context.Context, servicePlanGUID string) (*resource.ServicePlanVisibility, error)
+	// Update a service plan visibility. It behaves similar to Apply service plan visibility endpoint
+	// but this endpoint will replace the existing list of organizations when the service plan is
+	// organization visible
+	Update(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error)
+}
+
+var _ ServicePlanVisibilityService = (*ServicePlanVisibilityClient)(nil)
+
+// ServiceRouteBindingService is the interface implemented by ServiceRouteBindingClient
+type ServiceRouteBindingService interface {
+	// Create a new service route binding returning the jobGUID for managed service instances or the
+	// service route binding object for user provided service instances
+	Create(ctx context.Context, r *resource.ServiceRouteBindingCreate) (string, *resource.ServiceRouteBinding, error)
+	// Delete the specified service route binding returning the jobGUID for managed service instances or empty string
+	// for user provided service instances
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first service route binding matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ServiceRouteBindingListOptions) (*resource.ServiceRouteBinding, error)
+	// Get the specified service route binding
+	Get(ctx context.Context, guid string) (*resource.ServiceRouteBinding, error)
+	// GetIncludeRoute allows callers to fetch a service route binding and include the associated route
+	GetIncludeRoute(ctx context.Context, guid string) (*resource.ServiceRouteBinding, *resource.Route, error)
+	// GetIncludeServiceInstance allows callers to fetch a service route binding and include the associated service instance
+	GetIncludeServiceInstance(ctx context.Context, guid string) (*resource.ServiceRouteBinding, *resource.ServiceInstance, error)
+	// GetParameters queries the Service Broker for the parameters associated with this service route binding
+	GetParameters(ctx context.Context, guid string) (map[string]string, error)
+	// Iter returns an iterator over all service route bindings the user has access to
+	Iter(ctx context.Context, opts *ServiceRouteBindingListOptions) Seq2[*resource.ServiceRouteBinding, error]
+	// List pages all the service route bindings the user has access to
+	List(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, *Pager, error)
+	// ListAll retrieves all service route bindings the user has access to
+	ListAll(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, error)
+	// ListIncludeRoutes page all service route bindings the user has access to and include the associated routes
+	ListIncludeRoutes(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.Route, *Pager, error)
+	// ListIncludeRoutesAll retrieves all service route bindings the user has access to and include the associated routes
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeRoutes to fetch them a page at
+	// a time instead.
+	ListIncludeRoutesAll(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.Route, error)
+	// ListIncludeServiceInstances page all service route bindings the user has access to and include the
+	// associated service instances
+	ListIncludeServiceInstances(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.ServiceInstance, *Pager, error)
+	// ListIncludeServiceInstancesAll retrieves all service route bindings the user has access to and include the
+	// associated service instances
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeServiceInstances to fetch
+	// them a page at a time instead.
+	ListIncludeServiceInstancesAll(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.ServiceInstance, error)
+	// Single returns a single service route binding matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ServiceRouteBindingListOptions) (*resource.ServiceRouteBinding, error)
+	// Update the specified attributes of the service route binding
+	Update(ctx context.Context, guid string, r *resource.ServiceRouteBindingUpdate) (*resource.ServiceRouteBinding, error)
+}
+
+var _ ServiceRouteBindingService = (*ServiceRouteBindingClient)(nil)
+
+// ServiceUsageService is the interface implemented by ServiceUsageClient
+type ServiceUsageService interface {
+	// First returns the first space matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *ServiceUsageListOptions) (*resource.ServiceUsage, error)
+	// Get retrieves the specified service event
+	Get(ctx context.Context, guid string) (*resource.ServiceUsage, error)
+	// Iter returns an iterator over all service usage events
+	Iter(ctx context.Context, opts *ServiceUsageListOptions) Seq2[*resource.ServiceUsage, error]
+	// List pages all service usage events
+	List(ctx context.Context, opts *ServiceUsageListOptions) ([]*resource.ServiceUsage, *Pager, error)
+	// ListAll retrieves all service usage events
+	ListAll(ctx context.Context, opts *ServiceUsageListOptions) ([]*resource.ServiceUsage, error)
+	// Purge destroys all existing events. Populates new usage events, one for each existing service instance.
+	// All populated events will have a created_at value of current time.
+	//
+	// There is the potential race condition if service instances are currently being created or deleted.
+	// The seeded usage events will have the same guid as the service instance.
+	Purge(ctx context.Context) error
+	// Single returns a single service usage matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *ServiceUsageListOptions) (*resource.ServiceUsage, error)
+}
+
+var _ ServiceUsageService = (*ServiceUsageClient)(nil)
+
+// SidecarService is the interface implemented by SidecarClient
+type SidecarService interface {
+	// Create a new app sidecar
+	Create(ctx context.Context, appGUID string, r *resource.SidecarCreate) (*resource.Sidecar, error)
+	// Delete the specified sidecar
+	Delete(ctx context.Context, guid string) error
+	// FirstForApp returns the first sidecar matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *SidecarListOptions) (*resource.Sidecar, error)
+	// FirstForProcess returns the first sidecar matching the options and process or an error when less than 1 match
+	FirstForProcess(ctx context.Context, processGUID string, opts *SidecarListOptions) (*resource.Sidecar, error)
+	// Get the specified app
+	Get(ctx context.Context, guid string) (*resource.Sidecar, error)
+	// IterForApp returns an iterator over all sidecars associated with the specified app
+	IterForApp(ctx context.Context, appGUID string, opts *SidecarListOptions) Seq2[*resource.Sidecar, error]
+	// IterForProcess returns an iterator over all sidecars associated with the specified process
+	IterForProcess(ctx context.Context, processGUID string, opts *SidecarListOptions) Seq2[*resource.Sidecar, error]
+	// ListForApp pages all sidecars associated with the specified app
+	ListForApp(ctx context.Context, appGUID string, opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error)
+	// ListForAppAll retrieves all sidecars associated with the specified app
+	ListForAppAll(ctx context.Context, appGUID string, opts *SidecarListOptions) ([]*resource.Sidecar, error)
+	// ListForProcess pages all sidecars associated with the specified process
+	ListForProcess(ctx context.Context, processGUID string, opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error)
+	// ListForProcessAll retrieves all sidecars associated with the specified process
+	ListForProcessAll(ctx context.Context, processGUID string, opts *SidecarListOptions) ([]*resource.Sidecar, error)
+	// SingleForApp returns a single sidecar matching the options and app or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *SidecarListOptions) (*resource.Sidecar, error)
+	// SingleForProcess returns a single sidecar matching the options and process or an error if not exactly 1 match
+	SingleForProcess(ctx context.Context, processGUID string, opts *SidecarListOptions) (*resource.Sidecar, error)
+	// Update the specified attributes of the app
+	Update(ctx context.Context, guid string, r *resource.SidecarUpdate) (*resource.Sidecar, error)
+}
+
+var _ SidecarService = (*SidecarClient)(nil)
+
+// SpaceService is the interface implemented by SpaceClient
+type SpaceService interface {
+	// AssignIsolationSegment assigns an isolation segment to the space
+	//
+	// Apps will not run in the isolation segment until they are restarted
+	// An empty isolationSegmentGUID will un-assign the isolation segment
+	AssignIsolationSegment(ctx context.Context, guid string, isolationSegmentGUID string) error
+	// Create a new space
+	Create(ctx context.Context, r *resource.SpaceCreate) (*resource.Space, error)
+	// Delete the specified space asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first space matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *SpaceListOptions) (*resource.Space, error)
+	// Get the specified space
+	Get(ctx context.Context, guid string) (*resource.Space, error)
+	// GetAssignedIsolationSegment gets the space's assigned isolation segment, if any
+	GetAssignedIsolationSegment(ctx context.Context, guid string) (string, error)
+	// GetIncludeOrganization allows callers to fetch a space and include the parent organization
+	GetIncludeOrganization(ctx context.Context, guid string) (*resource.Space, *resource.Organization, error)
+	// Iter returns an iterator over all spaces the user has access to
+	Iter(ctx context.Context, opts *SpaceListOptions) Seq2[*resource.Space, error]
+	// IterUsers returns an iterator over all users by space GUID
+	IterUsers(ctx context.Context, spaceGUID string, opts *UserListOptions) Seq2[*resource.User, error]
+	// List pages all spaces the user has access to
+	List(ctx context.Context, opts *SpaceListOptions) ([]*resource.Space, *Pager, error)
+	// ListAll retrieves all spaces the user has access to
+	ListAll(ctx context.Context, opts *SpaceListOptions) ([]*resource.Space, error)
+	// ListIncludeOrganizations page all spaces the user has access to and include the parent organizations
+	ListIncludeOrganizations(ctx context.Context, opts *SpaceListOptions) ([]*resource.Space, []*resource.Organization, *Pager, error)
+	// ListIncludeOrganizationsAll retrieves all spaces the user has access to and include the parent organizations
+	//
+	// There's no iterator over the resources with their included resources, use ListIncludeOrganizations to fetch them a page at
+	// a time instead.
+	ListIncludeOrganizationsAll(ctx context.Context, opts *SpaceListOptions) ([]*resource.Space, []*resource.Organization, error)
+	// ListUsers pages users by space GUID
+	ListUsers(ctx context.Context, spaceGUID string, opts *UserListOptions) ([]*resource.User, *Pager, error)
+	// ListUsersAll retrieves all users by space GUID
+	ListUsersAll(ctx context.Context, spaceGUID string, opts *UserListOptions) ([]*resource.User, error)
+	// Single returns a single space matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *SpaceListOptions) (*resource.Space, error)
+	// Update the specified attributes of a space
+	Update(ctx context.Context, guid string, r *resource.SpaceUpdate) (*resource.Space, error)
+}
+
+var _ SpaceService = (*SpaceClient)(nil)
+
+// SpaceFeatureService is the interface implemented by SpaceFeatureClient
+type SpaceFeatureService interface {
+	// EnableSSH toggles the SSH feature for a space
+	EnableSSH(ctx context.Context, spaceGUID string, enable bool) error
+	// IsSSHEnabled returns true if SSH is enabled for the specified space
+	IsSSHEnabled(ctx context.Context, spaceGUID string) (bool, error)
+}
+
+var _ SpaceFeatureService = (*SpaceFeatureClient)(nil)
+
+// SpaceQuotaService is the interface implemented by SpaceQuotaClient
+type SpaceQuotaService interface {
+	// Apply the quota to the specified spaces
+	Apply(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error)
+	// Create a new space quota
+	Create(ctx context.Context, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error)
+	// Delete the specified space quota asynchronously and return a jobGUID
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first space quota matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *SpaceQuotaListOptions) (*resource.SpaceQuota, error)
+	// Get the specified space quota
+	Get(ctx context.Context, guid string) (*resource.SpaceQuota, error)
+	// Iter returns an iterator over all space quotas the user has access to
+	Iter(ctx context.Context, opts *SpaceQuotaListOptions) Seq2[*resource.SpaceQuota, error]
+	// List pages all space quotas the user has access to
+	List(ctx context.Context, opts *SpaceQuotaListOptions) ([]*resource.SpaceQuota, *Pager, error)
+	// ListAll retrieves all space quotas the user has access to
+	ListAll(ctx context.Context, opts *SpaceQuotaListOptions) ([]*resource.SpaceQuota, error)
+	// Remove the space quota from the specified space
+	Remove(ctx context.Context, guid string, spaceGUID string) error
+	// Single returns a single space quota matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *SpaceQuotaListOptions) (*resource.SpaceQuota, error)
+	// Update the specified attributes of the organization quota
+	Update(ctx context.Context, guid string, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error)
+}
+
+var _ SpaceQuotaService = (*SpaceQuotaClient)(nil)
+
+// StackService is the interface implemented by StackClient
+type StackService interface {
+	// Create a new stack
+	Create(ctx context.Context, r *resource.StackCreate) (*resource.Stack, error)
+	// Delete the specified stack
+	Delete(ctx context.Context, guid string) error
+	// First returns the first stack matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *StackListOptions) (*resource.Stack, error)
+	// Get the specified stack
+	Get(ctx context.Context, guid string) (*resource.Stack, error)
+	// Iter returns an iterator over all stacks the user has access to
+	Iter(ctx context.Context, opts *StackListOptions) Seq2[*resource.Stack, error]
+	// IterAppsOnStack returns an iterator over all apps using a given stack
+	IterAppsOnStack(ctx context.Context, guid string, opts *StackListOptions) Seq2[*resource.App, error]
+	// List pages all stacks the user has access to
+	List(ctx context.Context, opts *StackListOptions) ([]*resource.Stack, *Pager, error)
+	// ListAll retrieves all stacks the user has access to
+	ListAll(ctx context.Context, opts *StackListOptions) ([]*resource.Stack, error)
+	// ListAppsOnStack pages all apps using a given stack
+	ListAppsOnStack(ctx context.Context, guid string, opts *StackListOptions) ([]*resource.App, *Pager, error)
+	// ListAppsOnStackAll retrieves all apps using a given stack
+	ListAppsOnStackAll(ctx context.Context, guid string, opts *StackListOptions) ([]*resource.App, error)
+	// Single returns a single stack matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *StackListOptions) (*resource.Stack, error)
+	// Update the specified attributes of a stack
+	Update(ctx context.Context, guid string, r *resource.StackUpdate) (*resource.Stack, error)
+}
+
+var _ StackService = (*StackClient)(nil)
+
+// TaskService is the interface implemented by TaskClient
+type TaskService interface {
+	// Cancel the specified task
+	//
+	// Canceled tasks will initially be in state CANCELING and will move to state FAILED once the cancel request
+	// has been processed. Cancel requests are idempotent and will be processed according to the state of the
+	// task when the request is executed. Canceling a task that is in SUCCEEDED or FAILED state will return an error.
+	Cancel(ctx context.Context, guid string) (*resource.Task, error)
+	// Create a new task for the specified app
+	Create(ctx context.Context, appGUID string, r *resource.TaskCreate) (*resource.Task, error)
+	// First returns the first task matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *TaskListOptions) (*resource.Task, error)
+	// FirstForApp returns the first task matching the options and app or an error when less than 1 match
+	FirstForApp(ctx context.Context, appGUID string, opts *TaskListOptions) (*resource.Task, error)
+	// Get the specified task
+	Get(ctx context.Context, guid string) (*resource.Task, error)
+	// Iter returns an iterator over all tasks the user has access to. The command field is excluded in the response.
+	Iter(ctx context.Context, opts *TaskListOptions) Seq2[*resource.Task, error]
+	// IterForApp returns an iterator over all tasks for the specified app that the user has access to. The command field
+	// may be excluded in the response based on the user’s role.
+	IterForApp(ctx context.Context, appGUID string, opts *TaskListOptions) Seq2[*resource.Task, error]
+	// List pages all the tasks the user has access to. The command field is excluded in the response.
+	List(ctx context.Context, opts *TaskListOptions) ([]*resource.Task, *Pager, error)
+	// ListAll retrieves all tasks the user has access to. The command field is excluded in the response.
+	ListAll(ctx context.Context, opts *TaskListOptions) ([]*resource.Task, error)
+	// ListForApp pages all the tasks for the specified app that the user has access to. The command field
+	// may be excluded in the response based on the user’s role.
+	ListForApp(ctx context.Context, appGUID string, opts *TaskListOptions) ([]*resource.Task, *Pager, error)
+	// ListForAppAll retrieves all the tasks for the specified app that the user has access to. The command field
+	// may be excluded in the response based on the user’s role.
+	ListForAppAll(ctx context.Context, appGUID string, opts *TaskListOptions) ([]*resource.Task, error)
+	// Single returns a single task matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *TaskListOptions) (*resource.Task, error)
+	// SingleForApp returns a single task matching the options or an error if not exactly 1 match
+	SingleForApp(ctx context.Context, appGUID string, opts *TaskListOptions) (*resource.Task, error)
+	// Update the specified attributes of the task
+	Update(ctx context.Context, guid string, r *resource.TaskUpdate) (*resource.Task, error)
+}
+
+var _ TaskService = (*TaskClient)(nil)
+
+// UserService is the interface implemented by UserClient
+type UserService interface {
+	// Create a new user
+	Create(ctx context.Context, r *resource.UserCreate) (*resource.User, error)
+	// Delete the specified user
+	Delete(ctx context.Context, guid string) (string, error)
+	// First returns the first user matching the options or an error when less than 1 match
+	First(ctx context.Context, opts *UserListOptions) (*resource.User, error)
+	// Get the specified user
+	Get(ctx context.Context, guid string) (*resource.User, error)
+	// Iter returns an iterator over all users the user has access to
+	Iter(ctx context.Context, opts *UserListOptions) Seq2[*resource.User, error]
+	// List pages all users the user has access to
+	List(ctx context.Context, opts *UserListOptions) ([]*resource.User, *Pager, error)
+	// ListAll retrieves all users the user has access to
+	ListAll(ctx context.Context, opts *UserListOptions) ([]*resource.User, error)
+	// Single returns a single user matching the options or an error if not exactly 1 match
+	Single(ctx context.Context, opts *UserListOptions) (*resource.User, error)
+	// Update the specified attributes of a user
+	Update(ctx context.Context, guid string, r *resource.UserUpdate) (*resource.User, error)
+}
+
+var _ UserService = (*UserClient)(nil)
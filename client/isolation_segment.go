@@ -34,7 +34,7 @@ func (o IsolationSegmentListOptions) ToQueryString() (url.Values, error) {
 // Create a new isolation segment
 func (c *IsolationSegmentClient) Create(ctx context.Context, r *resource.IsolationSegmentCreate) (*resource.IsolationSegment, error) {
 	var iso resource.IsolationSegment
-	_, err := c.client.post(ctx, "/v3/isolation_segments", r, &iso)
+	_, err := c.client.post(ctx, "IsolationSegments.Create", "/v3/isolation_segments", r, &iso)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +45,7 @@ func (c *IsolationSegmentClient) Create(ctx context.Context, r *resource.Isolati
 //
 // An isolation segment cannot be deleted if it is entitled to any organization.
 func (c *IsolationSegmentClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/isolation_segments/%s", guid))
+	_, err := c.client.delete(ctx, "IsolationSegments.Delete", path.Format("/v3/isolation_segments/%s", guid))
 	return err
 }
 
@@ -66,7 +66,7 @@ func (c *IsolationSegmentClient) EntitleOrganization(ctx context.Context, guid s
 func (c *IsolationSegmentClient) EntitleOrganizations(ctx context.Context, guid string, organizationGUIDs []string) (*resource.IsolationSegmentRelationship, error) {
 	req := resource.NewToManyRelationships(organizationGUIDs)
 	var iso resource.IsolationSegmentRelationship
-	_, err := c.client.post(ctx, path.Format("/v3/isolation_segments/%s/relationships/organizations", guid), req, &iso)
+	_, err := c.client.post(ctx, "IsolationSegments.EntitleOrganizations", path.Format("/v3/isolation_segments/%s/relationships/organizations", guid), req, &iso)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func (c *IsolationSegmentClient) First(ctx context.Context, opts *IsolationSegme
 // Get the specified isolation segment
 func (c *IsolationSegmentClient) Get(ctx context.Context, guid string) (*resource.IsolationSegment, error) {
 	var iso resource.IsolationSegment
-	err := c.client.get(ctx, path.Format("/v3/isolation_segments/%s", guid), &iso)
+	err := c.client.get(ctx, "IsolationSegments.Get", path.Format("/v3/isolation_segments/%s", guid), &iso)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +100,7 @@ func (c *IsolationSegmentClient) List(ctx context.Context, opts *IsolationSegmen
 	}
 
 	var isos resource.IsolationSegmentList
-	err := c.client.list(ctx, "/v3/isolation_segments", opts.ToQueryString, &isos)
+	err := c.client.list(ctx, "IsolationSegments.List", "/v3/isolation_segments", opts.ToQueryString, &isos)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,7 +116,20 @@ func (c *IsolationSegmentClient) ListAll(ctx context.Context, opts *IsolationSeg
 	if opts == nil {
 		opts = NewIsolationSegmentOptions()
 	}
-	return AutoPage[*IsolationSegmentListOptions, *resource.IsolationSegment](opts, func(opts *IsolationSegmentListOptions) ([]*resource.IsolationSegment, *Pager, error) {
+	return AutoPageConcurrent[*IsolationSegmentListOptions, *resource.IsolationSegment](opts, c.client.PageConcurrency(), func(opts *IsolationSegmentListOptions) ([]*resource.IsolationSegment, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all isolation segments the user has access to
+//
+// For admin, this is all the isolation segments in the system. For anyone else,  this is
+// the isolation segments in the allowed list for any organization to which the user belongs.
+func (c *IsolationSegmentClient) Iter(ctx context.Context, opts *IsolationSegmentListOptions) Seq2[*resource.IsolationSegment, error] {
+	if opts == nil {
+		opts = NewIsolationSegmentOptions()
+	}
+	return AutoPageIter[*IsolationSegmentListOptions, *resource.IsolationSegment](ctx, opts, func(opts *IsolationSegmentListOptions) ([]*resource.IsolationSegment, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -127,7 +140,7 @@ func (c *IsolationSegmentClient) ListAll(ctx context.Context, opts *IsolationSeg
 // this will list only the entitled organizations to which the user belongs.
 func (c *IsolationSegmentClient) ListOrganizationRelationships(ctx context.Context, guid string) ([]string, error) {
 	var relationships resource.IsolationSegmentRelationship
-	err := c.client.get(ctx, path.Format("/v3/isolation_segments/%s/relationships/organizations", guid), &relationships)
+	err := c.client.get(ctx, "IsolationSegments.ListOrganizationRelationships", path.Format("/v3/isolation_segments/%s/relationships/organizations", guid), &relationships)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +160,7 @@ func (c *IsolationSegmentClient) ListOrganizationRelationships(ctx context.Conte
 // user has access.
 func (c *IsolationSegmentClient) ListSpaceRelationships(ctx context.Context, guid string) ([]string, error) {
 	var relationships resource.IsolationSegmentRelationship
-	err := c.client.get(ctx, path.Format("/v3/isolation_segments/%s/relationships/spaces", guid), &relationships)
+	err := c.client.get(ctx, "IsolationSegments.ListSpaceRelationships", path.Format("/v3/isolation_segments/%s/relationships/spaces", guid), &relationships)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +177,7 @@ func (c *IsolationSegmentClient) ListSpaceRelationships(ctx context.Context, gui
 // If the isolation segment is assigned to a space within an organization, the entitlement cannot be revoked.
 // If the isolation segment is the organization’s default, the entitlement cannot be revoked.
 func (c *IsolationSegmentClient) RevokeOrganization(ctx context.Context, guid string, organizationGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/isolation_segments/%s/relationships/organizations/%s", guid, organizationGUID))
+	_, err := c.client.delete(ctx, "IsolationSegments.RevokeOrganization", path.Format("/v3/isolation_segments/%s/relationships/organizations/%s", guid, organizationGUID))
 	return err
 }
 
@@ -192,7 +205,7 @@ func (c *IsolationSegmentClient) Single(ctx context.Context, opts *IsolationSegm
 // Update the specified attributes of the isolation segments
 func (c *IsolationSegmentClient) Update(ctx context.Context, guid string, r *resource.IsolationSegmentUpdate) (*resource.IsolationSegment, error) {
 	var iso resource.IsolationSegment
-	_, err := c.client.patch(ctx, path.Format("/v3/isolation_segments/%s", guid), r, &iso)
+	_, err := c.client.patch(ctx, "IsolationSegments.Update", path.Format("/v3/isolation_segments/%s", guid), r, &iso)
 	if err != nil {
 		return nil, err
 	}
@@ -11,7 +11,7 @@ type JobClient commonClient
 // Get the specified job
 func (c *JobClient) Get(ctx context.Context, guid string) (*resource.Job, error) {
 	var job resource.Job
-	err := c.client.get(ctx, path.Format("/v3/jobs/%s", guid), &job)
+	err := c.client.get(ctx, "Jobs.Get", path.Format("/v3/jobs/%s", guid), &job)
 	if err != nil {
 		return nil, err
 	}
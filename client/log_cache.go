@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// LogCacheMaxLimit is the maximum number of envelopes log cache returns from a single read
+const LogCacheMaxLimit = 1000
+
+// ErrNoLogCache is returned when the API root doesn't link to a log cache
+var ErrNoLogCache = errors.New("the Cloud Foundry API root doesn't link to a log cache")
+
+// LogCacheClient reads app logs and metrics from log cache
+type LogCacheClient commonClient
+
+// LogCacheReadOptions filters the envelopes read from log cache
+type LogCacheReadOptions struct {
+	// StartTime is the inclusive time of the oldest envelope to return, defaults to the oldest available
+	StartTime time.Time
+
+	// EndTime is the exclusive time of the newest envelope to return, defaults to now
+	EndTime time.Time
+
+	// EnvelopeTypes limits the envelopes returned to the specified types, defaults to all types
+	EnvelopeTypes []resource.EnvelopeType
+
+	// Limit is the maximum number of envelopes returned, up to LogCacheMaxLimit
+	Limit int
+
+	// Descending returns the newest envelopes first
+	Descending bool
+
+	// NameFilter is a regular expression matched against counter, gauge and timer names
+	NameFilter string
+}
+
+// NewLogCacheReadOptions creates new options to pass to Read
+func NewLogCacheReadOptions() *LogCacheReadOptions {
+	return &LogCacheReadOptions{
+		Limit: 100,
+	}
+}
+
+func (o LogCacheReadOptions) ToQueryString() (url.Values, error) {
+	if o.Limit < 0 || o.Limit > LogCacheMaxLimit {
+		return nil, fmt.Errorf("log cache read limit must be between 0 and %d, but got %d", LogCacheMaxLimit, o.Limit)
+	}
+	values := url.Values{}
+	if !o.StartTime.IsZero() {
+		values.Set("start_time", strconv.FormatInt(o.StartTime.UnixNano(), 10))
+	}
+	if !o.EndTime.IsZero() {
+		values.Set("end_time", strconv.FormatInt(o.EndTime.UnixNano(), 10))
+	}
+	for _, t := range o.EnvelopeTypes {
+		values.Add("envelope_types", string(t))
+	}
+	if o.Limit > 0 {
+		values.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Descending {
+		values.Set("descending", "true")
+	}
+	if o.NameFilter != "" {
+		values.Set("name_filter", o.NameFilter)
+	}
+	return values, nil
+}
+
+// Read the envelopes of the specified source, usually an app GUID, that match the options
+func (c *LogCacheClient) Read(ctx context.Context, sourceID string, opts *LogCacheReadOptions) ([]*resource.Envelope, error) {
+	if opts == nil {
+		opts = NewLogCacheReadOptions()
+	}
+	params, err := opts.ToQueryString()
+	if err != nil {
+		return nil, err
+	}
+	var res resource.LogCacheRead
+	err = c.get(ctx, "LogCache.Read", path.Format("/api/v1/read/%s?%s", sourceID, params), &res)
+	if err != nil {
+		return nil, err
+	}
+	return res.Envelopes.Batch, nil
+}
+
+// RecentLogs returns up to the last 1000 log lines of the specified app in chronological order, the same as
+// cf logs --recent
+func (c *LogCacheClient) RecentLogs(ctx context.Context, appGUID string) ([]*resource.Envelope, error) {
+	opts := &LogCacheReadOptions{
+		EnvelopeTypes: []resource.EnvelopeType{resource.EnvelopeTypeLog},
+		Limit:         LogCacheMaxLimit,
+		Descending:    true,
+	}
+	envelopes, err := c.Read(ctx, appGUID, opts)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(envelopes)
+	return envelopes, nil
+}
+
+// InstantQuery evaluates the PromQL query at a single point in time, for example the CPU usage of each app
+// instance: cpu{source_id="<app-guid>"}. A zero time evaluates the query at the current time.
+func (c *LogCacheClient) InstantQuery(ctx context.Context, query string, at time.Time) (*resource.PromQLResult, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	if !at.IsZero() {
+		values.Set("time", formatPromQLTime(at))
+	}
+	var res resource.PromQLResult
+	err := c.get(ctx, "LogCache.InstantQuery", path.Format("/api/v1/query?%s", values), &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// RangeQuery evaluates the PromQL query over the time range at each step, for example the memory usage of each
+// app instance over the last hour: memory{source_id="<app-guid>"}
+func (c *LogCacheClient) RangeQuery(ctx context.Context, query string, start, end time.Time, step time.Duration) (*resource.PromQLResult, error) {
+	if step <= 0 {
+		return nil, errors.New("range query step must be greater than zero")
+	}
+	values := url.Values{}
+	values.Set("query", query)
+	values.Set("start", formatPromQLTime(start))
+	values.Set("end", formatPromQLTime(end))
+	values.Set("step", strconv.FormatFloat(step.Seconds(), 'f', -1, 64))
+	var res resource.PromQLResult
+	err := c.get(ctx, "LogCache.RangeQuery", path.Format("/api/v1/query_range?%s", values), &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// get does an HTTP GET against the log cache discovered from the API root
+func (c *LogCacheClient) get(ctx context.Context, operation, resourcePath string, result any) error {
+	links, err := c.client.links(ctx)
+	if err != nil {
+		return err
+	}
+	if links.LogCache.Href == "" {
+		return ErrNoLogCache
+	}
+	return c.client.external(ctx, operation, http.MethodGet, links.LogCache.Href, resourcePath, nil, result)
+}
+
+// formatPromQLTime formats the time as decimal Unix seconds
+func formatPromQLTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixNano())/float64(time.Second), 'f', -1, 64)
+}
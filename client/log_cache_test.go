@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestLogCache(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(3)
+	appGUID := testutil.RandomGUID()
+	envelopes := g.LogCacheEnvelopes(appGUID).JSON
+	instantQuery := g.LogCacheInstantQuery(appGUID).JSON
+	rangeQuery := g.LogCacheRangeQuery(appGUID).JSON
+
+	var read struct {
+		Envelopes struct {
+			Batch json.RawMessage `json:"batch"`
+		} `json:"envelopes"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(envelopes), &read))
+
+	tests := []RouteTest{
+		{
+			Description: "Read envelopes",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/api/v1/read/" + appGUID,
+				Output:   g.Single(envelopes),
+				Status:   http.StatusOK,
+			},
+			Expected: string(read.Envelopes.Batch),
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.LogCache.Read(context.Background(), appGUID, nil)
+			},
+		},
+		{
+			Description: "Read filtered envelopes",
+			Route: testutil.MockRoute{
+				Method:      "GET",
+				Endpoint:    "/api/v1/read/" + appGUID,
+				Output:      g.Single(envelopes),
+				Status:      http.StatusOK,
+				QueryString: "descending=true&end_time=1580428900000000000&envelope_types=GAUGE&envelope_types=COUNTER&limit=10&name_filter=cpu|memory&start_time=1580428800000000000",
+			},
+			Expected: string(read.Envelopes.Batch),
+			Action: func(c *Client, t *testing.T) (any, error) {
+				opts := NewLogCacheReadOptions()
+				opts.StartTime = time.Unix(1580428800, 0)
+				opts.EndTime = time.Unix(1580428900, 0)
+				opts.EnvelopeTypes = []resource.EnvelopeType{resource.EnvelopeTypeGauge, resource.EnvelopeTypeCounter}
+				opts.Limit = 10
+				opts.Descending = true
+				opts.NameFilter = "cpu|memory"
+				return c.LogCache.Read(context.Background(), appGUID, opts)
+			},
+		},
+		{
+			Description: "Instant query",
+			Route: testutil.MockRoute{
+				Method:      "GET",
+				Endpoint:    "/api/v1/query",
+				Output:      g.Single(instantQuery),
+				Status:      http.StatusOK,
+				QueryString: `query=cpu{source_id="` + appGUID + `"}&time=1580428850`,
+			},
+			Expected: instantQuery,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.LogCache.InstantQuery(context.Background(), `cpu{source_id="`+appGUID+`"}`, time.Unix(1580428850, 0))
+			},
+		},
+		{
+			Description: "Range query",
+			Route: testutil.MockRoute{
+				Method:      "GET",
+				Endpoint:    "/api/v1/query_range",
+				Output:      g.Single(rangeQuery),
+				Status:      http.StatusOK,
+				QueryString: `end=1580428860&query=memory{source_id="` + appGUID + `"}&start=1580428800&step=60`,
+			},
+			Expected: rangeQuery,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.LogCache.RangeQuery(context.Background(), `memory{source_id="`+appGUID+`"}`,
+					time.Unix(1580428800, 0), time.Unix(1580428860, 0), time.Minute)
+			},
+		},
+	}
+	ExecuteTests(tests, t)
+}
+
+func TestLogCacheRecentLogs(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(3)
+	appGUID := testutil.RandomGUID()
+	serverURL := testutil.Setup(testutil.MockRoute{
+		Method:      "GET",
+		Endpoint:    "/api/v1/read/" + appGUID,
+		Output:      g.Single(g.LogCacheEnvelopes(appGUID).JSON),
+		Status:      http.StatusOK,
+		QueryString: "descending=true&envelope_types=LOG&limit=1000",
+	}, t)
+	defer testutil.Teardown()
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	require.NoError(t, err)
+	c, err := New(cfg)
+	require.NoError(t, err)
+
+	logs, err := c.LogCache.RecentLogs(context.Background(), appGUID)
+	require.NoError(t, err)
+	require.Len(t, logs, 6)
+
+	// the descending envelopes are returned in chronological order
+	require.Equal(t, resource.EnvelopeTypeEvent, logs[0].Type())
+	require.Equal(t, resource.EnvelopeTypeLog, logs[5].Type())
+	require.Equal(t, "Hello World", string(logs[5].Log.Payload))
+	require.Equal(t, resource.LogTypeOut, logs[5].Log.Type)
+	require.Equal(t, "Connection refused", string(logs[4].Log.Payload))
+	require.Equal(t, time.Unix(0, 1580428850380350000), logs[5].Time())
+	require.Equal(t, 0.25, logs[3].Gauge.Metrics["cpu"].Value)
+	require.EqualValues(t, 42, logs[2].Counter.Total)
+	require.EqualValues(t, 1580428850380000000, logs[1].Timer.Start)
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	internal "github.com/cloudfoundry-community/go-cfclient/v3/internal/http"
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/ios"
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+const (
+	// DefaultLogStreamReconnectDelay is how long to wait before reconnecting a dropped log stream
+	DefaultLogStreamReconnectDelay = time.Second
+
+	// maxLogStreamEventSize is the largest server-sent event accepted from the log stream
+	maxLogStreamEventSize = 1024 * 1024
+)
+
+// ErrNoLogStream is returned when the API root doesn't link to a log stream (RLP gateway)
+var ErrNoLogStream = errors.New("the Cloud Foundry API root doesn't link to a log stream")
+
+// LogStreamClient streams app logs and metrics live from the reverse log proxy (RLP) gateway
+type LogStreamClient commonClient
+
+// LogStreamOptions configures a log stream
+type LogStreamOptions struct {
+	// EnvelopeTypes limits the envelopes streamed to the specified types, defaults to logs only
+	EnvelopeTypes []resource.EnvelopeType
+
+	// ShardID splits envelopes between all streams with the same shard ID, by default each stream gets
+	// a unique shard ID so receives all envelopes
+	ShardID string
+
+	// ReconnectDelay is how long to wait before reconnecting after the stream is dropped
+	ReconnectDelay time.Duration
+
+	// OnError is called with the error that caused the stream to be dropped before reconnecting
+	OnError func(err error)
+}
+
+// NewLogStreamOptions creates new options to pass to Stream
+func NewLogStreamOptions() *LogStreamOptions {
+	return &LogStreamOptions{
+		EnvelopeTypes:  []resource.EnvelopeType{resource.EnvelopeTypeLog},
+		ReconnectDelay: DefaultLogStreamReconnectDelay,
+	}
+}
+
+func (o LogStreamOptions) ToQueryString(sourceID string) (url.Values, error) {
+	if len(o.EnvelopeTypes) == 0 {
+		return nil, errors.New("at least one envelope type must be streamed")
+	}
+	values := url.Values{}
+	values.Set("source_id", sourceID)
+	for _, t := range o.EnvelopeTypes {
+		// the RLP gateway selects envelope types by the presence of a parameter named after the type
+		values.Set(strings.ToLower(string(t)), "")
+	}
+	if o.ShardID != "" {
+		values.Set("shard_id", o.ShardID)
+	} else {
+		values.Set("shard_id", sourceID+"-"+strconv.FormatInt(time.Now().UnixNano(), 36))
+	}
+	return values, nil
+}
+
+// Stream envelopes emitted by the specified source, usually an app GUID, as they're emitted. The returned channel
+// is closed once the context is done or the stream can't be reconnected.
+//
+// The initial connection is made before Stream returns so any error connecting, like insufficient permissions,
+// is returned immediately. Once connected, a dropped stream is automatically reconnected although any
+// envelopes emitted while disconnected are lost, use LogCache to read those.
+func (c *LogStreamClient) Stream(ctx context.Context, sourceID string, opts *LogStreamOptions) (<-chan *resource.Envelope, error) {
+	if opts == nil {
+		opts = NewLogStreamOptions()
+	}
+	params, err := opts.ToQueryString(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := c.client.links(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if links.LogStream.Href == "" {
+		return nil, ErrNoLogStream
+	}
+	streamURL := strings.TrimSuffix(links.LogStream.Href, "/") + path.Format("/v2/read?%s", params)
+
+	body, err := c.connect(ctx, streamURL)
+	if err != nil {
+		return nil, err
+	}
+
+	reconnectDelay := opts.ReconnectDelay
+	if reconnectDelay <= 0 {
+		reconnectDelay = DefaultLogStreamReconnectDelay
+	}
+	onError := opts.OnError
+	if onError == nil {
+		onError = func(error) {}
+	}
+
+	envelopes := make(chan *resource.Envelope, 100)
+	go func() {
+		defer close(envelopes)
+		for {
+			err := readLogStreamEvents(ctx, body, envelopes)
+			ios.Close(body)
+			if ctx.Err() != nil {
+				return
+			}
+			onError(err)
+
+			// reconnect until successful, the context is done or the error isn't transient
+			for {
+				timer := time.NewTimer(reconnectDelay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+				body, err = c.connect(ctx, streamURL)
+				if err == nil {
+					break
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				onError(err)
+				if !isTransientLogStreamError(err) {
+					return
+				}
+			}
+		}
+	}()
+	return envelopes, nil
+}
+
+// connect opens the server-sent events stream, unlike other requests the stream isn't subject to the
+// configured request timeout
+func (c *LogStreamClient) connect(ctx context.Context, streamURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, streamURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating log stream request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	req.Header.Set("User-Agent", c.client.UserAgent())
+
+	streamClient := *c.client.HTTPAuthClient()
+	streamClient.Timeout = 0
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to log stream: %w", err)
+	}
+	if !internal.IsStatusSuccess(resp.StatusCode) {
+		return nil, fmt.Errorf("error connecting to log stream: %w", internal.DecodeError(resp))
+	}
+	return resp.Body, nil
+}
+
+// readLogStreamEvents parses the server-sent events stream sending each envelope to the channel until the stream
+// ends, the server closes the stream or the context is done
+func readLogStreamEvents(ctx context.Context, body io.Reader, envelopes chan<- *resource.Envelope) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogStreamEventSize)
+
+	var event string
+	var data bytes.Buffer
+	for scanner.Scan() {
+		line := scanner.Text()
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch {
+		case line == "":
+			// a blank line dispatches the event, only unnamed events hold envelopes
+			if event == "" && data.Len() > 0 {
+				var batch resource.EnvelopeBatch
+				if err := json.Unmarshal(data.Bytes(), &batch); err != nil {
+					return fmt.Errorf("error decoding log stream envelopes: %w", err)
+				}
+				for _, e := range batch.Batch {
+					select {
+					case envelopes <- e:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+			}
+			if event == "closing" {
+				return errors.New("log stream closed by the server")
+			}
+			event = ""
+			data.Reset()
+		case field == "":
+			// comment
+		case field == "event":
+			event = value
+		case field == "data":
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading log stream: %w", err)
+	}
+	return io.EOF
+}
+
+// isTransientLogStreamError returns false for client errors that reconnecting won't fix, like a missing permission
+func isTransientLogStreamError(err error) bool {
+	var httpErr resource.CloudFoundryHTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500 || httpErr.StatusCode == http.StatusTooManyRequests
+	}
+	var cfErr resource.CloudFoundryError
+	return !errors.As(err, &cfErr)
+}
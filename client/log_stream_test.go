@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestLogStream(t *testing.T) {
+	appGUID := testutil.RandomGUID()
+	logEvent := func(timestamp, payload string) string {
+		return `data: {"batch":[{"timestamp":"` + timestamp + `","source_id":"` + appGUID +
+			`","instance_id":"0","tags":{"source_type":"STG"},"log":{"payload":"` + payload + `","type":"OUT"}}]}` + "\n\n"
+	}
+	stream1 := ": connected\n\n" +
+		logEvent("1580428850380350000", "U3RhZ2luZy4uLg==") +
+		"event: heartbeat\ndata: 1580428850\n\n" +
+		logEvent("1580428850380360000", "RG93bmxvYWRpbmcgYnVpbGRwYWNr") +
+		"event: closing\ndata: closing\n\n"
+	stream2 := logEvent("1580428850380370000", "VXBsb2FkaW5nIGRyb3BsZXQ=")
+
+	serverURL := testutil.Setup(testutil.MockRoute{
+		Method:   "GET",
+		Endpoint: "/v2/read",
+		Output:   []string{stream1, stream2, `{"error":"forbidden"}`},
+		Statuses: []int{http.StatusOK, http.StatusOK, http.StatusForbidden},
+	}, t)
+	defer testutil.Teardown()
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	require.NoError(t, err)
+	c, err := New(cfg)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var streamErrs []error
+	opts := NewLogStreamOptions()
+	opts.ReconnectDelay = 10 * time.Millisecond
+	opts.OnError = func(err error) {
+		streamErrs = append(streamErrs, err)
+	}
+	envelopes, err := c.Logs.Stream(ctx, appGUID, opts)
+	require.NoError(t, err)
+
+	var lines []string
+	for e := range envelopes {
+		require.Equal(t, resource.EnvelopeTypeLog, e.Type())
+		require.Equal(t, "STG", e.Tags["source_type"])
+		lines = append(lines, string(e.Log.Payload))
+	}
+
+	// the stream is reconnected after the server closes it and stops once reconnecting is forbidden
+	require.Equal(t, []string{"Staging...", "Downloading buildpack", "Uploading droplet"}, lines)
+	require.Len(t, streamErrs, 3)
+	require.EqualError(t, streamErrs[0], "log stream closed by the server")
+	require.ErrorContains(t, streamErrs[2], "403")
+	require.NoError(t, ctx.Err())
+}
+
+func TestLogStreamQueryString(t *testing.T) {
+	opts := NewLogStreamOptions()
+	opts.EnvelopeTypes = []resource.EnvelopeType{resource.EnvelopeTypeLog, resource.EnvelopeTypeGauge}
+	opts.ShardID = "my-shard"
+	values, err := opts.ToQueryString("app-guid")
+	require.NoError(t, err)
+	require.Equal(t, "gauge=&log=&shard_id=my-shard&source_id=app-guid", values.Encode())
+
+	opts.ShardID = ""
+	values, err = opts.ToQueryString("app-guid")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(values.Get("shard_id"), "app-guid-"))
+
+	opts.EnvelopeTypes = nil
+	_, err = opts.ToQueryString("app-guid")
+	require.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 func TestManifests(t *testing.T) {
 	g := testutil.NewObjectJSONGenerator(1)
 	manifest := g.Manifest().JSON
+	diff := g.ManifestDiff().JSON
 
 	tests := []RouteTest{
 		{
@@ -27,6 +28,18 @@ func TestManifests(t *testing.T) {
 				return nil, nil
 			},
 		},
+		{
+			Description: "Diff manifest",
+			Route: testutil.MockRoute{
+				Method:   "POST",
+				Endpoint: "/v3/spaces/8d1f1d2e-08b1-4a10-a8df-471a1418cb8b/manifest_diff",
+				Output:   g.Single(diff),
+				Status:   http.StatusCreated},
+			Expected: diff,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.Manifests.ManifestDiff(context.Background(), "8d1f1d2e-08b1-4a10-a8df-471a1418cb8b", manifest)
+			},
+		},
 	}
 	ExecuteTests(tests, t)
 }
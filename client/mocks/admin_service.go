@@ -0,0 +1,24 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/stretchr/testify/mock"
+)
+
+// AdminService is a mock implementation of client.AdminService
+type AdminService struct {
+	mock.Mock
+}
+
+var _ client.AdminService = (*AdminService)(nil)
+
+// ClearBuildpackCache provides a mock function for client.AdminService.ClearBuildpackCache
+func (m *AdminService) ClearBuildpackCache(ctx context.Context) (string, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
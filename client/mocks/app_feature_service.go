@@ -0,0 +1,68 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// AppFeatureService is a mock implementation of client.AppFeatureService
+type AppFeatureService struct {
+	mock.Mock
+}
+
+var _ client.AppFeatureService = (*AppFeatureService)(nil)
+
+// Get provides a mock function for client.AppFeatureService.Get
+func (m *AppFeatureService) Get(ctx context.Context, appGUID string, featureName string) (*resource.AppFeature, error) {
+	ret := m.Called(ctx, appGUID, featureName)
+	r0, _ := ret.Get(0).(*resource.AppFeature)
+	return r0, ret.Error(1)
+}
+
+// GetRevisions provides a mock function for client.AppFeatureService.GetRevisions
+func (m *AppFeatureService) GetRevisions(ctx context.Context, appGUID string) (*resource.AppFeature, error) {
+	ret := m.Called(ctx, appGUID)
+	r0, _ := ret.Get(0).(*resource.AppFeature)
+	return r0, ret.Error(1)
+}
+
+// GetSSH provides a mock function for client.AppFeatureService.GetSSH
+func (m *AppFeatureService) GetSSH(ctx context.Context, appGUID string) (*resource.AppFeature, error) {
+	ret := m.Called(ctx, appGUID)
+	r0, _ := ret.Get(0).(*resource.AppFeature)
+	return r0, ret.Error(1)
+}
+
+// List provides a mock function for client.AppFeatureService.List
+func (m *AppFeatureService) List(ctx context.Context, appGUID string) ([]*resource.AppFeature, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID)
+	r0, _ := ret.Get(0).([]*resource.AppFeature)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// Update provides a mock function for client.AppFeatureService.Update
+func (m *AppFeatureService) Update(ctx context.Context, appGUID string, featureName string, enabled bool) (*resource.AppFeature, error) {
+	ret := m.Called(ctx, appGUID, featureName, enabled)
+	r0, _ := ret.Get(0).(*resource.AppFeature)
+	return r0, ret.Error(1)
+}
+
+// UpdateRevisions provides a mock function for client.AppFeatureService.UpdateRevisions
+func (m *AppFeatureService) UpdateRevisions(ctx context.Context, appGUID string, enabled bool) (*resource.AppFeature, error) {
+	ret := m.Called(ctx, appGUID, enabled)
+	r0, _ := ret.Get(0).(*resource.AppFeature)
+	return r0, ret.Error(1)
+}
+
+// UpdateSSH provides a mock function for client.AppFeatureService.UpdateSSH
+func (m *AppFeatureService) UpdateSSH(ctx context.Context, appGUID string, enabled bool) (*resource.AppFeature, error) {
+	ret := m.Called(ctx, appGUID, enabled)
+	r0, _ := ret.Get(0).(*resource.AppFeature)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,197 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// AppService is a mock implementation of client.AppService
+type AppService struct {
+	mock.Mock
+}
+
+var _ client.AppService = (*AppService)(nil)
+
+// Create provides a mock function for client.AppService.Create
+func (m *AppService) Create(ctx context.Context, r *resource.AppCreate) (*resource.App, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.AppService.Delete
+func (m *AppService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.AppService.First
+func (m *AppService) First(ctx context.Context, opts *client.AppListOptions) (*resource.App, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.AppService.Get
+func (m *AppService) Get(ctx context.Context, guid string) (*resource.App, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// GetEnvironment provides a mock function for client.AppService.GetEnvironment
+func (m *AppService) GetEnvironment(ctx context.Context, guid string) (*resource.AppEnvironment, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.AppEnvironment)
+	return r0, ret.Error(1)
+}
+
+// GetEnvironmentVariables provides a mock function for client.AppService.GetEnvironmentVariables
+func (m *AppService) GetEnvironmentVariables(ctx context.Context, guid string) (map[string]*string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(map[string]*string)
+	return r0, ret.Error(1)
+}
+
+// GetIncludeSpace provides a mock function for client.AppService.GetIncludeSpace
+func (m *AppService) GetIncludeSpace(ctx context.Context, guid string) (*resource.App, *resource.Space, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.App)
+	r1, _ := ret.Get(1).(*resource.Space)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeSpaceAndOrganization provides a mock function for client.AppService.GetIncludeSpaceAndOrganization
+func (m *AppService) GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.App, *resource.Space, *resource.Organization, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.App)
+	r1, _ := ret.Get(1).(*resource.Space)
+	r2, _ := ret.Get(2).(*resource.Organization)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// Iter provides a mock function for client.AppService.Iter
+func (m *AppService) Iter(ctx context.Context, opts *client.AppListOptions) client.Seq2[*resource.App, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.App, error])
+	return r0
+}
+
+// List provides a mock function for client.AppService.List
+func (m *AppService) List(ctx context.Context, opts *client.AppListOptions) ([]*resource.App, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.AppService.ListAll
+func (m *AppService) ListAll(ctx context.Context, opts *client.AppListOptions) ([]*resource.App, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	return r0, ret.Error(1)
+}
+
+// ListIncludeSpaces provides a mock function for client.AppService.ListIncludeSpaces
+func (m *AppService) ListIncludeSpaces(ctx context.Context, opts *client.AppListOptions) ([]*resource.App, []*resource.Space, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeSpacesAll provides a mock function for client.AppService.ListIncludeSpacesAll
+func (m *AppService) ListIncludeSpacesAll(ctx context.Context, opts *client.AppListOptions) ([]*resource.App, []*resource.Space, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeSpacesAndOrganizations provides a mock function for client.AppService.ListIncludeSpacesAndOrganizations
+func (m *AppService) ListIncludeSpacesAndOrganizations(ctx context.Context, opts *client.AppListOptions) ([]*resource.App, []*resource.Space, []*resource.Organization, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).([]*resource.Organization)
+	r3, _ := ret.Get(3).(*client.Pager)
+	return r0, r1, r2, r3, ret.Error(4)
+}
+
+// ListIncludeSpacesAndOrganizationsAll provides a mock function for client.AppService.ListIncludeSpacesAndOrganizationsAll
+func (m *AppService) ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *client.AppListOptions) ([]*resource.App, []*resource.Space, []*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).([]*resource.Organization)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// Permissions provides a mock function for client.AppService.Permissions
+func (m *AppService) Permissions(ctx context.Context, guid string) (*resource.AppPermissions, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.AppPermissions)
+	return r0, ret.Error(1)
+}
+
+// Restart provides a mock function for client.AppService.Restart
+func (m *AppService) Restart(ctx context.Context, guid string) (*resource.App, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// SSHEnabled provides a mock function for client.AppService.SSHEnabled
+func (m *AppService) SSHEnabled(ctx context.Context, guid string) (*resource.AppSSHEnabled, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.AppSSHEnabled)
+	return r0, ret.Error(1)
+}
+
+// SetEnvironmentVariables provides a mock function for client.AppService.SetEnvironmentVariables
+func (m *AppService) SetEnvironmentVariables(ctx context.Context, guid string, envRequest map[string]*string) (map[string]*string, error) {
+	ret := m.Called(ctx, guid, envRequest)
+	r0, _ := ret.Get(0).(map[string]*string)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.AppService.Single
+func (m *AppService) Single(ctx context.Context, opts *client.AppListOptions) (*resource.App, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// Start provides a mock function for client.AppService.Start
+func (m *AppService) Start(ctx context.Context, guid string) (*resource.App, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// Stop provides a mock function for client.AppService.Stop
+func (m *AppService) Stop(ctx context.Context, guid string) (*resource.App, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.AppService.Update
+func (m *AppService) Update(ctx context.Context, guid string, r *resource.AppUpdate) (*resource.App, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.App)
+	return r0, ret.Error(1)
+}
+
+// WaitForRunning provides a mock function for client.AppService.WaitForRunning
+func (m *AppService) WaitForRunning(ctx context.Context, guid string, opts *client.PollingOptions) error {
+	ret := m.Called(ctx, guid, opts)
+	return ret.Error(0)
+}
@@ -0,0 +1,53 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// AppUsageService is a mock implementation of client.AppUsageService
+type AppUsageService struct {
+	mock.Mock
+}
+
+var _ client.AppUsageService = (*AppUsageService)(nil)
+
+// Get provides a mock function for client.AppUsageService.Get
+func (m *AppUsageService) Get(ctx context.Context, guid string) (*resource.AppUsage, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.AppUsage)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.AppUsageService.Iter
+func (m *AppUsageService) Iter(ctx context.Context, opts *client.AppUsageListOptions) client.Seq2[*resource.AppUsage, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.AppUsage, error])
+	return r0
+}
+
+// List provides a mock function for client.AppUsageService.List
+func (m *AppUsageService) List(ctx context.Context, opts *client.AppUsageListOptions) ([]*resource.AppUsage, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.AppUsage)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.AppUsageService.ListAll
+func (m *AppUsageService) ListAll(ctx context.Context, opts *client.AppUsageListOptions) ([]*resource.AppUsage, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.AppUsage)
+	return r0, ret.Error(1)
+}
+
+// Purge provides a mock function for client.AppUsageService.Purge
+func (m *AppUsageService) Purge(ctx context.Context) error {
+	ret := m.Called(ctx)
+	return ret.Error(0)
+}
@@ -0,0 +1,61 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// AuditEventService is a mock implementation of client.AuditEventService
+type AuditEventService struct {
+	mock.Mock
+}
+
+var _ client.AuditEventService = (*AuditEventService)(nil)
+
+// First provides a mock function for client.AuditEventService.First
+func (m *AuditEventService) First(ctx context.Context, opts *client.AuditEventListOptions) (*resource.AuditEvent, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.AuditEvent)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.AuditEventService.Get
+func (m *AuditEventService) Get(ctx context.Context, guid string) (*resource.AuditEvent, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.AuditEvent)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.AuditEventService.Iter
+func (m *AuditEventService) Iter(ctx context.Context, opts *client.AuditEventListOptions) client.Seq2[*resource.AuditEvent, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.AuditEvent, error])
+	return r0
+}
+
+// List provides a mock function for client.AuditEventService.List
+func (m *AuditEventService) List(ctx context.Context, opts *client.AuditEventListOptions) ([]*resource.AuditEvent, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.AuditEvent)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.AuditEventService.ListAll
+func (m *AuditEventService) ListAll(ctx context.Context, opts *client.AuditEventListOptions) ([]*resource.AuditEvent, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.AuditEvent)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.AuditEventService.Single
+func (m *AuditEventService) Single(ctx context.Context, opts *client.AuditEventListOptions) (*resource.AuditEvent, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.AuditEvent)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,123 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// BuildService is a mock implementation of client.BuildService
+type BuildService struct {
+	mock.Mock
+}
+
+var _ client.BuildService = (*BuildService)(nil)
+
+// Create provides a mock function for client.BuildService.Create
+func (m *BuildService) Create(ctx context.Context, r *resource.BuildCreate) (*resource.Build, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.BuildService.Delete
+func (m *BuildService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// First provides a mock function for client.BuildService.First
+func (m *BuildService) First(ctx context.Context, opts *client.BuildListOptions) (*resource.Build, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// FirstForApp provides a mock function for client.BuildService.FirstForApp
+func (m *BuildService) FirstForApp(ctx context.Context, appGUID string, opts *client.BuildAppListOptions) (*resource.Build, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.BuildService.Get
+func (m *BuildService) Get(ctx context.Context, guid string) (*resource.Build, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.BuildService.Iter
+func (m *BuildService) Iter(ctx context.Context, opts *client.BuildListOptions) client.Seq2[*resource.Build, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Build, error])
+	return r0
+}
+
+// IterForApp provides a mock function for client.BuildService.IterForApp
+func (m *BuildService) IterForApp(ctx context.Context, appGUID string, opts *client.BuildAppListOptions) client.Seq2[*resource.Build, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Build, error])
+	return r0
+}
+
+// List provides a mock function for client.BuildService.List
+func (m *BuildService) List(ctx context.Context, opts *client.BuildListOptions) ([]*resource.Build, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Build)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.BuildService.ListAll
+func (m *BuildService) ListAll(ctx context.Context, opts *client.BuildListOptions) ([]*resource.Build, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// ListForApp provides a mock function for client.BuildService.ListForApp
+func (m *BuildService) ListForApp(ctx context.Context, appGUID string, opts *client.BuildAppListOptions) ([]*resource.Build, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Build)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.BuildService.ListForAppAll
+func (m *BuildService) ListForAppAll(ctx context.Context, appGUID string, opts *client.BuildAppListOptions) ([]*resource.Build, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// PollStaged provides a mock function for client.BuildService.PollStaged
+func (m *BuildService) PollStaged(ctx context.Context, guid string, opts *client.PollingOptions) error {
+	ret := m.Called(ctx, guid, opts)
+	return ret.Error(0)
+}
+
+// Single provides a mock function for client.BuildService.Single
+func (m *BuildService) Single(ctx context.Context, opts *client.BuildListOptions) (*resource.Build, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.BuildService.SingleForApp
+func (m *BuildService) SingleForApp(ctx context.Context, appGUID string, opts *client.BuildAppListOptions) (*resource.Build, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Build)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.BuildService.Update
+func (m *BuildService) Update(ctx context.Context, guid string, r *resource.BuildUpdate) (*resource.Build, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Build)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,90 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// BuildpackService is a mock implementation of client.BuildpackService
+type BuildpackService struct {
+	mock.Mock
+}
+
+var _ client.BuildpackService = (*BuildpackService)(nil)
+
+// Create provides a mock function for client.BuildpackService.Create
+func (m *BuildpackService) Create(ctx context.Context, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Buildpack)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.BuildpackService.Delete
+func (m *BuildpackService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// First provides a mock function for client.BuildpackService.First
+func (m *BuildpackService) First(ctx context.Context, opts *client.BuildpackListOptions) (*resource.Buildpack, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Buildpack)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.BuildpackService.Get
+func (m *BuildpackService) Get(ctx context.Context, guid string) (*resource.Buildpack, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Buildpack)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.BuildpackService.Iter
+func (m *BuildpackService) Iter(ctx context.Context, opts *client.BuildpackListOptions) client.Seq2[*resource.Buildpack, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Buildpack, error])
+	return r0
+}
+
+// List provides a mock function for client.BuildpackService.List
+func (m *BuildpackService) List(ctx context.Context, opts *client.BuildpackListOptions) ([]*resource.Buildpack, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Buildpack)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.BuildpackService.ListAll
+func (m *BuildpackService) ListAll(ctx context.Context, opts *client.BuildpackListOptions) ([]*resource.Buildpack, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Buildpack)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.BuildpackService.Single
+func (m *BuildpackService) Single(ctx context.Context, opts *client.BuildpackListOptions) (*resource.Buildpack, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Buildpack)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.BuildpackService.Update
+func (m *BuildpackService) Update(ctx context.Context, guid string, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Buildpack)
+	return r0, ret.Error(1)
+}
+
+// Upload provides a mock function for client.BuildpackService.Upload
+func (m *BuildpackService) Upload(ctx context.Context, guid string, zipFile io.Reader) (string, *resource.Buildpack, error) {
+	ret := m.Called(ctx, guid, zipFile)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(*resource.Buildpack)
+	return r0, r1, ret.Error(2)
+}
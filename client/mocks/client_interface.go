@@ -0,0 +1,347 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/stretchr/testify/mock"
+)
+
+// ClientInterface is a mock implementation of client.ClientInterface
+type ClientInterface struct {
+	mock.Mock
+}
+
+var _ client.ClientInterface = (*ClientInterface)(nil)
+
+// AdminService provides a mock function for client.ClientInterface.AdminService
+func (m *ClientInterface) AdminService() client.AdminService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.AdminService)
+	return r0
+}
+
+// AppFeaturesService provides a mock function for client.ClientInterface.AppFeaturesService
+func (m *ClientInterface) AppFeaturesService() client.AppFeatureService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.AppFeatureService)
+	return r0
+}
+
+// AppUsageEventsService provides a mock function for client.ClientInterface.AppUsageEventsService
+func (m *ClientInterface) AppUsageEventsService() client.AppUsageService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.AppUsageService)
+	return r0
+}
+
+// ApplicationsService provides a mock function for client.ClientInterface.ApplicationsService
+func (m *ClientInterface) ApplicationsService() client.AppService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.AppService)
+	return r0
+}
+
+// AuditEventsService provides a mock function for client.ClientInterface.AuditEventsService
+func (m *ClientInterface) AuditEventsService() client.AuditEventService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.AuditEventService)
+	return r0
+}
+
+// BuildpacksService provides a mock function for client.ClientInterface.BuildpacksService
+func (m *ClientInterface) BuildpacksService() client.BuildpackService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.BuildpackService)
+	return r0
+}
+
+// BuildsService provides a mock function for client.ClientInterface.BuildsService
+func (m *ClientInterface) BuildsService() client.BuildService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.BuildService)
+	return r0
+}
+
+// DeploymentsService provides a mock function for client.ClientInterface.DeploymentsService
+func (m *ClientInterface) DeploymentsService() client.DeploymentService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.DeploymentService)
+	return r0
+}
+
+// DomainsService provides a mock function for client.ClientInterface.DomainsService
+func (m *ClientInterface) DomainsService() client.DomainService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.DomainService)
+	return r0
+}
+
+// DropletsService provides a mock function for client.ClientInterface.DropletsService
+func (m *ClientInterface) DropletsService() client.DropletService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.DropletService)
+	return r0
+}
+
+// EnvVarGroupsService provides a mock function for client.ClientInterface.EnvVarGroupsService
+func (m *ClientInterface) EnvVarGroupsService() client.EnvVarGroupService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.EnvVarGroupService)
+	return r0
+}
+
+// ExecuteAuthRequest provides a mock function for client.ClientInterface.ExecuteAuthRequest
+func (m *ClientInterface) ExecuteAuthRequest(req *http.Request) (*http.Response, error) {
+	ret := m.Called(req)
+	r0, _ := ret.Get(0).(*http.Response)
+	return r0, ret.Error(1)
+}
+
+// ExecuteRequest provides a mock function for client.ClientInterface.ExecuteRequest
+func (m *ClientInterface) ExecuteRequest(req *http.Request) (*http.Response, error) {
+	ret := m.Called(req)
+	r0, _ := ret.Get(0).(*http.Response)
+	return r0, ret.Error(1)
+}
+
+// FeatureFlagsService provides a mock function for client.ClientInterface.FeatureFlagsService
+func (m *ClientInterface) FeatureFlagsService() client.FeatureFlagService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.FeatureFlagService)
+	return r0
+}
+
+// IsolationSegmentsService provides a mock function for client.ClientInterface.IsolationSegmentsService
+func (m *ClientInterface) IsolationSegmentsService() client.IsolationSegmentService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.IsolationSegmentService)
+	return r0
+}
+
+// JobsService provides a mock function for client.ClientInterface.JobsService
+func (m *ClientInterface) JobsService() client.JobService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.JobService)
+	return r0
+}
+
+// LogCacheService provides a mock function for client.ClientInterface.LogCacheService
+func (m *ClientInterface) LogCacheService() client.LogCacheService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.LogCacheService)
+	return r0
+}
+
+// LogsService provides a mock function for client.ClientInterface.LogsService
+func (m *ClientInterface) LogsService() client.LogStreamService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.LogStreamService)
+	return r0
+}
+
+// ManifestsService provides a mock function for client.ClientInterface.ManifestsService
+func (m *ClientInterface) ManifestsService() client.ManifestService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ManifestService)
+	return r0
+}
+
+// NetworkPoliciesService provides a mock function for client.ClientInterface.NetworkPoliciesService
+func (m *ClientInterface) NetworkPoliciesService() client.NetworkPolicyService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.NetworkPolicyService)
+	return r0
+}
+
+// OrganizationQuotasService provides a mock function for client.ClientInterface.OrganizationQuotasService
+func (m *ClientInterface) OrganizationQuotasService() client.OrganizationQuotaService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.OrganizationQuotaService)
+	return r0
+}
+
+// OrganizationsService provides a mock function for client.ClientInterface.OrganizationsService
+func (m *ClientInterface) OrganizationsService() client.OrganizationService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.OrganizationService)
+	return r0
+}
+
+// PackagesService provides a mock function for client.ClientInterface.PackagesService
+func (m *ClientInterface) PackagesService() client.PackageService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.PackageService)
+	return r0
+}
+
+// ProcessesService provides a mock function for client.ClientInterface.ProcessesService
+func (m *ClientInterface) ProcessesService() client.ProcessService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ProcessService)
+	return r0
+}
+
+// ResourceMatchesService provides a mock function for client.ClientInterface.ResourceMatchesService
+func (m *ClientInterface) ResourceMatchesService() client.ResourceMatchService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ResourceMatchService)
+	return r0
+}
+
+// RevisionsService provides a mock function for client.ClientInterface.RevisionsService
+func (m *ClientInterface) RevisionsService() client.RevisionService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.RevisionService)
+	return r0
+}
+
+// RolesService provides a mock function for client.ClientInterface.RolesService
+func (m *ClientInterface) RolesService() client.RoleService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.RoleService)
+	return r0
+}
+
+// RootService provides a mock function for client.ClientInterface.RootService
+func (m *ClientInterface) RootService() client.RootService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.RootService)
+	return r0
+}
+
+// RoutesService provides a mock function for client.ClientInterface.RoutesService
+func (m *ClientInterface) RoutesService() client.RouteService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.RouteService)
+	return r0
+}
+
+// RoutingAPIService provides a mock function for client.ClientInterface.RoutingAPIService
+func (m *ClientInterface) RoutingAPIService() client.RoutingAPIService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.RoutingAPIService)
+	return r0
+}
+
+// SSHCode provides a mock function for client.ClientInterface.SSHCode
+func (m *ClientInterface) SSHCode(ctx context.Context) (string, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// SecurityGroupsService provides a mock function for client.ClientInterface.SecurityGroupsService
+func (m *ClientInterface) SecurityGroupsService() client.SecurityGroupService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.SecurityGroupService)
+	return r0
+}
+
+// ServiceBrokersService provides a mock function for client.ClientInterface.ServiceBrokersService
+func (m *ClientInterface) ServiceBrokersService() client.ServiceBrokerService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServiceBrokerService)
+	return r0
+}
+
+// ServiceCredentialBindingsService provides a mock function for client.ClientInterface.ServiceCredentialBindingsService
+func (m *ClientInterface) ServiceCredentialBindingsService() client.ServiceCredentialBindingService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServiceCredentialBindingService)
+	return r0
+}
+
+// ServiceInstancesService provides a mock function for client.ClientInterface.ServiceInstancesService
+func (m *ClientInterface) ServiceInstancesService() client.ServiceInstanceService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServiceInstanceService)
+	return r0
+}
+
+// ServiceOfferingsService provides a mock function for client.ClientInterface.ServiceOfferingsService
+func (m *ClientInterface) ServiceOfferingsService() client.ServiceOfferingService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServiceOfferingService)
+	return r0
+}
+
+// ServicePlansService provides a mock function for client.ClientInterface.ServicePlansService
+func (m *ClientInterface) ServicePlansService() client.ServicePlanService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServicePlanService)
+	return r0
+}
+
+// ServicePlansVisibilityService provides a mock function for client.ClientInterface.ServicePlansVisibilityService
+func (m *ClientInterface) ServicePlansVisibilityService() client.ServicePlanVisibilityService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServicePlanVisibilityService)
+	return r0
+}
+
+// ServiceRouteBindingsService provides a mock function for client.ClientInterface.ServiceRouteBindingsService
+func (m *ClientInterface) ServiceRouteBindingsService() client.ServiceRouteBindingService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServiceRouteBindingService)
+	return r0
+}
+
+// ServiceUsageEventsService provides a mock function for client.ClientInterface.ServiceUsageEventsService
+func (m *ClientInterface) ServiceUsageEventsService() client.ServiceUsageService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.ServiceUsageService)
+	return r0
+}
+
+// SidecarsService provides a mock function for client.ClientInterface.SidecarsService
+func (m *ClientInterface) SidecarsService() client.SidecarService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.SidecarService)
+	return r0
+}
+
+// SpaceFeaturesService provides a mock function for client.ClientInterface.SpaceFeaturesService
+func (m *ClientInterface) SpaceFeaturesService() client.SpaceFeatureService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.SpaceFeatureService)
+	return r0
+}
+
+// SpaceQuotasService provides a mock function for client.ClientInterface.SpaceQuotasService
+func (m *ClientInterface) SpaceQuotasService() client.SpaceQuotaService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.SpaceQuotaService)
+	return r0
+}
+
+// SpacesService provides a mock function for client.ClientInterface.SpacesService
+func (m *ClientInterface) SpacesService() client.SpaceService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.SpaceService)
+	return r0
+}
+
+// StacksService provides a mock function for client.ClientInterface.StacksService
+func (m *ClientInterface) StacksService() client.StackService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.StackService)
+	return r0
+}
+
+// TasksService provides a mock function for client.ClientInterface.TasksService
+func (m *ClientInterface) TasksService() client.TaskService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.TaskService)
+	return r0
+}
+
+// UsersService provides a mock function for client.ClientInterface.UsersService
+func (m *ClientInterface) UsersService() client.UserService {
+	ret := m.Called()
+	r0, _ := ret.Get(0).(client.UserService)
+	return r0
+}
@@ -0,0 +1,101 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// DeploymentService is a mock implementation of client.DeploymentService
+type DeploymentService struct {
+	mock.Mock
+}
+
+var _ client.DeploymentService = (*DeploymentService)(nil)
+
+// Cancel provides a mock function for client.DeploymentService.Cancel
+func (m *DeploymentService) Cancel(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// Continue provides a mock function for client.DeploymentService.Continue
+func (m *DeploymentService) Continue(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// Create provides a mock function for client.DeploymentService.Create
+func (m *DeploymentService) Create(ctx context.Context, r *resource.DeploymentCreate) (*resource.Deployment, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Deployment)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.DeploymentService.First
+func (m *DeploymentService) First(ctx context.Context, opts *client.DeploymentListOptions) (*resource.Deployment, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Deployment)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.DeploymentService.Get
+func (m *DeploymentService) Get(ctx context.Context, guid string) (*resource.Deployment, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Deployment)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.DeploymentService.Iter
+func (m *DeploymentService) Iter(ctx context.Context, opts *client.DeploymentListOptions) client.Seq2[*resource.Deployment, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Deployment, error])
+	return r0
+}
+
+// List provides a mock function for client.DeploymentService.List
+func (m *DeploymentService) List(ctx context.Context, opts *client.DeploymentListOptions) ([]*resource.Deployment, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Deployment)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.DeploymentService.ListAll
+func (m *DeploymentService) ListAll(ctx context.Context, opts *client.DeploymentListOptions) ([]*resource.Deployment, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Deployment)
+	return r0, ret.Error(1)
+}
+
+// PollFinalized provides a mock function for client.DeploymentService.PollFinalized
+func (m *DeploymentService) PollFinalized(ctx context.Context, guid string, opts *client.PollingOptions) (*resource.Deployment, error) {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).(*resource.Deployment)
+	return r0, ret.Error(1)
+}
+
+// PollPaused provides a mock function for client.DeploymentService.PollPaused
+func (m *DeploymentService) PollPaused(ctx context.Context, guid string, opts *client.PollingOptions) (*resource.Deployment, error) {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).(*resource.Deployment)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.DeploymentService.Single
+func (m *DeploymentService) Single(ctx context.Context, opts *client.DeploymentListOptions) (*resource.Deployment, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Deployment)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.DeploymentService.Update
+func (m *DeploymentService) Update(ctx context.Context, guid string, r *resource.DeploymentUpdate) (*resource.Deployment, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Deployment)
+	return r0, ret.Error(1)
+}
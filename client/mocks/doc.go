@@ -0,0 +1,11 @@
+// Package mocks contains testify mocks of client.ClientInterface and the service interfaces implemented by each
+// client.Client sub-client, so code that depends on the client can be unit tested without a Cloud Controller.
+//
+//	apps := &mocks.AppService{}
+//	apps.On("Get", mock.Anything, "app-guid").Return(&resource.App{Name: "my-app"}, nil)
+//	cf := &mocks.ClientInterface{}
+//	cf.On("ApplicationsService").Return(apps)
+//
+// Variadic parameters are passed to the mock as a single slice argument. The mocks are generated from the client
+// package by go generate, don't edit them by hand.
+package mocks
@@ -0,0 +1,138 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// DomainService is a mock implementation of client.DomainService
+type DomainService struct {
+	mock.Mock
+}
+
+var _ client.DomainService = (*DomainService)(nil)
+
+// Create provides a mock function for client.DomainService.Create
+func (m *DomainService) Create(ctx context.Context, r *resource.DomainCreate) (*resource.Domain, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.DomainService.Delete
+func (m *DomainService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.DomainService.First
+func (m *DomainService) First(ctx context.Context, opts *client.DomainListOptions) (*resource.Domain, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// FirstForOrganization provides a mock function for client.DomainService.FirstForOrganization
+func (m *DomainService) FirstForOrganization(ctx context.Context, organizationGUID string, opts *client.DomainListOptions) (*resource.Domain, error) {
+	ret := m.Called(ctx, organizationGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.DomainService.Get
+func (m *DomainService) Get(ctx context.Context, guid string) (*resource.Domain, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.DomainService.Iter
+func (m *DomainService) Iter(ctx context.Context, opts *client.DomainListOptions) client.Seq2[*resource.Domain, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Domain, error])
+	return r0
+}
+
+// IterForOrganization provides a mock function for client.DomainService.IterForOrganization
+func (m *DomainService) IterForOrganization(ctx context.Context, organizationGUID string, opts *client.DomainListOptions) client.Seq2[*resource.Domain, error] {
+	ret := m.Called(ctx, organizationGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Domain, error])
+	return r0
+}
+
+// List provides a mock function for client.DomainService.List
+func (m *DomainService) List(ctx context.Context, opts *client.DomainListOptions) ([]*resource.Domain, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Domain)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.DomainService.ListAll
+func (m *DomainService) ListAll(ctx context.Context, opts *client.DomainListOptions) ([]*resource.Domain, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// ListForOrganization provides a mock function for client.DomainService.ListForOrganization
+func (m *DomainService) ListForOrganization(ctx context.Context, organizationGUID string, opts *client.DomainListOptions) ([]*resource.Domain, *client.Pager, error) {
+	ret := m.Called(ctx, organizationGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Domain)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForOrganizationAll provides a mock function for client.DomainService.ListForOrganizationAll
+func (m *DomainService) ListForOrganizationAll(ctx context.Context, organizationGUID string, opts *client.DomainListOptions) ([]*resource.Domain, error) {
+	ret := m.Called(ctx, organizationGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// Share provides a mock function for client.DomainService.Share
+func (m *DomainService) Share(ctx context.Context, domainGUID string, organizationGUID string) (*resource.ToManyRelationships, error) {
+	ret := m.Called(ctx, domainGUID, organizationGUID)
+	r0, _ := ret.Get(0).(*resource.ToManyRelationships)
+	return r0, ret.Error(1)
+}
+
+// ShareMany provides a mock function for client.DomainService.ShareMany
+func (m *DomainService) ShareMany(ctx context.Context, guid string, r *resource.ToManyRelationships) (*resource.ToManyRelationships, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.ToManyRelationships)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.DomainService.Single
+func (m *DomainService) Single(ctx context.Context, opts *client.DomainListOptions) (*resource.Domain, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// SingleForOrganization provides a mock function for client.DomainService.SingleForOrganization
+func (m *DomainService) SingleForOrganization(ctx context.Context, organizationGUID string, opts *client.DomainListOptions) (*resource.Domain, error) {
+	ret := m.Called(ctx, organizationGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// UnShare provides a mock function for client.DomainService.UnShare
+func (m *DomainService) UnShare(ctx context.Context, domainGUID string, organizationGUID string) error {
+	ret := m.Called(ctx, domainGUID, organizationGUID)
+	return ret.Error(0)
+}
+
+// Update provides a mock function for client.DomainService.Update
+func (m *DomainService) Update(ctx context.Context, guid string, r *resource.DomainUpdate) (*resource.Domain, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
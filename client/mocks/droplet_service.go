@@ -0,0 +1,198 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// DropletService is a mock implementation of client.DropletService
+type DropletService struct {
+	mock.Mock
+}
+
+var _ client.DropletService = (*DropletService)(nil)
+
+// Copy provides a mock function for client.DropletService.Copy
+func (m *DropletService) Copy(ctx context.Context, srcDropletGUID string, destAppGUID string) (any, error) {
+	ret := m.Called(ctx, srcDropletGUID, destAppGUID)
+	r0, _ := ret.Get(0).(any)
+	return r0, ret.Error(1)
+}
+
+// Create provides a mock function for client.DropletService.Create
+func (m *DropletService) Create(ctx context.Context, r *resource.DropletCreate) (*resource.Droplet, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.DropletService.Delete
+func (m *DropletService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// Download provides a mock function for client.DropletService.Download
+func (m *DropletService) Download(ctx context.Context, guid string) (io.ReadCloser, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(io.ReadCloser)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.DropletService.First
+func (m *DropletService) First(ctx context.Context, opts *client.DropletListOptions) (*resource.Droplet, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// FirstForApp provides a mock function for client.DropletService.FirstForApp
+func (m *DropletService) FirstForApp(ctx context.Context, appGUID string, opts *client.DropletAppListOptions) (*resource.Droplet, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// FirstForPackage provides a mock function for client.DropletService.FirstForPackage
+func (m *DropletService) FirstForPackage(ctx context.Context, packageGUID string, opts *client.DropletPackageListOptions) (*resource.Droplet, error) {
+	ret := m.Called(ctx, packageGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.DropletService.Get
+func (m *DropletService) Get(ctx context.Context, guid string) (*resource.Droplet, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// GetCurrentAssociationForApp provides a mock function for client.DropletService.GetCurrentAssociationForApp
+func (m *DropletService) GetCurrentAssociationForApp(ctx context.Context, appGUID string) (*resource.DropletCurrent, error) {
+	ret := m.Called(ctx, appGUID)
+	r0, _ := ret.Get(0).(*resource.DropletCurrent)
+	return r0, ret.Error(1)
+}
+
+// GetCurrentForApp provides a mock function for client.DropletService.GetCurrentForApp
+func (m *DropletService) GetCurrentForApp(ctx context.Context, appGUID string) (*resource.Droplet, error) {
+	ret := m.Called(ctx, appGUID)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.DropletService.Iter
+func (m *DropletService) Iter(ctx context.Context, opts *client.DropletListOptions) client.Seq2[*resource.Droplet, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Droplet, error])
+	return r0
+}
+
+// IterForApp provides a mock function for client.DropletService.IterForApp
+func (m *DropletService) IterForApp(ctx context.Context, appGUID string, opts *client.DropletAppListOptions) client.Seq2[*resource.Droplet, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Droplet, error])
+	return r0
+}
+
+// IterForPackage provides a mock function for client.DropletService.IterForPackage
+func (m *DropletService) IterForPackage(ctx context.Context, packageGUID string, opts *client.DropletPackageListOptions) client.Seq2[*resource.Droplet, error] {
+	ret := m.Called(ctx, packageGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Droplet, error])
+	return r0
+}
+
+// List provides a mock function for client.DropletService.List
+func (m *DropletService) List(ctx context.Context, opts *client.DropletListOptions) ([]*resource.Droplet, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Droplet)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.DropletService.ListAll
+func (m *DropletService) ListAll(ctx context.Context, opts *client.DropletListOptions) ([]*resource.Droplet, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// ListForApp provides a mock function for client.DropletService.ListForApp
+func (m *DropletService) ListForApp(ctx context.Context, appGUID string, opts *client.DropletAppListOptions) ([]*resource.Droplet, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Droplet)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.DropletService.ListForAppAll
+func (m *DropletService) ListForAppAll(ctx context.Context, appGUID string, opts *client.DropletAppListOptions) ([]*resource.Droplet, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// ListForPackage provides a mock function for client.DropletService.ListForPackage
+func (m *DropletService) ListForPackage(ctx context.Context, packageGUID string, opts *client.DropletPackageListOptions) ([]*resource.Droplet, *client.Pager, error) {
+	ret := m.Called(ctx, packageGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Droplet)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForPackageAll provides a mock function for client.DropletService.ListForPackageAll
+func (m *DropletService) ListForPackageAll(ctx context.Context, packageGUID string, opts *client.DropletPackageListOptions) ([]*resource.Droplet, error) {
+	ret := m.Called(ctx, packageGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// SetCurrentAssociationForApp provides a mock function for client.DropletService.SetCurrentAssociationForApp
+func (m *DropletService) SetCurrentAssociationForApp(ctx context.Context, appGUID string, dropletGUID string) (*resource.DropletCurrent, error) {
+	ret := m.Called(ctx, appGUID, dropletGUID)
+	r0, _ := ret.Get(0).(*resource.DropletCurrent)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.DropletService.Single
+func (m *DropletService) Single(ctx context.Context, opts *client.DropletListOptions) (*resource.Droplet, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.DropletService.SingleForApp
+func (m *DropletService) SingleForApp(ctx context.Context, appGUID string, opts *client.DropletAppListOptions) (*resource.Droplet, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// SingleForPackage provides a mock function for client.DropletService.SingleForPackage
+func (m *DropletService) SingleForPackage(ctx context.Context, packageGUID string, opts *client.DropletPackageListOptions) (*resource.Droplet, error) {
+	ret := m.Called(ctx, packageGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.DropletService.Update
+func (m *DropletService) Update(ctx context.Context, guid string, r *resource.DropletUpdate) (*resource.Droplet, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Droplet)
+	return r0, ret.Error(1)
+}
+
+// Upload provides a mock function for client.DropletService.Upload
+func (m *DropletService) Upload(ctx context.Context, guid string, tgzDroplet io.Reader) (string, *resource.Droplet, error) {
+	ret := m.Called(ctx, guid, tgzDroplet)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(*resource.Droplet)
+	return r0, r1, ret.Error(2)
+}
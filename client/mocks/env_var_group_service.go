@@ -0,0 +1,60 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// EnvVarGroupService is a mock implementation of client.EnvVarGroupService
+type EnvVarGroupService struct {
+	mock.Mock
+}
+
+var _ client.EnvVarGroupService = (*EnvVarGroupService)(nil)
+
+// Get provides a mock function for client.EnvVarGroupService.Get
+func (m *EnvVarGroupService) Get(ctx context.Context, name string) (*resource.EnvVarGroup, error) {
+	ret := m.Called(ctx, name)
+	r0, _ := ret.Get(0).(*resource.EnvVarGroup)
+	return r0, ret.Error(1)
+}
+
+// GetRunning provides a mock function for client.EnvVarGroupService.GetRunning
+func (m *EnvVarGroupService) GetRunning(ctx context.Context) (*resource.EnvVarGroup, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).(*resource.EnvVarGroup)
+	return r0, ret.Error(1)
+}
+
+// GetStaging provides a mock function for client.EnvVarGroupService.GetStaging
+func (m *EnvVarGroupService) GetStaging(ctx context.Context) (*resource.EnvVarGroup, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).(*resource.EnvVarGroup)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.EnvVarGroupService.Update
+func (m *EnvVarGroupService) Update(ctx context.Context, name string, r *resource.EnvVarGroupUpdate) (*resource.EnvVarGroup, error) {
+	ret := m.Called(ctx, name, r)
+	r0, _ := ret.Get(0).(*resource.EnvVarGroup)
+	return r0, ret.Error(1)
+}
+
+// UpdateRunning provides a mock function for client.EnvVarGroupService.UpdateRunning
+func (m *EnvVarGroupService) UpdateRunning(ctx context.Context, r *resource.EnvVarGroupUpdate) (*resource.EnvVarGroup, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.EnvVarGroup)
+	return r0, ret.Error(1)
+}
+
+// UpdateStaging provides a mock function for client.EnvVarGroupService.UpdateStaging
+func (m *EnvVarGroupService) UpdateStaging(ctx context.Context, r *resource.EnvVarGroupUpdate) (*resource.EnvVarGroup, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.EnvVarGroup)
+	return r0, ret.Error(1)
+}
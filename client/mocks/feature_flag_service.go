@@ -0,0 +1,54 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// FeatureFlagService is a mock implementation of client.FeatureFlagService
+type FeatureFlagService struct {
+	mock.Mock
+}
+
+var _ client.FeatureFlagService = (*FeatureFlagService)(nil)
+
+// Get provides a mock function for client.FeatureFlagService.Get
+func (m *FeatureFlagService) Get(ctx context.Context, featureFlag resource.FeatureFlagType) (*resource.FeatureFlag, error) {
+	ret := m.Called(ctx, featureFlag)
+	r0, _ := ret.Get(0).(*resource.FeatureFlag)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.FeatureFlagService.Iter
+func (m *FeatureFlagService) Iter(ctx context.Context, opts *client.FeatureFlagListOptions) client.Seq2[*resource.FeatureFlag, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.FeatureFlag, error])
+	return r0
+}
+
+// List provides a mock function for client.FeatureFlagService.List
+func (m *FeatureFlagService) List(ctx context.Context, opts *client.FeatureFlagListOptions) ([]*resource.FeatureFlag, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.FeatureFlag)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.FeatureFlagService.ListAll
+func (m *FeatureFlagService) ListAll(ctx context.Context, opts *client.FeatureFlagListOptions) ([]*resource.FeatureFlag, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.FeatureFlag)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.FeatureFlagService.Update
+func (m *FeatureFlagService) Update(ctx context.Context, featureFlag resource.FeatureFlagType, r *resource.FeatureFlagUpdate) (*resource.FeatureFlag, error) {
+	ret := m.Called(ctx, featureFlag, r)
+	r0, _ := ret.Get(0).(*resource.FeatureFlag)
+	return r0, ret.Error(1)
+}
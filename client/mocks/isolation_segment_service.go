@@ -0,0 +1,121 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// IsolationSegmentService is a mock implementation of client.IsolationSegmentService
+type IsolationSegmentService struct {
+	mock.Mock
+}
+
+var _ client.IsolationSegmentService = (*IsolationSegmentService)(nil)
+
+// Create provides a mock function for client.IsolationSegmentService.Create
+func (m *IsolationSegmentService) Create(ctx context.Context, r *resource.IsolationSegmentCreate) (*resource.IsolationSegment, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.IsolationSegment)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.IsolationSegmentService.Delete
+func (m *IsolationSegmentService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// EntitleOrganization provides a mock function for client.IsolationSegmentService.EntitleOrganization
+func (m *IsolationSegmentService) EntitleOrganization(ctx context.Context, guid string, organizationGUID string) (*resource.IsolationSegmentRelationship, error) {
+	ret := m.Called(ctx, guid, organizationGUID)
+	r0, _ := ret.Get(0).(*resource.IsolationSegmentRelationship)
+	return r0, ret.Error(1)
+}
+
+// EntitleOrganizations provides a mock function for client.IsolationSegmentService.EntitleOrganizations
+func (m *IsolationSegmentService) EntitleOrganizations(ctx context.Context, guid string, organizationGUIDs []string) (*resource.IsolationSegmentRelationship, error) {
+	ret := m.Called(ctx, guid, organizationGUIDs)
+	r0, _ := ret.Get(0).(*resource.IsolationSegmentRelationship)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.IsolationSegmentService.First
+func (m *IsolationSegmentService) First(ctx context.Context, opts *client.IsolationSegmentListOptions) (*resource.IsolationSegment, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.IsolationSegment)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.IsolationSegmentService.Get
+func (m *IsolationSegmentService) Get(ctx context.Context, guid string) (*resource.IsolationSegment, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.IsolationSegment)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.IsolationSegmentService.Iter
+func (m *IsolationSegmentService) Iter(ctx context.Context, opts *client.IsolationSegmentListOptions) client.Seq2[*resource.IsolationSegment, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.IsolationSegment, error])
+	return r0
+}
+
+// List provides a mock function for client.IsolationSegmentService.List
+func (m *IsolationSegmentService) List(ctx context.Context, opts *client.IsolationSegmentListOptions) ([]*resource.IsolationSegment, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.IsolationSegment)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.IsolationSegmentService.ListAll
+func (m *IsolationSegmentService) ListAll(ctx context.Context, opts *client.IsolationSegmentListOptions) ([]*resource.IsolationSegment, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.IsolationSegment)
+	return r0, ret.Error(1)
+}
+
+// ListOrganizationRelationships provides a mock function for client.IsolationSegmentService.ListOrganizationRelationships
+func (m *IsolationSegmentService) ListOrganizationRelationships(ctx context.Context, guid string) ([]string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).([]string)
+	return r0, ret.Error(1)
+}
+
+// ListSpaceRelationships provides a mock function for client.IsolationSegmentService.ListSpaceRelationships
+func (m *IsolationSegmentService) ListSpaceRelationships(ctx context.Context, guid string) ([]string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).([]string)
+	return r0, ret.Error(1)
+}
+
+// RevokeOrganization provides a mock function for client.IsolationSegmentService.RevokeOrganization
+func (m *IsolationSegmentService) RevokeOrganization(ctx context.Context, guid string, organizationGUID string) error {
+	ret := m.Called(ctx, guid, organizationGUID)
+	return ret.Error(0)
+}
+
+// RevokeOrganizations provides a mock function for client.IsolationSegmentService.RevokeOrganizations
+func (m *IsolationSegmentService) RevokeOrganizations(ctx context.Context, guid string, organizationGUIDs []string) error {
+	ret := m.Called(ctx, guid, organizationGUIDs)
+	return ret.Error(0)
+}
+
+// Single provides a mock function for client.IsolationSegmentService.Single
+func (m *IsolationSegmentService) Single(ctx context.Context, opts *client.IsolationSegmentListOptions) (*resource.IsolationSegment, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.IsolationSegment)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.IsolationSegmentService.Update
+func (m *IsolationSegmentService) Update(ctx context.Context, guid string, r *resource.IsolationSegmentUpdate) (*resource.IsolationSegment, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.IsolationSegment)
+	return r0, ret.Error(1)
+}
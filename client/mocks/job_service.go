@@ -0,0 +1,31 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// JobService is a mock implementation of client.JobService
+type JobService struct {
+	mock.Mock
+}
+
+var _ client.JobService = (*JobService)(nil)
+
+// Get provides a mock function for client.JobService.Get
+func (m *JobService) Get(ctx context.Context, guid string) (*resource.Job, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Job)
+	return r0, ret.Error(1)
+}
+
+// PollComplete provides a mock function for client.JobService.PollComplete
+func (m *JobService) PollComplete(ctx context.Context, jobGUID string, opts *client.PollingOptions) error {
+	ret := m.Called(ctx, jobGUID, opts)
+	return ret.Error(0)
+}
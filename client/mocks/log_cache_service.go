@@ -0,0 +1,47 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// LogCacheService is a mock implementation of client.LogCacheService
+type LogCacheService struct {
+	mock.Mock
+}
+
+var _ client.LogCacheService = (*LogCacheService)(nil)
+
+// InstantQuery provides a mock function for client.LogCacheService.InstantQuery
+func (m *LogCacheService) InstantQuery(ctx context.Context, query string, at time.Time) (*resource.PromQLResult, error) {
+	ret := m.Called(ctx, query, at)
+	r0, _ := ret.Get(0).(*resource.PromQLResult)
+	return r0, ret.Error(1)
+}
+
+// RangeQuery provides a mock function for client.LogCacheService.RangeQuery
+func (m *LogCacheService) RangeQuery(ctx context.Context, query string, start time.Time, end time.Time, step time.Duration) (*resource.PromQLResult, error) {
+	ret := m.Called(ctx, query, start, end, step)
+	r0, _ := ret.Get(0).(*resource.PromQLResult)
+	return r0, ret.Error(1)
+}
+
+// Read provides a mock function for client.LogCacheService.Read
+func (m *LogCacheService) Read(ctx context.Context, sourceID string, opts *client.LogCacheReadOptions) ([]*resource.Envelope, error) {
+	ret := m.Called(ctx, sourceID, opts)
+	r0, _ := ret.Get(0).([]*resource.Envelope)
+	return r0, ret.Error(1)
+}
+
+// RecentLogs provides a mock function for client.LogCacheService.RecentLogs
+func (m *LogCacheService) RecentLogs(ctx context.Context, appGUID string) ([]*resource.Envelope, error) {
+	ret := m.Called(ctx, appGUID)
+	r0, _ := ret.Get(0).([]*resource.Envelope)
+	return r0, ret.Error(1)
+}
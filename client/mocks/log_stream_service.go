@@ -0,0 +1,25 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// LogStreamService is a mock implementation of client.LogStreamService
+type LogStreamService struct {
+	mock.Mock
+}
+
+var _ client.LogStreamService = (*LogStreamService)(nil)
+
+// Stream provides a mock function for client.LogStreamService.Stream
+func (m *LogStreamService) Stream(ctx context.Context, sourceID string, opts *client.LogStreamOptions) (<-chan *resource.Envelope, error) {
+	ret := m.Called(ctx, sourceID, opts)
+	r0, _ := ret.Get(0).(<-chan *resource.Envelope)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,39 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ManifestService is a mock implementation of client.ManifestService
+type ManifestService struct {
+	mock.Mock
+}
+
+var _ client.ManifestService = (*ManifestService)(nil)
+
+// ApplyManifest provides a mock function for client.ManifestService.ApplyManifest
+func (m *ManifestService) ApplyManifest(ctx context.Context, spaceGUID string, manifest string) (string, error) {
+	ret := m.Called(ctx, spaceGUID, manifest)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// Generate provides a mock function for client.ManifestService.Generate
+func (m *ManifestService) Generate(ctx context.Context, appGUID string) (string, error) {
+	ret := m.Called(ctx, appGUID)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// ManifestDiff provides a mock function for client.ManifestService.ManifestDiff
+func (m *ManifestService) ManifestDiff(ctx context.Context, spaceGUID string, manifest string) (*resource.ManifestDiff, error) {
+	ret := m.Called(ctx, spaceGUID, manifest)
+	r0, _ := ret.Get(0).(*resource.ManifestDiff)
+	return r0, ret.Error(1)
+}
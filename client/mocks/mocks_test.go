@@ -0,0 +1,51 @@
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/client/mocks"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// appName is an example of code that depends on the client interface rather than the concrete client
+func appName(ctx context.Context, cf client.ClientInterface, guid string) (string, error) {
+	app, err := cf.ApplicationsService().Get(ctx, guid)
+	if err != nil {
+		return "", err
+	}
+	return app.Name, nil
+}
+
+func TestClientInterface(t *testing.T) {
+	apps := &mocks.AppService{}
+	apps.On("Get", mock.Anything, "app-guid").Return(&resource.App{Name: "my-app"}, nil)
+	apps.On("Get", mock.Anything, "missing-guid").Return(nil, errors.New("not found"))
+	cf := &mocks.ClientInterface{}
+	cf.On("ApplicationsService").Return(apps)
+
+	name, err := appName(context.Background(), cf, "app-guid")
+	require.NoError(t, err)
+	require.Equal(t, "my-app", name)
+
+	_, err = appName(context.Background(), cf, "missing-guid")
+	require.EqualError(t, err, "not found")
+
+	apps.AssertExpectations(t)
+	cf.AssertNumberOfCalls(t, "ApplicationsService", 2)
+}
+
+func TestVariadic(t *testing.T) {
+	policies := &mocks.NetworkPolicyService{}
+	policies.On("List", mock.Anything, []string{"app-1", "app-2"}).
+		Return([]*resource.NetworkPolicy{{}}, nil)
+
+	got, err := policies.List(context.Background(), "app-1", "app-2")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	policies.AssertExpectations(t)
+}
@@ -0,0 +1,64 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// NetworkPolicyService is a mock implementation of client.NetworkPolicyService
+type NetworkPolicyService struct {
+	mock.Mock
+}
+
+var _ client.NetworkPolicyService = (*NetworkPolicyService)(nil)
+
+// AppGUIDs provides a mock function for client.NetworkPolicyService.AppGUIDs
+func (m *NetworkPolicyService) AppGUIDs(ctx context.Context, spaceGUID string, appNames ...string) (map[string]string, error) {
+	ret := m.Called(ctx, spaceGUID, appNames)
+	r0, _ := ret.Get(0).(map[string]string)
+	return r0, ret.Error(1)
+}
+
+// Create provides a mock function for client.NetworkPolicyService.Create
+func (m *NetworkPolicyService) Create(ctx context.Context, policies ...*resource.NetworkPolicy) error {
+	ret := m.Called(ctx, policies)
+	return ret.Error(0)
+}
+
+// CreateForAppNames provides a mock function for client.NetworkPolicyService.CreateForAppNames
+func (m *NetworkPolicyService) CreateForAppNames(ctx context.Context, spaceGUID string, sourceAppName string, destinationAppName string, protocol resource.NetworkPolicyProtocol, startPort int, endPort int) (*resource.NetworkPolicy, error) {
+	ret := m.Called(ctx, spaceGUID, sourceAppName, destinationAppName, protocol, startPort, endPort)
+	r0, _ := ret.Get(0).(*resource.NetworkPolicy)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.NetworkPolicyService.Delete
+func (m *NetworkPolicyService) Delete(ctx context.Context, policies ...*resource.NetworkPolicy) error {
+	ret := m.Called(ctx, policies)
+	return ret.Error(0)
+}
+
+// DeleteForAppNames provides a mock function for client.NetworkPolicyService.DeleteForAppNames
+func (m *NetworkPolicyService) DeleteForAppNames(ctx context.Context, spaceGUID string, sourceAppName string, destinationAppName string, protocol resource.NetworkPolicyProtocol, startPort int, endPort int) error {
+	ret := m.Called(ctx, spaceGUID, sourceAppName, destinationAppName, protocol, startPort, endPort)
+	return ret.Error(0)
+}
+
+// List provides a mock function for client.NetworkPolicyService.List
+func (m *NetworkPolicyService) List(ctx context.Context, appGUIDs ...string) ([]*resource.NetworkPolicy, error) {
+	ret := m.Called(ctx, appGUIDs)
+	r0, _ := ret.Get(0).([]*resource.NetworkPolicy)
+	return r0, ret.Error(1)
+}
+
+// ListForAppNames provides a mock function for client.NetworkPolicyService.ListForAppNames
+func (m *NetworkPolicyService) ListForAppNames(ctx context.Context, spaceGUID string, appNames ...string) ([]*resource.NetworkPolicy, error) {
+	ret := m.Called(ctx, spaceGUID, appNames)
+	r0, _ := ret.Get(0).([]*resource.NetworkPolicy)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,89 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// OrganizationQuotaService is a mock implementation of client.OrganizationQuotaService
+type OrganizationQuotaService struct {
+	mock.Mock
+}
+
+var _ client.OrganizationQuotaService = (*OrganizationQuotaService)(nil)
+
+// Apply provides a mock function for client.OrganizationQuotaService.Apply
+func (m *OrganizationQuotaService) Apply(ctx context.Context, guid string, organizationGUIDs []string) ([]string, error) {
+	ret := m.Called(ctx, guid, organizationGUIDs)
+	r0, _ := ret.Get(0).([]string)
+	return r0, ret.Error(1)
+}
+
+// Create provides a mock function for client.OrganizationQuotaService.Create
+func (m *OrganizationQuotaService) Create(ctx context.Context, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.OrganizationQuota)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.OrganizationQuotaService.Delete
+func (m *OrganizationQuotaService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.OrganizationQuotaService.First
+func (m *OrganizationQuotaService) First(ctx context.Context, opts *client.OrganizationQuotaListOptions) (*resource.OrganizationQuota, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.OrganizationQuota)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.OrganizationQuotaService.Get
+func (m *OrganizationQuotaService) Get(ctx context.Context, guid string) (*resource.OrganizationQuota, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.OrganizationQuota)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.OrganizationQuotaService.Iter
+func (m *OrganizationQuotaService) Iter(ctx context.Context, opts *client.OrganizationQuotaListOptions) client.Seq2[*resource.OrganizationQuota, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.OrganizationQuota, error])
+	return r0
+}
+
+// List provides a mock function for client.OrganizationQuotaService.List
+func (m *OrganizationQuotaService) List(ctx context.Context, opts *client.OrganizationQuotaListOptions) ([]*resource.OrganizationQuota, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.OrganizationQuota)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.OrganizationQuotaService.ListAll
+func (m *OrganizationQuotaService) ListAll(ctx context.Context, opts *client.OrganizationQuotaListOptions) ([]*resource.OrganizationQuota, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.OrganizationQuota)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.OrganizationQuotaService.Single
+func (m *OrganizationQuotaService) Single(ctx context.Context, opts *client.OrganizationQuotaListOptions) (*resource.OrganizationQuota, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.OrganizationQuota)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.OrganizationQuotaService.Update
+func (m *OrganizationQuotaService) Update(ctx context.Context, guid string, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.OrganizationQuota)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,167 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// OrganizationService is a mock implementation of client.OrganizationService
+type OrganizationService struct {
+	mock.Mock
+}
+
+var _ client.OrganizationService = (*OrganizationService)(nil)
+
+// AssignDefaultIsolationSegment provides a mock function for client.OrganizationService.AssignDefaultIsolationSegment
+func (m *OrganizationService) AssignDefaultIsolationSegment(ctx context.Context, guid string, isolationSegmentGUID string) error {
+	ret := m.Called(ctx, guid, isolationSegmentGUID)
+	return ret.Error(0)
+}
+
+// Create provides a mock function for client.OrganizationService.Create
+func (m *OrganizationService) Create(ctx context.Context, r *resource.OrganizationCreate) (*resource.Organization, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.OrganizationService.Delete
+func (m *OrganizationService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.OrganizationService.First
+func (m *OrganizationService) First(ctx context.Context, opts *client.OrganizationListOptions) (*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// FirstForIsolationSegment provides a mock function for client.OrganizationService.FirstForIsolationSegment
+func (m *OrganizationService) FirstForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *client.OrganizationListOptions) (*resource.Organization, error) {
+	ret := m.Called(ctx, isolationSegmentGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.OrganizationService.Get
+func (m *OrganizationService) Get(ctx context.Context, guid string) (*resource.Organization, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// GetDefaultDomain provides a mock function for client.OrganizationService.GetDefaultDomain
+func (m *OrganizationService) GetDefaultDomain(ctx context.Context, guid string) (*resource.Domain, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Domain)
+	return r0, ret.Error(1)
+}
+
+// GetDefaultIsolationSegment provides a mock function for client.OrganizationService.GetDefaultIsolationSegment
+func (m *OrganizationService) GetDefaultIsolationSegment(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// GetUsageSummary provides a mock function for client.OrganizationService.GetUsageSummary
+func (m *OrganizationService) GetUsageSummary(ctx context.Context, guid string) (*resource.OrganizationUsageSummary, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.OrganizationUsageSummary)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.OrganizationService.Iter
+func (m *OrganizationService) Iter(ctx context.Context, opts *client.OrganizationListOptions) client.Seq2[*resource.Organization, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Organization, error])
+	return r0
+}
+
+// IterForIsolationSegment provides a mock function for client.OrganizationService.IterForIsolationSegment
+func (m *OrganizationService) IterForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *client.OrganizationListOptions) client.Seq2[*resource.Organization, error] {
+	ret := m.Called(ctx, isolationSegmentGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Organization, error])
+	return r0
+}
+
+// IterUsers provides a mock function for client.OrganizationService.IterUsers
+func (m *OrganizationService) IterUsers(ctx context.Context, guid string, opts *client.UserListOptions) client.Seq2[*resource.User, error] {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.User, error])
+	return r0
+}
+
+// List provides a mock function for client.OrganizationService.List
+func (m *OrganizationService) List(ctx context.Context, opts *client.OrganizationListOptions) ([]*resource.Organization, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Organization)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.OrganizationService.ListAll
+func (m *OrganizationService) ListAll(ctx context.Context, opts *client.OrganizationListOptions) ([]*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// ListForIsolationSegment provides a mock function for client.OrganizationService.ListForIsolationSegment
+func (m *OrganizationService) ListForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *client.OrganizationListOptions) ([]*resource.Organization, *client.Pager, error) {
+	ret := m.Called(ctx, isolationSegmentGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Organization)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForIsolationSegmentAll provides a mock function for client.OrganizationService.ListForIsolationSegmentAll
+func (m *OrganizationService) ListForIsolationSegmentAll(ctx context.Context, isolationSegmentGUID string, opts *client.OrganizationListOptions) ([]*resource.Organization, error) {
+	ret := m.Called(ctx, isolationSegmentGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// ListUsers provides a mock function for client.OrganizationService.ListUsers
+func (m *OrganizationService) ListUsers(ctx context.Context, guid string, opts *client.UserListOptions) ([]*resource.User, *client.Pager, error) {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).([]*resource.User)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListUsersAll provides a mock function for client.OrganizationService.ListUsersAll
+func (m *OrganizationService) ListUsersAll(ctx context.Context, guid string, opts *client.UserListOptions) ([]*resource.User, error) {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).([]*resource.User)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.OrganizationService.Single
+func (m *OrganizationService) Single(ctx context.Context, opts *client.OrganizationListOptions) (*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// SingleForIsolationSegment provides a mock function for client.OrganizationService.SingleForIsolationSegment
+func (m *OrganizationService) SingleForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *client.OrganizationListOptions) (*resource.Organization, error) {
+	ret := m.Called(ctx, isolationSegmentGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Organization)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.OrganizationService.Update
+func (m *OrganizationService) Update(ctx context.Context, guid string, r *resource.OrganizationUpdate) (*resource.Organization, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Organization)
+	return r0, ret.Error(1)
+}
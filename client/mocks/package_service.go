@@ -0,0 +1,153 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// PackageService is a mock implementation of client.PackageService
+type PackageService struct {
+	mock.Mock
+}
+
+var _ client.PackageService = (*PackageService)(nil)
+
+// Copy provides a mock function for client.PackageService.Copy
+func (m *PackageService) Copy(ctx context.Context, srcPackageGUID string, destAppGUID string) (*resource.Package, error) {
+	ret := m.Called(ctx, srcPackageGUID, destAppGUID)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// Create provides a mock function for client.PackageService.Create
+func (m *PackageService) Create(ctx context.Context, r *resource.PackageCreate) (*resource.Package, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.PackageService.Delete
+func (m *PackageService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// Download provides a mock function for client.PackageService.Download
+func (m *PackageService) Download(ctx context.Context, guid string) (io.ReadCloser, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(io.ReadCloser)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.PackageService.First
+func (m *PackageService) First(ctx context.Context, opts *client.PackageListOptions) (*resource.Package, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// FirstForApp provides a mock function for client.PackageService.FirstForApp
+func (m *PackageService) FirstForApp(ctx context.Context, appGUID string, opts *client.PackageListOptions) (*resource.Package, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.PackageService.Get
+func (m *PackageService) Get(ctx context.Context, guid string) (*resource.Package, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.PackageService.Iter
+func (m *PackageService) Iter(ctx context.Context, opts *client.PackageListOptions) client.Seq2[*resource.Package, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Package, error])
+	return r0
+}
+
+// IterForApp provides a mock function for client.PackageService.IterForApp
+func (m *PackageService) IterForApp(ctx context.Context, appGUID string, opts *client.PackageListOptions) client.Seq2[*resource.Package, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Package, error])
+	return r0
+}
+
+// List provides a mock function for client.PackageService.List
+func (m *PackageService) List(ctx context.Context, opts *client.PackageListOptions) ([]*resource.Package, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Package)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.PackageService.ListAll
+func (m *PackageService) ListAll(ctx context.Context, opts *client.PackageListOptions) ([]*resource.Package, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// ListForApp provides a mock function for client.PackageService.ListForApp
+func (m *PackageService) ListForApp(ctx context.Context, appGUID string, opts *client.PackageListOptions) ([]*resource.Package, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Package)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.PackageService.ListForAppAll
+func (m *PackageService) ListForAppAll(ctx context.Context, appGUID string, opts *client.PackageListOptions) ([]*resource.Package, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// PollReady provides a mock function for client.PackageService.PollReady
+func (m *PackageService) PollReady(ctx context.Context, guid string, opts *client.PollingOptions) error {
+	ret := m.Called(ctx, guid, opts)
+	return ret.Error(0)
+}
+
+// Single provides a mock function for client.PackageService.Single
+func (m *PackageService) Single(ctx context.Context, opts *client.PackageListOptions) (*resource.Package, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.PackageService.SingleForApp
+func (m *PackageService) SingleForApp(ctx context.Context, appGUID string, opts *client.PackageListOptions) (*resource.Package, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.PackageService.Update
+func (m *PackageService) Update(ctx context.Context, guid string, r *resource.PackageUpdate) (*resource.Package, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// Upload provides a mock function for client.PackageService.Upload
+func (m *PackageService) Upload(ctx context.Context, guid string, zipFile io.Reader) (*resource.Package, error) {
+	ret := m.Called(ctx, guid, zipFile)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
+
+// UploadWithResources provides a mock function for client.PackageService.UploadWithResources
+func (m *PackageService) UploadWithResources(ctx context.Context, guid string, zipFile io.Reader, resources []resource.ResourceMatch) (*resource.Package, error) {
+	ret := m.Called(ctx, guid, zipFile, resources)
+	r0, _ := ret.Get(0).(*resource.Package)
+	return r0, ret.Error(1)
+}
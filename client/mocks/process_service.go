@@ -0,0 +1,131 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ProcessService is a mock implementation of client.ProcessService
+type ProcessService struct {
+	mock.Mock
+}
+
+var _ client.ProcessService = (*ProcessService)(nil)
+
+// First provides a mock function for client.ProcessService.First
+func (m *ProcessService) First(ctx context.Context, opts *client.ProcessListOptions) (*resource.Process, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// FirstForApp provides a mock function for client.ProcessService.FirstForApp
+func (m *ProcessService) FirstForApp(ctx context.Context, appGUID string, opts *client.ProcessListOptions) (*resource.Process, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ProcessService.Get
+func (m *ProcessService) Get(ctx context.Context, guid string) (*resource.Process, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// GetStats provides a mock function for client.ProcessService.GetStats
+func (m *ProcessService) GetStats(ctx context.Context, guid string) (*resource.ProcessStats, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ProcessStats)
+	return r0, ret.Error(1)
+}
+
+// GetStatsForApp provides a mock function for client.ProcessService.GetStatsForApp
+func (m *ProcessService) GetStatsForApp(ctx context.Context, appGUID string, processType string) (*resource.ProcessStats, error) {
+	ret := m.Called(ctx, appGUID, processType)
+	r0, _ := ret.Get(0).(*resource.ProcessStats)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.ProcessService.Iter
+func (m *ProcessService) Iter(ctx context.Context, opts *client.ProcessListOptions) client.Seq2[*resource.Process, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Process, error])
+	return r0
+}
+
+// IterForApp provides a mock function for client.ProcessService.IterForApp
+func (m *ProcessService) IterForApp(ctx context.Context, appGUID string, opts *client.ProcessListOptions) client.Seq2[*resource.Process, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Process, error])
+	return r0
+}
+
+// List provides a mock function for client.ProcessService.List
+func (m *ProcessService) List(ctx context.Context, opts *client.ProcessListOptions) ([]*resource.Process, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Process)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ProcessService.ListAll
+func (m *ProcessService) ListAll(ctx context.Context, opts *client.ProcessListOptions) ([]*resource.Process, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// ListForApp provides a mock function for client.ProcessService.ListForApp
+func (m *ProcessService) ListForApp(ctx context.Context, appGUID string, opts *client.ProcessListOptions) ([]*resource.Process, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Process)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.ProcessService.ListForAppAll
+func (m *ProcessService) ListForAppAll(ctx context.Context, appGUID string, opts *client.ProcessListOptions) ([]*resource.Process, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// Scale provides a mock function for client.ProcessService.Scale
+func (m *ProcessService) Scale(ctx context.Context, guid string, scale *resource.ProcessScale) (*resource.Process, error) {
+	ret := m.Called(ctx, guid, scale)
+	r0, _ := ret.Get(0).(*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.ProcessService.Single
+func (m *ProcessService) Single(ctx context.Context, opts *client.ProcessListOptions) (*resource.Process, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.ProcessService.SingleForApp
+func (m *ProcessService) SingleForApp(ctx context.Context, appGUID string, opts *client.ProcessListOptions) (*resource.Process, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Process)
+	return r0, ret.Error(1)
+}
+
+// Terminate provides a mock function for client.ProcessService.Terminate
+func (m *ProcessService) Terminate(ctx context.Context, guid string, index int) error {
+	ret := m.Called(ctx, guid, index)
+	return ret.Error(0)
+}
+
+// Update provides a mock function for client.ProcessService.Update
+func (m *ProcessService) Update(ctx context.Context, guid string, r *resource.ProcessUpdate) (*resource.Process, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Process)
+	return r0, ret.Error(1)
+}
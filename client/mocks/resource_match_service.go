@@ -0,0 +1,25 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ResourceMatchService is a mock implementation of client.ResourceMatchService
+type ResourceMatchService struct {
+	mock.Mock
+}
+
+var _ client.ResourceMatchService = (*ResourceMatchService)(nil)
+
+// Create provides a mock function for client.ResourceMatchService.Create
+func (m *ResourceMatchService) Create(ctx context.Context, toMatch *resource.ResourceMatches) (*resource.ResourceMatches, error) {
+	ret := m.Called(ctx, toMatch)
+	r0, _ := ret.Get(0).(*resource.ResourceMatches)
+	return r0, ret.Error(1)
+}
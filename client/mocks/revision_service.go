@@ -0,0 +1,104 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// RevisionService is a mock implementation of client.RevisionService
+type RevisionService struct {
+	mock.Mock
+}
+
+var _ client.RevisionService = (*RevisionService)(nil)
+
+// FirstForApp provides a mock function for client.RevisionService.FirstForApp
+func (m *RevisionService) FirstForApp(ctx context.Context, appGUID string, opts *client.RevisionListOptions) (*resource.Revision, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Revision)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.RevisionService.Get
+func (m *RevisionService) Get(ctx context.Context, guid string) (*resource.Revision, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Revision)
+	return r0, ret.Error(1)
+}
+
+// GetEnvironmentVariables provides a mock function for client.RevisionService.GetEnvironmentVariables
+func (m *RevisionService) GetEnvironmentVariables(ctx context.Context, guid string) (map[string]*string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(map[string]*string)
+	return r0, ret.Error(1)
+}
+
+// IterForApp provides a mock function for client.RevisionService.IterForApp
+func (m *RevisionService) IterForApp(ctx context.Context, appGUID string, opts *client.RevisionListOptions) client.Seq2[*resource.Revision, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Revision, error])
+	return r0
+}
+
+// IterForAppDeployed provides a mock function for client.RevisionService.IterForAppDeployed
+func (m *RevisionService) IterForAppDeployed(ctx context.Context, appGUID string, opts *client.RevisionListOptions) client.Seq2[*resource.Revision, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Revision, error])
+	return r0
+}
+
+// ListForApp provides a mock function for client.RevisionService.ListForApp
+func (m *RevisionService) ListForApp(ctx context.Context, appGUID string, opts *client.RevisionListOptions) ([]*resource.Revision, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Revision)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.RevisionService.ListForAppAll
+func (m *RevisionService) ListForAppAll(ctx context.Context, appGUID string, opts *client.RevisionListOptions) ([]*resource.Revision, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Revision)
+	return r0, ret.Error(1)
+}
+
+// ListForAppDeployed provides a mock function for client.RevisionService.ListForAppDeployed
+func (m *RevisionService) ListForAppDeployed(ctx context.Context, appGUID string, opts *client.RevisionListOptions) ([]*resource.Revision, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Revision)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppDeployedAll provides a mock function for client.RevisionService.ListForAppDeployedAll
+func (m *RevisionService) ListForAppDeployedAll(ctx context.Context, appGUID string, opts *client.RevisionListOptions) ([]*resource.Revision, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Revision)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.RevisionService.SingleForApp
+func (m *RevisionService) SingleForApp(ctx context.Context, appGUID string, opts *client.RevisionListOptions) (*resource.Revision, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Revision)
+	return r0, ret.Error(1)
+}
+
+// SingleForAppDeployed provides a mock function for client.RevisionService.SingleForAppDeployed
+func (m *RevisionService) SingleForAppDeployed(ctx context.Context, appGUID string, opts *client.RevisionListOptions) (*resource.Revision, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Revision)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.RevisionService.Update
+func (m *RevisionService) Update(ctx context.Context, guid string, r *resource.RevisionUpdate) (*resource.Revision, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Revision)
+	return r0, ret.Error(1)
+}
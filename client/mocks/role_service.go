@@ -0,0 +1,157 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// RoleService is a mock implementation of client.RoleService
+type RoleService struct {
+	mock.Mock
+}
+
+var _ client.RoleService = (*RoleService)(nil)
+
+// CreateOrganizationRole provides a mock function for client.RoleService.CreateOrganizationRole
+func (m *RoleService) CreateOrganizationRole(ctx context.Context, organizationGUID string, userGUID string, roleType resource.OrganizationRoleType) (*resource.Role, error) {
+	ret := m.Called(ctx, organizationGUID, userGUID, roleType)
+	r0, _ := ret.Get(0).(*resource.Role)
+	return r0, ret.Error(1)
+}
+
+// CreateSpaceRole provides a mock function for client.RoleService.CreateSpaceRole
+func (m *RoleService) CreateSpaceRole(ctx context.Context, spaceGUID string, userGUID string, roleType resource.SpaceRoleType) (*resource.Role, error) {
+	ret := m.Called(ctx, spaceGUID, userGUID, roleType)
+	r0, _ := ret.Get(0).(*resource.Role)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.RoleService.Delete
+func (m *RoleService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.RoleService.First
+func (m *RoleService) First(ctx context.Context, opts *client.RoleListOptions) (*resource.Role, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Role)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.RoleService.Get
+func (m *RoleService) Get(ctx context.Context, guid string) (*resource.Role, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Role)
+	return r0, ret.Error(1)
+}
+
+// GetIncludeOrganizations provides a mock function for client.RoleService.GetIncludeOrganizations
+func (m *RoleService) GetIncludeOrganizations(ctx context.Context, guid string) (*resource.Role, []*resource.Organization, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.Organization)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeSpaces provides a mock function for client.RoleService.GetIncludeSpaces
+func (m *RoleService) GetIncludeSpaces(ctx context.Context, guid string) (*resource.Role, []*resource.Space, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeUsers provides a mock function for client.RoleService.GetIncludeUsers
+func (m *RoleService) GetIncludeUsers(ctx context.Context, guid string) (*resource.Role, []*resource.User, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.User)
+	return r0, r1, ret.Error(2)
+}
+
+// Iter provides a mock function for client.RoleService.Iter
+func (m *RoleService) Iter(ctx context.Context, opts *client.RoleListOptions) client.Seq2[*resource.Role, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Role, error])
+	return r0
+}
+
+// List provides a mock function for client.RoleService.List
+func (m *RoleService) List(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.RoleService.ListAll
+func (m *RoleService) ListAll(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	return r0, ret.Error(1)
+}
+
+// ListIncludeOrganizations provides a mock function for client.RoleService.ListIncludeOrganizations
+func (m *RoleService) ListIncludeOrganizations(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, []*resource.Organization, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.Organization)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeOrganizationsAll provides a mock function for client.RoleService.ListIncludeOrganizationsAll
+func (m *RoleService) ListIncludeOrganizationsAll(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, []*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.Organization)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeSpaces provides a mock function for client.RoleService.ListIncludeSpaces
+func (m *RoleService) ListIncludeSpaces(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, []*resource.Space, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeSpacesAll provides a mock function for client.RoleService.ListIncludeSpacesAll
+func (m *RoleService) ListIncludeSpacesAll(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, []*resource.Space, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeUsers provides a mock function for client.RoleService.ListIncludeUsers
+func (m *RoleService) ListIncludeUsers(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, []*resource.User, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.User)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeUsersAll provides a mock function for client.RoleService.ListIncludeUsersAll
+func (m *RoleService) ListIncludeUsersAll(ctx context.Context, opts *client.RoleListOptions) ([]*resource.Role, []*resource.User, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Role)
+	r1, _ := ret.Get(1).([]*resource.User)
+	return r0, r1, ret.Error(2)
+}
+
+// Single provides a mock function for client.RoleService.Single
+func (m *RoleService) Single(ctx context.Context, opts *client.RoleListOptions) (*resource.Role, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Role)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,32 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// RootService is a mock implementation of client.RootService
+type RootService struct {
+	mock.Mock
+}
+
+var _ client.RootService = (*RootService)(nil)
+
+// Get provides a mock function for client.RootService.Get
+func (m *RootService) Get(ctx context.Context) (*resource.Root, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).(*resource.Root)
+	return r0, ret.Error(1)
+}
+
+// GetV3 provides a mock function for client.RootService.GetV3
+func (m *RootService) GetV3(ctx context.Context) (*resource.V3Root, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).(*resource.V3Root)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,283 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// RouteService is a mock implementation of client.RouteService
+type RouteService struct {
+	mock.Mock
+}
+
+var _ client.RouteService = (*RouteService)(nil)
+
+// Create provides a mock function for client.RouteService.Create
+func (m *RouteService) Create(ctx context.Context, r *resource.RouteCreate) (*resource.Route, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.RouteService.Delete
+func (m *RouteService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// DeleteUnmappedRoutesForSpace provides a mock function for client.RouteService.DeleteUnmappedRoutesForSpace
+func (m *RouteService) DeleteUnmappedRoutesForSpace(ctx context.Context, spaceGUID string) (string, error) {
+	ret := m.Called(ctx, spaceGUID)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.RouteService.First
+func (m *RouteService) First(ctx context.Context, opts *client.RouteListOptions) (*resource.Route, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// FirstForApp provides a mock function for client.RouteService.FirstForApp
+func (m *RouteService) FirstForApp(ctx context.Context, appGUID string, opts *client.RouteListOptions) (*resource.Route, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.RouteService.Get
+func (m *RouteService) Get(ctx context.Context, guid string) (*resource.Route, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// GetDestinations provides a mock function for client.RouteService.GetDestinations
+func (m *RouteService) GetDestinations(ctx context.Context, guid string) (*resource.RouteDestinations, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.RouteDestinations)
+	return r0, ret.Error(1)
+}
+
+// GetIncludeDomain provides a mock function for client.RouteService.GetIncludeDomain
+func (m *RouteService) GetIncludeDomain(ctx context.Context, guid string) (*resource.Route, *resource.Domain, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Route)
+	r1, _ := ret.Get(1).(*resource.Domain)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeSpace provides a mock function for client.RouteService.GetIncludeSpace
+func (m *RouteService) GetIncludeSpace(ctx context.Context, guid string) (*resource.Route, *resource.Space, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Route)
+	r1, _ := ret.Get(1).(*resource.Space)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeSpaceAndOrganization provides a mock function for client.RouteService.GetIncludeSpaceAndOrganization
+func (m *RouteService) GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.Route, *resource.Space, *resource.Organization, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Route)
+	r1, _ := ret.Get(1).(*resource.Space)
+	r2, _ := ret.Get(2).(*resource.Organization)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// GetSharedSpacesRelationships provides a mock function for client.RouteService.GetSharedSpacesRelationships
+func (m *RouteService) GetSharedSpacesRelationships(ctx context.Context, guid string) (*resource.RouteSharedSpaceRelationships, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.RouteSharedSpaceRelationships)
+	return r0, ret.Error(1)
+}
+
+// InsertDestinations provides a mock function for client.RouteService.InsertDestinations
+func (m *RouteService) InsertDestinations(ctx context.Context, guid string, dest []*resource.RouteDestinationInsertOrReplace) (*resource.RouteDestinations, error) {
+	ret := m.Called(ctx, guid, dest)
+	r0, _ := ret.Get(0).(*resource.RouteDestinations)
+	return r0, ret.Error(1)
+}
+
+// IsRouteReserved provides a mock function for client.RouteService.IsRouteReserved
+func (m *RouteService) IsRouteReserved(ctx context.Context, domainGUID string, opts *client.RouteReservationListOptions) (bool, error) {
+	ret := m.Called(ctx, domainGUID, opts)
+	r0, _ := ret.Get(0).(bool)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.RouteService.Iter
+func (m *RouteService) Iter(ctx context.Context, opts *client.RouteListOptions) client.Seq2[*resource.Route, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Route, error])
+	return r0
+}
+
+// IterForApp provides a mock function for client.RouteService.IterForApp
+func (m *RouteService) IterForApp(ctx context.Context, appGUID string, opts *client.RouteListOptions) client.Seq2[*resource.Route, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Route, error])
+	return r0
+}
+
+// List provides a mock function for client.RouteService.List
+func (m *RouteService) List(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.RouteService.ListAll
+func (m *RouteService) ListAll(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// ListForApp provides a mock function for client.RouteService.ListForApp
+func (m *RouteService) ListForApp(ctx context.Context, appGUID string, opts *client.RouteListOptions) ([]*resource.Route, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.RouteService.ListForAppAll
+func (m *RouteService) ListForAppAll(ctx context.Context, appGUID string, opts *client.RouteListOptions) ([]*resource.Route, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// ListIncludeDomains provides a mock function for client.RouteService.ListIncludeDomains
+func (m *RouteService) ListIncludeDomains(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, []*resource.Domain, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).([]*resource.Domain)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeDomainsAll provides a mock function for client.RouteService.ListIncludeDomainsAll
+func (m *RouteService) ListIncludeDomainsAll(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, []*resource.Domain, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).([]*resource.Domain)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeSpaces provides a mock function for client.RouteService.ListIncludeSpaces
+func (m *RouteService) ListIncludeSpaces(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, []*resource.Space, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeSpacesAll provides a mock function for client.RouteService.ListIncludeSpacesAll
+func (m *RouteService) ListIncludeSpacesAll(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, []*resource.Space, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeSpacesAndOrganizations provides a mock function for client.RouteService.ListIncludeSpacesAndOrganizations
+func (m *RouteService) ListIncludeSpacesAndOrganizations(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, []*resource.Space, []*resource.Organization, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).([]*resource.Organization)
+	r3, _ := ret.Get(3).(*client.Pager)
+	return r0, r1, r2, r3, ret.Error(4)
+}
+
+// ListIncludeSpacesAndOrganizationsAll provides a mock function for client.RouteService.ListIncludeSpacesAndOrganizationsAll
+func (m *RouteService) ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *client.RouteListOptions) ([]*resource.Route, []*resource.Space, []*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Route)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).([]*resource.Organization)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// RemoveDestination provides a mock function for client.RouteService.RemoveDestination
+func (m *RouteService) RemoveDestination(ctx context.Context, guid string, destinationGUID string) error {
+	ret := m.Called(ctx, guid, destinationGUID)
+	return ret.Error(0)
+}
+
+// ReplaceDestinations provides a mock function for client.RouteService.ReplaceDestinations
+func (m *RouteService) ReplaceDestinations(ctx context.Context, guid string, dest []*resource.RouteDestinationInsertOrReplace) (*resource.RouteDestinations, error) {
+	ret := m.Called(ctx, guid, dest)
+	r0, _ := ret.Get(0).(*resource.RouteDestinations)
+	return r0, ret.Error(1)
+}
+
+// ShareWithSpace provides a mock function for client.RouteService.ShareWithSpace
+func (m *RouteService) ShareWithSpace(ctx context.Context, guid string, spaceGUID string) (*resource.RouteSharedSpaceRelationships, error) {
+	ret := m.Called(ctx, guid, spaceGUID)
+	r0, _ := ret.Get(0).(*resource.RouteSharedSpaceRelationships)
+	return r0, ret.Error(1)
+}
+
+// ShareWithSpaces provides a mock function for client.RouteService.ShareWithSpaces
+func (m *RouteService) ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.RouteSharedSpaceRelationships, error) {
+	ret := m.Called(ctx, guid, spaceGUIDs)
+	r0, _ := ret.Get(0).(*resource.RouteSharedSpaceRelationships)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.RouteService.Single
+func (m *RouteService) Single(ctx context.Context, opts *client.RouteListOptions) (*resource.Route, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.RouteService.SingleForApp
+func (m *RouteService) SingleForApp(ctx context.Context, appGUID string, opts *client.RouteListOptions) (*resource.Route, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// TransferOwnership provides a mock function for client.RouteService.TransferOwnership
+func (m *RouteService) TransferOwnership(ctx context.Context, guid string, spaceGUID string) error {
+	ret := m.Called(ctx, guid, spaceGUID)
+	return ret.Error(0)
+}
+
+// UnShareWithSpace provides a mock function for client.RouteService.UnShareWithSpace
+func (m *RouteService) UnShareWithSpace(ctx context.Context, guid string, spaceGUID string) error {
+	ret := m.Called(ctx, guid, spaceGUID)
+	return ret.Error(0)
+}
+
+// UnShareWithSpaces provides a mock function for client.RouteService.UnShareWithSpaces
+func (m *RouteService) UnShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) error {
+	ret := m.Called(ctx, guid, spaceGUIDs)
+	return ret.Error(0)
+}
+
+// Update provides a mock function for client.RouteService.Update
+func (m *RouteService) Update(ctx context.Context, guid string, r *resource.RouteUpdate) (*resource.Route, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Route)
+	return r0, ret.Error(1)
+}
+
+// UpdateDestinationProtocol provides a mock function for client.RouteService.UpdateDestinationProtocol
+func (m *RouteService) UpdateDestinationProtocol(ctx context.Context, guid string, destinationGUID string, protocol string) (*resource.RouteDestinationWithLinks, error) {
+	ret := m.Called(ctx, guid, destinationGUID, protocol)
+	r0, _ := ret.Get(0).(*resource.RouteDestinationWithLinks)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,79 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// RoutingAPIService is a mock implementation of client.RoutingAPIService
+type RoutingAPIService struct {
+	mock.Mock
+}
+
+var _ client.RoutingAPIService = (*RoutingAPIService)(nil)
+
+// CreateTCPRoutes provides a mock function for client.RoutingAPIService.CreateTCPRoutes
+func (m *RoutingAPIService) CreateTCPRoutes(ctx context.Context, routes ...*resource.TCPRoute) error {
+	ret := m.Called(ctx, routes)
+	return ret.Error(0)
+}
+
+// DeleteTCPRoutes provides a mock function for client.RoutingAPIService.DeleteTCPRoutes
+func (m *RoutingAPIService) DeleteTCPRoutes(ctx context.Context, routes ...*resource.TCPRoute) error {
+	ret := m.Called(ctx, routes)
+	return ret.Error(0)
+}
+
+// FreePort provides a mock function for client.RoutingAPIService.FreePort
+func (m *RoutingAPIService) FreePort(ctx context.Context, domainGUID string) (int, error) {
+	ret := m.Called(ctx, domainGUID)
+	r0, _ := ret.Get(0).(int)
+	return r0, ret.Error(1)
+}
+
+// GetRouterGroup provides a mock function for client.RoutingAPIService.GetRouterGroup
+func (m *RoutingAPIService) GetRouterGroup(ctx context.Context, guid string) (*resource.RouterGroup, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.RouterGroup)
+	return r0, ret.Error(1)
+}
+
+// GetRouterGroupByName provides a mock function for client.RoutingAPIService.GetRouterGroupByName
+func (m *RoutingAPIService) GetRouterGroupByName(ctx context.Context, name string) (*resource.RouterGroup, error) {
+	ret := m.Called(ctx, name)
+	r0, _ := ret.Get(0).(*resource.RouterGroup)
+	return r0, ret.Error(1)
+}
+
+// ListRouterGroups provides a mock function for client.RoutingAPIService.ListRouterGroups
+func (m *RoutingAPIService) ListRouterGroups(ctx context.Context) ([]*resource.RouterGroup, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).([]*resource.RouterGroup)
+	return r0, ret.Error(1)
+}
+
+// ListTCPRoutes provides a mock function for client.RoutingAPIService.ListTCPRoutes
+func (m *RoutingAPIService) ListTCPRoutes(ctx context.Context) ([]*resource.TCPRoute, error) {
+	ret := m.Called(ctx)
+	r0, _ := ret.Get(0).([]*resource.TCPRoute)
+	return r0, ret.Error(1)
+}
+
+// ListTCPRoutesForRouterGroup provides a mock function for client.RoutingAPIService.ListTCPRoutesForRouterGroup
+func (m *RoutingAPIService) ListTCPRoutesForRouterGroup(ctx context.Context, routerGroupGUID string) ([]*resource.TCPRoute, error) {
+	ret := m.Called(ctx, routerGroupGUID)
+	r0, _ := ret.Get(0).([]*resource.TCPRoute)
+	return r0, ret.Error(1)
+}
+
+// UpdateRouterGroup provides a mock function for client.RoutingAPIService.UpdateRouterGroup
+func (m *RoutingAPIService) UpdateRouterGroup(ctx context.Context, guid string, r *resource.RouterGroupUpdate) (*resource.RouterGroup, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.RouterGroup)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,152 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// SecurityGroupService is a mock implementation of client.SecurityGroupService
+type SecurityGroupService struct {
+	mock.Mock
+}
+
+var _ client.SecurityGroupService = (*SecurityGroupService)(nil)
+
+// BindRunningSecurityGroup provides a mock function for client.SecurityGroupService.BindRunningSecurityGroup
+func (m *SecurityGroupService) BindRunningSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
+	ret := m.Called(ctx, guid, spaceGUIDs)
+	r0, _ := ret.Get(0).([]string)
+	return r0, ret.Error(1)
+}
+
+// BindStagingSecurityGroup provides a mock function for client.SecurityGroupService.BindStagingSecurityGroup
+func (m *SecurityGroupService) BindStagingSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
+	ret := m.Called(ctx, guid, spaceGUIDs)
+	r0, _ := ret.Get(0).([]string)
+	return r0, ret.Error(1)
+}
+
+// Create provides a mock function for client.SecurityGroupService.Create
+func (m *SecurityGroupService) Create(ctx context.Context, r *resource.SecurityGroupCreate) (*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.SecurityGroupService.Delete
+func (m *SecurityGroupService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.SecurityGroupService.First
+func (m *SecurityGroupService) First(ctx context.Context, opts *client.SecurityGroupListOptions) (*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.SecurityGroupService.Get
+func (m *SecurityGroupService) Get(ctx context.Context, guid string) (*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.SecurityGroupService.Iter
+func (m *SecurityGroupService) Iter(ctx context.Context, opts *client.SecurityGroupListOptions) client.Seq2[*resource.SecurityGroup, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.SecurityGroup, error])
+	return r0
+}
+
+// IterRunningForSpace provides a mock function for client.SecurityGroupService.IterRunningForSpace
+func (m *SecurityGroupService) IterRunningForSpace(ctx context.Context, spaceGUID string, opts *client.SecurityGroupSpaceListOptions) client.Seq2[*resource.SecurityGroup, error] {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.SecurityGroup, error])
+	return r0
+}
+
+// IterStagingForSpace provides a mock function for client.SecurityGroupService.IterStagingForSpace
+func (m *SecurityGroupService) IterStagingForSpace(ctx context.Context, spaceGUID string, opts *client.SecurityGroupSpaceListOptions) client.Seq2[*resource.SecurityGroup, error] {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.SecurityGroup, error])
+	return r0
+}
+
+// List provides a mock function for client.SecurityGroupService.List
+func (m *SecurityGroupService) List(ctx context.Context, opts *client.SecurityGroupListOptions) ([]*resource.SecurityGroup, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.SecurityGroup)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.SecurityGroupService.ListAll
+func (m *SecurityGroupService) ListAll(ctx context.Context, opts *client.SecurityGroupListOptions) ([]*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
+
+// ListRunningForSpace provides a mock function for client.SecurityGroupService.ListRunningForSpace
+func (m *SecurityGroupService) ListRunningForSpace(ctx context.Context, spaceGUID string, opts *client.SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *client.Pager, error) {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.SecurityGroup)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListRunningForSpaceAll provides a mock function for client.SecurityGroupService.ListRunningForSpaceAll
+func (m *SecurityGroupService) ListRunningForSpaceAll(ctx context.Context, spaceGUID string, opts *client.SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
+
+// ListStagingForSpace provides a mock function for client.SecurityGroupService.ListStagingForSpace
+func (m *SecurityGroupService) ListStagingForSpace(ctx context.Context, spaceGUID string, opts *client.SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *client.Pager, error) {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.SecurityGroup)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListStagingForSpaceAll provides a mock function for client.SecurityGroupService.ListStagingForSpaceAll
+func (m *SecurityGroupService) ListStagingForSpaceAll(ctx context.Context, spaceGUID string, opts *client.SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.SecurityGroupService.Single
+func (m *SecurityGroupService) Single(ctx context.Context, opts *client.SecurityGroupListOptions) (*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
+
+// UnBindRunningSecurityGroup provides a mock function for client.SecurityGroupService.UnBindRunningSecurityGroup
+func (m *SecurityGroupService) UnBindRunningSecurityGroup(ctx context.Context, guid string, spaceGUID string) error {
+	ret := m.Called(ctx, guid, spaceGUID)
+	return ret.Error(0)
+}
+
+// UnBindStagingSecurityGroup provides a mock function for client.SecurityGroupService.UnBindStagingSecurityGroup
+func (m *SecurityGroupService) UnBindStagingSecurityGroup(ctx context.Context, guid string, spaceGUID string) error {
+	ret := m.Called(ctx, guid, spaceGUID)
+	return ret.Error(0)
+}
+
+// Update provides a mock function for client.SecurityGroupService.Update
+func (m *SecurityGroupService) Update(ctx context.Context, guid string, r *resource.SecurityGroupUpdate) (*resource.SecurityGroup, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.SecurityGroup)
+	return r0, ret.Error(1)
+}
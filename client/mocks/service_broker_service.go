@@ -0,0 +1,83 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServiceBrokerService is a mock implementation of client.ServiceBrokerService
+type ServiceBrokerService struct {
+	mock.Mock
+}
+
+var _ client.ServiceBrokerService = (*ServiceBrokerService)(nil)
+
+// Create provides a mock function for client.ServiceBrokerService.Create
+func (m *ServiceBrokerService) Create(ctx context.Context, r *resource.ServiceBrokerCreate) (string, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.ServiceBrokerService.Delete
+func (m *ServiceBrokerService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.ServiceBrokerService.First
+func (m *ServiceBrokerService) First(ctx context.Context, opts *client.ServiceBrokerListOptions) (*resource.ServiceBroker, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceBroker)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ServiceBrokerService.Get
+func (m *ServiceBrokerService) Get(ctx context.Context, guid string) (*resource.ServiceBroker, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceBroker)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.ServiceBrokerService.Iter
+func (m *ServiceBrokerService) Iter(ctx context.Context, opts *client.ServiceBrokerListOptions) client.Seq2[*resource.ServiceBroker, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.ServiceBroker, error])
+	return r0
+}
+
+// List provides a mock function for client.ServiceBrokerService.List
+func (m *ServiceBrokerService) List(ctx context.Context, opts *client.ServiceBrokerListOptions) ([]*resource.ServiceBroker, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceBroker)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ServiceBrokerService.ListAll
+func (m *ServiceBrokerService) ListAll(ctx context.Context, opts *client.ServiceBrokerListOptions) ([]*resource.ServiceBroker, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceBroker)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.ServiceBrokerService.Single
+func (m *ServiceBrokerService) Single(ctx context.Context, opts *client.ServiceBrokerListOptions) (*resource.ServiceBroker, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceBroker)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.ServiceBrokerService.Update
+func (m *ServiceBrokerService) Update(ctx context.Context, guid string, r *resource.ServiceBrokerUpdate) (string, *resource.ServiceBroker, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(*resource.ServiceBroker)
+	return r0, r1, ret.Error(2)
+}
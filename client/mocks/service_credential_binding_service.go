@@ -0,0 +1,146 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServiceCredentialBindingService is a mock implementation of client.ServiceCredentialBindingService
+type ServiceCredentialBindingService struct {
+	mock.Mock
+}
+
+var _ client.ServiceCredentialBindingService = (*ServiceCredentialBindingService)(nil)
+
+// Create provides a mock function for client.ServiceCredentialBindingService.Create
+func (m *ServiceCredentialBindingService) Create(ctx context.Context, r *resource.ServiceCredentialBindingCreate) (string, *resource.ServiceCredentialBinding, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(*resource.ServiceCredentialBinding)
+	return r0, r1, ret.Error(2)
+}
+
+// Delete provides a mock function for client.ServiceCredentialBindingService.Delete
+func (m *ServiceCredentialBindingService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// First provides a mock function for client.ServiceCredentialBindingService.First
+func (m *ServiceCredentialBindingService) First(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) (*resource.ServiceCredentialBinding, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceCredentialBinding)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ServiceCredentialBindingService.Get
+func (m *ServiceCredentialBindingService) Get(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceCredentialBinding)
+	return r0, ret.Error(1)
+}
+
+// GetDetails provides a mock function for client.ServiceCredentialBindingService.GetDetails
+func (m *ServiceCredentialBindingService) GetDetails(ctx context.Context, guid string) (*resource.ServiceCredentialBindingDetails, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceCredentialBindingDetails)
+	return r0, ret.Error(1)
+}
+
+// GetIncludeApp provides a mock function for client.ServiceCredentialBindingService.GetIncludeApp
+func (m *ServiceCredentialBindingService) GetIncludeApp(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, *resource.App, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceCredentialBinding)
+	r1, _ := ret.Get(1).(*resource.App)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeServiceInstance provides a mock function for client.ServiceCredentialBindingService.GetIncludeServiceInstance
+func (m *ServiceCredentialBindingService) GetIncludeServiceInstance(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, *resource.ServiceInstance, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceCredentialBinding)
+	r1, _ := ret.Get(1).(*resource.ServiceInstance)
+	return r0, r1, ret.Error(2)
+}
+
+// GetParameters provides a mock function for client.ServiceCredentialBindingService.GetParameters
+func (m *ServiceCredentialBindingService) GetParameters(ctx context.Context, guid string) (map[string]string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(map[string]string)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.ServiceCredentialBindingService.Iter
+func (m *ServiceCredentialBindingService) Iter(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) client.Seq2[*resource.ServiceCredentialBinding, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.ServiceCredentialBinding, error])
+	return r0
+}
+
+// List provides a mock function for client.ServiceCredentialBindingService.List
+func (m *ServiceCredentialBindingService) List(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceCredentialBinding)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ServiceCredentialBindingService.ListAll
+func (m *ServiceCredentialBindingService) ListAll(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceCredentialBinding)
+	return r0, ret.Error(1)
+}
+
+// ListIncludeApps provides a mock function for client.ServiceCredentialBindingService.ListIncludeApps
+func (m *ServiceCredentialBindingService) ListIncludeApps(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.App, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceCredentialBinding)
+	r1, _ := ret.Get(1).([]*resource.App)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeAppsAll provides a mock function for client.ServiceCredentialBindingService.ListIncludeAppsAll
+func (m *ServiceCredentialBindingService) ListIncludeAppsAll(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.App, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceCredentialBinding)
+	r1, _ := ret.Get(1).([]*resource.App)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeServiceInstances provides a mock function for client.ServiceCredentialBindingService.ListIncludeServiceInstances
+func (m *ServiceCredentialBindingService) ListIncludeServiceInstances(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.ServiceInstance, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceCredentialBinding)
+	r1, _ := ret.Get(1).([]*resource.ServiceInstance)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeServiceInstancesAll provides a mock function for client.ServiceCredentialBindingService.ListIncludeServiceInstancesAll
+func (m *ServiceCredentialBindingService) ListIncludeServiceInstancesAll(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceCredentialBinding)
+	r1, _ := ret.Get(1).([]*resource.ServiceInstance)
+	return r0, r1, ret.Error(2)
+}
+
+// Single provides a mock function for client.ServiceCredentialBindingService.Single
+func (m *ServiceCredentialBindingService) Single(ctx context.Context, opts *client.ServiceCredentialBindingListOptions) (*resource.ServiceCredentialBinding, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceCredentialBinding)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.ServiceCredentialBindingService.Update
+func (m *ServiceCredentialBindingService) Update(ctx context.Context, guid string, r *resource.ServiceCredentialBindingUpdate) (*resource.ServiceCredentialBinding, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.ServiceCredentialBinding)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,159 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServiceInstanceService is a mock implementation of client.ServiceInstanceService
+type ServiceInstanceService struct {
+	mock.Mock
+}
+
+var _ client.ServiceInstanceService = (*ServiceInstanceService)(nil)
+
+// CreateManaged provides a mock function for client.ServiceInstanceService.CreateManaged
+func (m *ServiceInstanceService) CreateManaged(ctx context.Context, r *resource.ServiceInstanceManagedCreate) (string, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// CreateUserProvided provides a mock function for client.ServiceInstanceService.CreateUserProvided
+func (m *ServiceInstanceService) CreateUserProvided(ctx context.Context, r *resource.ServiceInstanceUserProvidedCreate) (*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.ServiceInstance)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.ServiceInstanceService.Delete
+func (m *ServiceInstanceService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.ServiceInstanceService.First
+func (m *ServiceInstanceService) First(ctx context.Context, opts *client.ServiceInstanceListOptions) (*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceInstance)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ServiceInstanceService.Get
+func (m *ServiceInstanceService) Get(ctx context.Context, guid string) (*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceInstance)
+	return r0, ret.Error(1)
+}
+
+// GetManagedParameters provides a mock function for client.ServiceInstanceService.GetManagedParameters
+func (m *ServiceInstanceService) GetManagedParameters(ctx context.Context, guid string) (*json.RawMessage, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*json.RawMessage)
+	return r0, ret.Error(1)
+}
+
+// GetSharedSpaceRelationships provides a mock function for client.ServiceInstanceService.GetSharedSpaceRelationships
+func (m *ServiceInstanceService) GetSharedSpaceRelationships(ctx context.Context, guid string) (*resource.ServiceInstanceSharedSpaceRelationships, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceInstanceSharedSpaceRelationships)
+	return r0, ret.Error(1)
+}
+
+// GetSharedSpaceUsageSummary provides a mock function for client.ServiceInstanceService.GetSharedSpaceUsageSummary
+func (m *ServiceInstanceService) GetSharedSpaceUsageSummary(ctx context.Context, guid string) (*resource.ServiceInstanceUsageSummary, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceInstanceUsageSummary)
+	return r0, ret.Error(1)
+}
+
+// GetUserPermissions provides a mock function for client.ServiceInstanceService.GetUserPermissions
+func (m *ServiceInstanceService) GetUserPermissions(ctx context.Context, guid string) (*resource.ServiceInstanceUserPermissions, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceInstanceUserPermissions)
+	return r0, ret.Error(1)
+}
+
+// GetUserProvidedCredentials provides a mock function for client.ServiceInstanceService.GetUserProvidedCredentials
+func (m *ServiceInstanceService) GetUserProvidedCredentials(ctx context.Context, guid string) (*json.RawMessage, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*json.RawMessage)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.ServiceInstanceService.Iter
+func (m *ServiceInstanceService) Iter(ctx context.Context, opts *client.ServiceInstanceListOptions) client.Seq2[*resource.ServiceInstance, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.ServiceInstance, error])
+	return r0
+}
+
+// List provides a mock function for client.ServiceInstanceService.List
+func (m *ServiceInstanceService) List(ctx context.Context, opts *client.ServiceInstanceListOptions) ([]*resource.ServiceInstance, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceInstance)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ServiceInstanceService.ListAll
+func (m *ServiceInstanceService) ListAll(ctx context.Context, opts *client.ServiceInstanceListOptions) ([]*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceInstance)
+	return r0, ret.Error(1)
+}
+
+// ShareWithSpace provides a mock function for client.ServiceInstanceService.ShareWithSpace
+func (m *ServiceInstanceService) ShareWithSpace(ctx context.Context, guid string, spaceGUID string) (*resource.ServiceInstanceSharedSpaceRelationships, error) {
+	ret := m.Called(ctx, guid, spaceGUID)
+	r0, _ := ret.Get(0).(*resource.ServiceInstanceSharedSpaceRelationships)
+	return r0, ret.Error(1)
+}
+
+// ShareWithSpaces provides a mock function for client.ServiceInstanceService.ShareWithSpaces
+func (m *ServiceInstanceService) ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.ServiceInstanceSharedSpaceRelationships, error) {
+	ret := m.Called(ctx, guid, spaceGUIDs)
+	r0, _ := ret.Get(0).(*resource.ServiceInstanceSharedSpaceRelationships)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.ServiceInstanceService.Single
+func (m *ServiceInstanceService) Single(ctx context.Context, opts *client.ServiceInstanceListOptions) (*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceInstance)
+	return r0, ret.Error(1)
+}
+
+// UnShareWithSpace provides a mock function for client.ServiceInstanceService.UnShareWithSpace
+func (m *ServiceInstanceService) UnShareWithSpace(ctx context.Context, guid string, spaceGUID string) error {
+	ret := m.Called(ctx, guid, spaceGUID)
+	return ret.Error(0)
+}
+
+// UnShareWithSpaces provides a mock function for client.ServiceInstanceService.UnShareWithSpaces
+func (m *ServiceInstanceService) UnShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) error {
+	ret := m.Called(ctx, guid, spaceGUIDs)
+	return ret.Error(0)
+}
+
+// UpdateManaged provides a mock function for client.ServiceInstanceService.UpdateManaged
+func (m *ServiceInstanceService) UpdateManaged(ctx context.Context, guid string, r *resource.ServiceInstanceManagedUpdate) (string, *resource.ServiceInstance, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(*resource.ServiceInstance)
+	return r0, r1, ret.Error(2)
+}
+
+// UpdateUserProvided provides a mock function for client.ServiceInstanceService.UpdateUserProvided
+func (m *ServiceInstanceService) UpdateUserProvided(ctx context.Context, guid string, r *resource.ServiceInstanceUserProvidedUpdate) (*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.ServiceInstance)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,74 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServiceOfferingService is a mock implementation of client.ServiceOfferingService
+type ServiceOfferingService struct {
+	mock.Mock
+}
+
+var _ client.ServiceOfferingService = (*ServiceOfferingService)(nil)
+
+// Delete provides a mock function for client.ServiceOfferingService.Delete
+func (m *ServiceOfferingService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// First provides a mock function for client.ServiceOfferingService.First
+func (m *ServiceOfferingService) First(ctx context.Context, opts *client.ServiceOfferingListOptions) (*resource.ServiceOffering, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceOffering)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ServiceOfferingService.Get
+func (m *ServiceOfferingService) Get(ctx context.Context, guid string) (*resource.ServiceOffering, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceOffering)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.ServiceOfferingService.Iter
+func (m *ServiceOfferingService) Iter(ctx context.Context, opts *client.ServiceOfferingListOptions) client.Seq2[*resource.ServiceOffering, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.ServiceOffering, error])
+	return r0
+}
+
+// List provides a mock function for client.ServiceOfferingService.List
+func (m *ServiceOfferingService) List(ctx context.Context, opts *client.ServiceOfferingListOptions) ([]*resource.ServiceOffering, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceOffering)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ServiceOfferingService.ListAll
+func (m *ServiceOfferingService) ListAll(ctx context.Context, opts *client.ServiceOfferingListOptions) ([]*resource.ServiceOffering, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceOffering)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.ServiceOfferingService.Single
+func (m *ServiceOfferingService) Single(ctx context.Context, opts *client.ServiceOfferingListOptions) (*resource.ServiceOffering, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceOffering)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.ServiceOfferingService.Update
+func (m *ServiceOfferingService) Update(ctx context.Context, guid string, r *resource.ServiceOfferingUpdate) (*resource.ServiceOffering, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.ServiceOffering)
+	return r0, ret.Error(1)
+}
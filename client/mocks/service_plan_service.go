@@ -0,0 +1,127 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServicePlanService is a mock implementation of client.ServicePlanService
+type ServicePlanService struct {
+	mock.Mock
+}
+
+var _ client.ServicePlanService = (*ServicePlanService)(nil)
+
+// Delete provides a mock function for client.ServicePlanService.Delete
+func (m *ServicePlanService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// First provides a mock function for client.ServicePlanService.First
+func (m *ServicePlanService) First(ctx context.Context, opts *client.ServicePlanListOptions) (*resource.ServicePlan, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServicePlan)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ServicePlanService.Get
+func (m *ServicePlanService) Get(ctx context.Context, guid string) (*resource.ServicePlan, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServicePlan)
+	return r0, ret.Error(1)
+}
+
+// GetIncludeServicePlan provides a mock function for client.ServicePlanService.GetIncludeServicePlan
+func (m *ServicePlanService) GetIncludeServicePlan(ctx context.Context, guid string) (*resource.ServicePlan, *resource.ServiceOffering, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServicePlan)
+	r1, _ := ret.Get(1).(*resource.ServiceOffering)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeSpaceAndOrganization provides a mock function for client.ServicePlanService.GetIncludeSpaceAndOrganization
+func (m *ServicePlanService) GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.ServicePlan, *resource.Space, *resource.Organization, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServicePlan)
+	r1, _ := ret.Get(1).(*resource.Space)
+	r2, _ := ret.Get(2).(*resource.Organization)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// Iter provides a mock function for client.ServicePlanService.Iter
+func (m *ServicePlanService) Iter(ctx context.Context, opts *client.ServicePlanListOptions) client.Seq2[*resource.ServicePlan, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.ServicePlan, error])
+	return r0
+}
+
+// List provides a mock function for client.ServicePlanService.List
+func (m *ServicePlanService) List(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServicePlan)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ServicePlanService.ListAll
+func (m *ServicePlanService) ListAll(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServicePlan)
+	return r0, ret.Error(1)
+}
+
+// ListIncludeServiceOffering provides a mock function for client.ServicePlanService.ListIncludeServiceOffering
+func (m *ServicePlanService) ListIncludeServiceOffering(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.ServiceOffering, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServicePlan)
+	r1, _ := ret.Get(1).([]*resource.ServiceOffering)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeServiceOfferingAll provides a mock function for client.ServicePlanService.ListIncludeServiceOfferingAll
+func (m *ServicePlanService) ListIncludeServiceOfferingAll(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.ServiceOffering, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServicePlan)
+	r1, _ := ret.Get(1).([]*resource.ServiceOffering)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeSpacesAndOrganizations provides a mock function for client.ServicePlanService.ListIncludeSpacesAndOrganizations
+func (m *ServicePlanService) ListIncludeSpacesAndOrganizations(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.Space, []*resource.Organization, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServicePlan)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).([]*resource.Organization)
+	r3, _ := ret.Get(3).(*client.Pager)
+	return r0, r1, r2, r3, ret.Error(4)
+}
+
+// ListIncludeSpacesAndOrganizationsAll provides a mock function for client.ServicePlanService.ListIncludeSpacesAndOrganizationsAll
+func (m *ServicePlanService) ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.Space, []*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServicePlan)
+	r1, _ := ret.Get(1).([]*resource.Space)
+	r2, _ := ret.Get(2).([]*resource.Organization)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// Single provides a mock function for client.ServicePlanService.Single
+func (m *ServicePlanService) Single(ctx context.Context, opts *client.ServicePlanListOptions) (*resource.ServicePlan, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServicePlan)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.ServicePlanService.Update
+func (m *ServicePlanService) Update(ctx context.Context, guid string, r *resource.ServicePlanUpdate) (*resource.ServicePlan, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.ServicePlan)
+	return r0, ret.Error(1)
+}
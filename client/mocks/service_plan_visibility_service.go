@@ -0,0 +1,45 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServicePlanVisibilityService is a mock implementation of client.ServicePlanVisibilityService
+type ServicePlanVisibilityService struct {
+	mock.Mock
+}
+
+var _ client.ServicePlanVisibilityService = (*ServicePlanVisibilityService)(nil)
+
+// Apply provides a mock function for client.ServicePlanVisibilityService.Apply
+func (m *ServicePlanVisibilityService) Apply(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error) {
+	ret := m.Called(ctx, servicePlanGUID, r)
+	r0, _ := ret.Get(0).(*resource.ServicePlanVisibility)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.ServicePlanVisibilityService.Delete
+func (m *ServicePlanVisibilityService) Delete(ctx context.Context, servicePlanGUID string, organizationGUID string) error {
+	ret := m.Called(ctx, servicePlanGUID, organizationGUID)
+	return ret.Error(0)
+}
+
+// Get provides a mock function for client.ServicePlanVisibilityService.Get
+func (m *ServicePlanVisibilityService) Get(ctx context.Context, servicePlanGUID string) (*resource.ServicePlanVisibility, error) {
+	ret := m.Called(ctx, servicePlanGUID)
+	r0, _ := ret.Get(0).(*resource.ServicePlanVisibility)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.ServicePlanVisibilityService.Update
+func (m *ServicePlanVisibilityService) Update(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error) {
+	ret := m.Called(ctx, servicePlanGUID, r)
+	r0, _ := ret.Get(0).(*resource.ServicePlanVisibility)
+	return r0, ret.Error(1)
+}
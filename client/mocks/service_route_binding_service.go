@@ -0,0 +1,140 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServiceRouteBindingService is a mock implementation of client.ServiceRouteBindingService
+type ServiceRouteBindingService struct {
+	mock.Mock
+}
+
+var _ client.ServiceRouteBindingService = (*ServiceRouteBindingService)(nil)
+
+// Create provides a mock function for client.ServiceRouteBindingService.Create
+func (m *ServiceRouteBindingService) Create(ctx context.Context, r *resource.ServiceRouteBindingCreate) (string, *resource.ServiceRouteBinding, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(*resource.ServiceRouteBinding)
+	return r0, r1, ret.Error(2)
+}
+
+// Delete provides a mock function for client.ServiceRouteBindingService.Delete
+func (m *ServiceRouteBindingService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.ServiceRouteBindingService.First
+func (m *ServiceRouteBindingService) First(ctx context.Context, opts *client.ServiceRouteBindingListOptions) (*resource.ServiceRouteBinding, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceRouteBinding)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ServiceRouteBindingService.Get
+func (m *ServiceRouteBindingService) Get(ctx context.Context, guid string) (*resource.ServiceRouteBinding, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceRouteBinding)
+	return r0, ret.Error(1)
+}
+
+// GetIncludeRoute provides a mock function for client.ServiceRouteBindingService.GetIncludeRoute
+func (m *ServiceRouteBindingService) GetIncludeRoute(ctx context.Context, guid string) (*resource.ServiceRouteBinding, *resource.Route, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceRouteBinding)
+	r1, _ := ret.Get(1).(*resource.Route)
+	return r0, r1, ret.Error(2)
+}
+
+// GetIncludeServiceInstance provides a mock function for client.ServiceRouteBindingService.GetIncludeServiceInstance
+func (m *ServiceRouteBindingService) GetIncludeServiceInstance(ctx context.Context, guid string) (*resource.ServiceRouteBinding, *resource.ServiceInstance, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceRouteBinding)
+	r1, _ := ret.Get(1).(*resource.ServiceInstance)
+	return r0, r1, ret.Error(2)
+}
+
+// GetParameters provides a mock function for client.ServiceRouteBindingService.GetParameters
+func (m *ServiceRouteBindingService) GetParameters(ctx context.Context, guid string) (map[string]string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(map[string]string)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.ServiceRouteBindingService.Iter
+func (m *ServiceRouteBindingService) Iter(ctx context.Context, opts *client.ServiceRouteBindingListOptions) client.Seq2[*resource.ServiceRouteBinding, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.ServiceRouteBinding, error])
+	return r0
+}
+
+// List provides a mock function for client.ServiceRouteBindingService.List
+func (m *ServiceRouteBindingService) List(ctx context.Context, opts *client.ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceRouteBinding)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ServiceRouteBindingService.ListAll
+func (m *ServiceRouteBindingService) ListAll(ctx context.Context, opts *client.ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceRouteBinding)
+	return r0, ret.Error(1)
+}
+
+// ListIncludeRoutes provides a mock function for client.ServiceRouteBindingService.ListIncludeRoutes
+func (m *ServiceRouteBindingService) ListIncludeRoutes(ctx context.Context, opts *client.ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.Route, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceRouteBinding)
+	r1, _ := ret.Get(1).([]*resource.Route)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeRoutesAll provides a mock function for client.ServiceRouteBindingService.ListIncludeRoutesAll
+func (m *ServiceRouteBindingService) ListIncludeRoutesAll(ctx context.Context, opts *client.ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.Route, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceRouteBinding)
+	r1, _ := ret.Get(1).([]*resource.Route)
+	return r0, r1, ret.Error(2)
+}
+
+// ListIncludeServiceInstances provides a mock function for client.ServiceRouteBindingService.ListIncludeServiceInstances
+func (m *ServiceRouteBindingService) ListIncludeServiceInstances(ctx context.Context, opts *client.ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.ServiceInstance, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceRouteBinding)
+	r1, _ := ret.Get(1).([]*resource.ServiceInstance)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeServiceInstancesAll provides a mock function for client.ServiceRouteBindingService.ListIncludeServiceInstancesAll
+func (m *ServiceRouteBindingService) ListIncludeServiceInstancesAll(ctx context.Context, opts *client.ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.ServiceInstance, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceRouteBinding)
+	r1, _ := ret.Get(1).([]*resource.ServiceInstance)
+	return r0, r1, ret.Error(2)
+}
+
+// Single provides a mock function for client.ServiceRouteBindingService.Single
+func (m *ServiceRouteBindingService) Single(ctx context.Context, opts *client.ServiceRouteBindingListOptions) (*resource.ServiceRouteBinding, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceRouteBinding)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.ServiceRouteBindingService.Update
+func (m *ServiceRouteBindingService) Update(ctx context.Context, guid string, r *resource.ServiceRouteBindingUpdate) (*resource.ServiceRouteBinding, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.ServiceRouteBinding)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,67 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServiceUsageService is a mock implementation of client.ServiceUsageService
+type ServiceUsageService struct {
+	mock.Mock
+}
+
+var _ client.ServiceUsageService = (*ServiceUsageService)(nil)
+
+// First provides a mock function for client.ServiceUsageService.First
+func (m *ServiceUsageService) First(ctx context.Context, opts *client.ServiceUsageListOptions) (*resource.ServiceUsage, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceUsage)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.ServiceUsageService.Get
+func (m *ServiceUsageService) Get(ctx context.Context, guid string) (*resource.ServiceUsage, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.ServiceUsage)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.ServiceUsageService.Iter
+func (m *ServiceUsageService) Iter(ctx context.Context, opts *client.ServiceUsageListOptions) client.Seq2[*resource.ServiceUsage, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.ServiceUsage, error])
+	return r0
+}
+
+// List provides a mock function for client.ServiceUsageService.List
+func (m *ServiceUsageService) List(ctx context.Context, opts *client.ServiceUsageListOptions) ([]*resource.ServiceUsage, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceUsage)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.ServiceUsageService.ListAll
+func (m *ServiceUsageService) ListAll(ctx context.Context, opts *client.ServiceUsageListOptions) ([]*resource.ServiceUsage, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.ServiceUsage)
+	return r0, ret.Error(1)
+}
+
+// Purge provides a mock function for client.ServiceUsageService.Purge
+func (m *ServiceUsageService) Purge(ctx context.Context) error {
+	ret := m.Called(ctx)
+	return ret.Error(0)
+}
+
+// Single provides a mock function for client.ServiceUsageService.Single
+func (m *ServiceUsageService) Single(ctx context.Context, opts *client.ServiceUsageListOptions) (*resource.ServiceUsage, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.ServiceUsage)
+	return r0, ret.Error(1)
+}
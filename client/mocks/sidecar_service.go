@@ -0,0 +1,117 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// SidecarService is a mock implementation of client.SidecarService
+type SidecarService struct {
+	mock.Mock
+}
+
+var _ client.SidecarService = (*SidecarService)(nil)
+
+// Create provides a mock function for client.SidecarService.Create
+func (m *SidecarService) Create(ctx context.Context, appGUID string, r *resource.SidecarCreate) (*resource.Sidecar, error) {
+	ret := m.Called(ctx, appGUID, r)
+	r0, _ := ret.Get(0).(*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.SidecarService.Delete
+func (m *SidecarService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// FirstForApp provides a mock function for client.SidecarService.FirstForApp
+func (m *SidecarService) FirstForApp(ctx context.Context, appGUID string, opts *client.SidecarListOptions) (*resource.Sidecar, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// FirstForProcess provides a mock function for client.SidecarService.FirstForProcess
+func (m *SidecarService) FirstForProcess(ctx context.Context, processGUID string, opts *client.SidecarListOptions) (*resource.Sidecar, error) {
+	ret := m.Called(ctx, processGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.SidecarService.Get
+func (m *SidecarService) Get(ctx context.Context, guid string) (*resource.Sidecar, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// IterForApp provides a mock function for client.SidecarService.IterForApp
+func (m *SidecarService) IterForApp(ctx context.Context, appGUID string, opts *client.SidecarListOptions) client.Seq2[*resource.Sidecar, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Sidecar, error])
+	return r0
+}
+
+// IterForProcess provides a mock function for client.SidecarService.IterForProcess
+func (m *SidecarService) IterForProcess(ctx context.Context, processGUID string, opts *client.SidecarListOptions) client.Seq2[*resource.Sidecar, error] {
+	ret := m.Called(ctx, processGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Sidecar, error])
+	return r0
+}
+
+// ListForApp provides a mock function for client.SidecarService.ListForApp
+func (m *SidecarService) ListForApp(ctx context.Context, appGUID string, opts *client.SidecarListOptions) ([]*resource.Sidecar, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Sidecar)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.SidecarService.ListForAppAll
+func (m *SidecarService) ListForAppAll(ctx context.Context, appGUID string, opts *client.SidecarListOptions) ([]*resource.Sidecar, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// ListForProcess provides a mock function for client.SidecarService.ListForProcess
+func (m *SidecarService) ListForProcess(ctx context.Context, processGUID string, opts *client.SidecarListOptions) ([]*resource.Sidecar, *client.Pager, error) {
+	ret := m.Called(ctx, processGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Sidecar)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForProcessAll provides a mock function for client.SidecarService.ListForProcessAll
+func (m *SidecarService) ListForProcessAll(ctx context.Context, processGUID string, opts *client.SidecarListOptions) ([]*resource.Sidecar, error) {
+	ret := m.Called(ctx, processGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.SidecarService.SingleForApp
+func (m *SidecarService) SingleForApp(ctx context.Context, appGUID string, opts *client.SidecarListOptions) (*resource.Sidecar, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// SingleForProcess provides a mock function for client.SidecarService.SingleForProcess
+func (m *SidecarService) SingleForProcess(ctx context.Context, processGUID string, opts *client.SidecarListOptions) (*resource.Sidecar, error) {
+	ret := m.Called(ctx, processGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Sidecar)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.SidecarService.Update
+func (m *SidecarService) Update(ctx context.Context, guid string, r *resource.SidecarUpdate) (*resource.Sidecar, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Sidecar)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,30 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/stretchr/testify/mock"
+)
+
+// SpaceFeatureService is a mock implementation of client.SpaceFeatureService
+type SpaceFeatureService struct {
+	mock.Mock
+}
+
+var _ client.SpaceFeatureService = (*SpaceFeatureService)(nil)
+
+// EnableSSH provides a mock function for client.SpaceFeatureService.EnableSSH
+func (m *SpaceFeatureService) EnableSSH(ctx context.Context, spaceGUID string, enable bool) error {
+	ret := m.Called(ctx, spaceGUID, enable)
+	return ret.Error(0)
+}
+
+// IsSSHEnabled provides a mock function for client.SpaceFeatureService.IsSSHEnabled
+func (m *SpaceFeatureService) IsSSHEnabled(ctx context.Context, spaceGUID string) (bool, error) {
+	ret := m.Called(ctx, spaceGUID)
+	r0, _ := ret.Get(0).(bool)
+	return r0, ret.Error(1)
+}
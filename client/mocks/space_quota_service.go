@@ -0,0 +1,95 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// SpaceQuotaService is a mock implementation of client.SpaceQuotaService
+type SpaceQuotaService struct {
+	mock.Mock
+}
+
+var _ client.SpaceQuotaService = (*SpaceQuotaService)(nil)
+
+// Apply provides a mock function for client.SpaceQuotaService.Apply
+func (m *SpaceQuotaService) Apply(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
+	ret := m.Called(ctx, guid, spaceGUIDs)
+	r0, _ := ret.Get(0).([]string)
+	return r0, ret.Error(1)
+}
+
+// Create provides a mock function for client.SpaceQuotaService.Create
+func (m *SpaceQuotaService) Create(ctx context.Context, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.SpaceQuota)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.SpaceQuotaService.Delete
+func (m *SpaceQuotaService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.SpaceQuotaService.First
+func (m *SpaceQuotaService) First(ctx context.Context, opts *client.SpaceQuotaListOptions) (*resource.SpaceQuota, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.SpaceQuota)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.SpaceQuotaService.Get
+func (m *SpaceQuotaService) Get(ctx context.Context, guid string) (*resource.SpaceQuota, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.SpaceQuota)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.SpaceQuotaService.Iter
+func (m *SpaceQuotaService) Iter(ctx context.Context, opts *client.SpaceQuotaListOptions) client.Seq2[*resource.SpaceQuota, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.SpaceQuota, error])
+	return r0
+}
+
+// List provides a mock function for client.SpaceQuotaService.List
+func (m *SpaceQuotaService) List(ctx context.Context, opts *client.SpaceQuotaListOptions) ([]*resource.SpaceQuota, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.SpaceQuota)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.SpaceQuotaService.ListAll
+func (m *SpaceQuotaService) ListAll(ctx context.Context, opts *client.SpaceQuotaListOptions) ([]*resource.SpaceQuota, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.SpaceQuota)
+	return r0, ret.Error(1)
+}
+
+// Remove provides a mock function for client.SpaceQuotaService.Remove
+func (m *SpaceQuotaService) Remove(ctx context.Context, guid string, spaceGUID string) error {
+	ret := m.Called(ctx, guid, spaceGUID)
+	return ret.Error(0)
+}
+
+// Single provides a mock function for client.SpaceQuotaService.Single
+func (m *SpaceQuotaService) Single(ctx context.Context, opts *client.SpaceQuotaListOptions) (*resource.SpaceQuota, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.SpaceQuota)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.SpaceQuotaService.Update
+func (m *SpaceQuotaService) Update(ctx context.Context, guid string, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.SpaceQuota)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,142 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// SpaceService is a mock implementation of client.SpaceService
+type SpaceService struct {
+	mock.Mock
+}
+
+var _ client.SpaceService = (*SpaceService)(nil)
+
+// AssignIsolationSegment provides a mock function for client.SpaceService.AssignIsolationSegment
+func (m *SpaceService) AssignIsolationSegment(ctx context.Context, guid string, isolationSegmentGUID string) error {
+	ret := m.Called(ctx, guid, isolationSegmentGUID)
+	return ret.Error(0)
+}
+
+// Create provides a mock function for client.SpaceService.Create
+func (m *SpaceService) Create(ctx context.Context, r *resource.SpaceCreate) (*resource.Space, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Space)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.SpaceService.Delete
+func (m *SpaceService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.SpaceService.First
+func (m *SpaceService) First(ctx context.Context, opts *client.SpaceListOptions) (*resource.Space, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Space)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.SpaceService.Get
+func (m *SpaceService) Get(ctx context.Context, guid string) (*resource.Space, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Space)
+	return r0, ret.Error(1)
+}
+
+// GetAssignedIsolationSegment provides a mock function for client.SpaceService.GetAssignedIsolationSegment
+func (m *SpaceService) GetAssignedIsolationSegment(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// GetIncludeOrganization provides a mock function for client.SpaceService.GetIncludeOrganization
+func (m *SpaceService) GetIncludeOrganization(ctx context.Context, guid string) (*resource.Space, *resource.Organization, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Space)
+	r1, _ := ret.Get(1).(*resource.Organization)
+	return r0, r1, ret.Error(2)
+}
+
+// Iter provides a mock function for client.SpaceService.Iter
+func (m *SpaceService) Iter(ctx context.Context, opts *client.SpaceListOptions) client.Seq2[*resource.Space, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Space, error])
+	return r0
+}
+
+// IterUsers provides a mock function for client.SpaceService.IterUsers
+func (m *SpaceService) IterUsers(ctx context.Context, spaceGUID string, opts *client.UserListOptions) client.Seq2[*resource.User, error] {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.User, error])
+	return r0
+}
+
+// List provides a mock function for client.SpaceService.List
+func (m *SpaceService) List(ctx context.Context, opts *client.SpaceListOptions) ([]*resource.Space, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Space)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.SpaceService.ListAll
+func (m *SpaceService) ListAll(ctx context.Context, opts *client.SpaceListOptions) ([]*resource.Space, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Space)
+	return r0, ret.Error(1)
+}
+
+// ListIncludeOrganizations provides a mock function for client.SpaceService.ListIncludeOrganizations
+func (m *SpaceService) ListIncludeOrganizations(ctx context.Context, opts *client.SpaceListOptions) ([]*resource.Space, []*resource.Organization, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Space)
+	r1, _ := ret.Get(1).([]*resource.Organization)
+	r2, _ := ret.Get(2).(*client.Pager)
+	return r0, r1, r2, ret.Error(3)
+}
+
+// ListIncludeOrganizationsAll provides a mock function for client.SpaceService.ListIncludeOrganizationsAll
+func (m *SpaceService) ListIncludeOrganizationsAll(ctx context.Context, opts *client.SpaceListOptions) ([]*resource.Space, []*resource.Organization, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Space)
+	r1, _ := ret.Get(1).([]*resource.Organization)
+	return r0, r1, ret.Error(2)
+}
+
+// ListUsers provides a mock function for client.SpaceService.ListUsers
+func (m *SpaceService) ListUsers(ctx context.Context, spaceGUID string, opts *client.UserListOptions) ([]*resource.User, *client.Pager, error) {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.User)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListUsersAll provides a mock function for client.SpaceService.ListUsersAll
+func (m *SpaceService) ListUsersAll(ctx context.Context, spaceGUID string, opts *client.UserListOptions) ([]*resource.User, error) {
+	ret := m.Called(ctx, spaceGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.User)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.SpaceService.Single
+func (m *SpaceService) Single(ctx context.Context, opts *client.SpaceListOptions) (*resource.Space, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Space)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.SpaceService.Update
+func (m *SpaceService) Update(ctx context.Context, guid string, r *resource.SpaceUpdate) (*resource.Space, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Space)
+	return r0, ret.Error(1)
+}
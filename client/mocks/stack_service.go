@@ -0,0 +1,103 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// StackService is a mock implementation of client.StackService
+type StackService struct {
+	mock.Mock
+}
+
+var _ client.StackService = (*StackService)(nil)
+
+// Create provides a mock function for client.StackService.Create
+func (m *StackService) Create(ctx context.Context, r *resource.StackCreate) (*resource.Stack, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.Stack)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.StackService.Delete
+func (m *StackService) Delete(ctx context.Context, guid string) error {
+	ret := m.Called(ctx, guid)
+	return ret.Error(0)
+}
+
+// First provides a mock function for client.StackService.First
+func (m *StackService) First(ctx context.Context, opts *client.StackListOptions) (*resource.Stack, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Stack)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.StackService.Get
+func (m *StackService) Get(ctx context.Context, guid string) (*resource.Stack, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Stack)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.StackService.Iter
+func (m *StackService) Iter(ctx context.Context, opts *client.StackListOptions) client.Seq2[*resource.Stack, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Stack, error])
+	return r0
+}
+
+// IterAppsOnStack provides a mock function for client.StackService.IterAppsOnStack
+func (m *StackService) IterAppsOnStack(ctx context.Context, guid string, opts *client.StackListOptions) client.Seq2[*resource.App, error] {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.App, error])
+	return r0
+}
+
+// List provides a mock function for client.StackService.List
+func (m *StackService) List(ctx context.Context, opts *client.StackListOptions) ([]*resource.Stack, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Stack)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.StackService.ListAll
+func (m *StackService) ListAll(ctx context.Context, opts *client.StackListOptions) ([]*resource.Stack, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Stack)
+	return r0, ret.Error(1)
+}
+
+// ListAppsOnStack provides a mock function for client.StackService.ListAppsOnStack
+func (m *StackService) ListAppsOnStack(ctx context.Context, guid string, opts *client.StackListOptions) ([]*resource.App, *client.Pager, error) {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAppsOnStackAll provides a mock function for client.StackService.ListAppsOnStackAll
+func (m *StackService) ListAppsOnStackAll(ctx context.Context, guid string, opts *client.StackListOptions) ([]*resource.App, error) {
+	ret := m.Called(ctx, guid, opts)
+	r0, _ := ret.Get(0).([]*resource.App)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.StackService.Single
+func (m *StackService) Single(ctx context.Context, opts *client.StackListOptions) (*resource.Stack, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Stack)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.StackService.Update
+func (m *StackService) Update(ctx context.Context, guid string, r *resource.StackUpdate) (*resource.Stack, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Stack)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,118 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// TaskService is a mock implementation of client.TaskService
+type TaskService struct {
+	mock.Mock
+}
+
+var _ client.TaskService = (*TaskService)(nil)
+
+// Cancel provides a mock function for client.TaskService.Cancel
+func (m *TaskService) Cancel(ctx context.Context, guid string) (*resource.Task, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// Create provides a mock function for client.TaskService.Create
+func (m *TaskService) Create(ctx context.Context, appGUID string, r *resource.TaskCreate) (*resource.Task, error) {
+	ret := m.Called(ctx, appGUID, r)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.TaskService.First
+func (m *TaskService) First(ctx context.Context, opts *client.TaskListOptions) (*resource.Task, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// FirstForApp provides a mock function for client.TaskService.FirstForApp
+func (m *TaskService) FirstForApp(ctx context.Context, appGUID string, opts *client.TaskListOptions) (*resource.Task, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.TaskService.Get
+func (m *TaskService) Get(ctx context.Context, guid string) (*resource.Task, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.TaskService.Iter
+func (m *TaskService) Iter(ctx context.Context, opts *client.TaskListOptions) client.Seq2[*resource.Task, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Task, error])
+	return r0
+}
+
+// IterForApp provides a mock function for client.TaskService.IterForApp
+func (m *TaskService) IterForApp(ctx context.Context, appGUID string, opts *client.TaskListOptions) client.Seq2[*resource.Task, error] {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.Task, error])
+	return r0
+}
+
+// List provides a mock function for client.TaskService.List
+func (m *TaskService) List(ctx context.Context, opts *client.TaskListOptions) ([]*resource.Task, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Task)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.TaskService.ListAll
+func (m *TaskService) ListAll(ctx context.Context, opts *client.TaskListOptions) ([]*resource.Task, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// ListForApp provides a mock function for client.TaskService.ListForApp
+func (m *TaskService) ListForApp(ctx context.Context, appGUID string, opts *client.TaskListOptions) ([]*resource.Task, *client.Pager, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Task)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListForAppAll provides a mock function for client.TaskService.ListForAppAll
+func (m *TaskService) ListForAppAll(ctx context.Context, appGUID string, opts *client.TaskListOptions) ([]*resource.Task, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).([]*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.TaskService.Single
+func (m *TaskService) Single(ctx context.Context, opts *client.TaskListOptions) (*resource.Task, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// SingleForApp provides a mock function for client.TaskService.SingleForApp
+func (m *TaskService) SingleForApp(ctx context.Context, appGUID string, opts *client.TaskListOptions) (*resource.Task, error) {
+	ret := m.Called(ctx, appGUID, opts)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.TaskService.Update
+func (m *TaskService) Update(ctx context.Context, guid string, r *resource.TaskUpdate) (*resource.Task, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.Task)
+	return r0, ret.Error(1)
+}
@@ -0,0 +1,82 @@
+// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// UserService is a mock implementation of client.UserService
+type UserService struct {
+	mock.Mock
+}
+
+var _ client.UserService = (*UserService)(nil)
+
+// Create provides a mock function for client.UserService.Create
+func (m *UserService) Create(ctx context.Context, r *resource.UserCreate) (*resource.User, error) {
+	ret := m.Called(ctx, r)
+	r0, _ := ret.Get(0).(*resource.User)
+	return r0, ret.Error(1)
+}
+
+// Delete provides a mock function for client.UserService.Delete
+func (m *UserService) Delete(ctx context.Context, guid string) (string, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(string)
+	return r0, ret.Error(1)
+}
+
+// First provides a mock function for client.UserService.First
+func (m *UserService) First(ctx context.Context, opts *client.UserListOptions) (*resource.User, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.User)
+	return r0, ret.Error(1)
+}
+
+// Get provides a mock function for client.UserService.Get
+func (m *UserService) Get(ctx context.Context, guid string) (*resource.User, error) {
+	ret := m.Called(ctx, guid)
+	r0, _ := ret.Get(0).(*resource.User)
+	return r0, ret.Error(1)
+}
+
+// Iter provides a mock function for client.UserService.Iter
+func (m *UserService) Iter(ctx context.Context, opts *client.UserListOptions) client.Seq2[*resource.User, error] {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(client.Seq2[*resource.User, error])
+	return r0
+}
+
+// List provides a mock function for client.UserService.List
+func (m *UserService) List(ctx context.Context, opts *client.UserListOptions) ([]*resource.User, *client.Pager, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.User)
+	r1, _ := ret.Get(1).(*client.Pager)
+	return r0, r1, ret.Error(2)
+}
+
+// ListAll provides a mock function for client.UserService.ListAll
+func (m *UserService) ListAll(ctx context.Context, opts *client.UserListOptions) ([]*resource.User, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).([]*resource.User)
+	return r0, ret.Error(1)
+}
+
+// Single provides a mock function for client.UserService.Single
+func (m *UserService) Single(ctx context.Context, opts *client.UserListOptions) (*resource.User, error) {
+	ret := m.Called(ctx, opts)
+	r0, _ := ret.Get(0).(*resource.User)
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function for client.UserService.Update
+func (m *UserService) Update(ctx context.Context, guid string, r *resource.UserUpdate) (*resource.User, error) {
+	ret := m.Called(ctx, guid, r)
+	r0, _ := ret.Get(0).(*resource.User)
+	return r0, ret.Error(1)
+}
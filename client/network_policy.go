@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// ErrNoNetworkPolicy is returned when the API root doesn't link to a network policy server
+var ErrNoNetworkPolicy = errors.New("the Cloud Foundry API root doesn't link to a network policy server")
+
+// NetworkPolicyClient manages container to container networking policies using the policy server
+type NetworkPolicyClient commonClient
+
+// Create the policies, creating a policy that already exists isn't an error
+func (c *NetworkPolicyClient) Create(ctx context.Context, policies ...*resource.NetworkPolicy) error {
+	return c.policies(ctx, "NetworkPolicies.Create", http.MethodPost, "/policies", &resource.NetworkPolicies{Policies: policies}, nil)
+}
+
+// CreateForAppNames creates a policy between apps in the space, identified by name, allowing the source app to
+// reach the destination app on the inclusive port range
+func (c *NetworkPolicyClient) CreateForAppNames(ctx context.Context, spaceGUID, sourceAppName, destinationAppName string,
+	protocol resource.NetworkPolicyProtocol, startPort, endPort int) (*resource.NetworkPolicy, error) {
+	policy, err := c.newForAppNames(ctx, spaceGUID, sourceAppName, destinationAppName, protocol, startPort, endPort)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.Create(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Delete the policies, deleting a policy that doesn't exist isn't an error
+func (c *NetworkPolicyClient) Delete(ctx context.Context, policies ...*resource.NetworkPolicy) error {
+	return c.policies(ctx, "NetworkPolicies.Delete", http.MethodPost, "/policies/delete", &resource.NetworkPolicies{Policies: policies}, nil)
+}
+
+// DeleteForAppNames deletes the policy between apps in the space, identified by name, for the inclusive port range
+func (c *NetworkPolicyClient) DeleteForAppNames(ctx context.Context, spaceGUID, sourceAppName, destinationAppName string,
+	protocol resource.NetworkPolicyProtocol, startPort, endPort int) error {
+	policy, err := c.newForAppNames(ctx, spaceGUID, sourceAppName, destinationAppName, protocol, startPort, endPort)
+	if err != nil {
+		return err
+	}
+	return c.Delete(ctx, policy)
+}
+
+// List the policies the user can see, if app GUIDs are specified only the policies where one of the apps is the
+// source or destination are returned
+func (c *NetworkPolicyClient) List(ctx context.Context, appGUIDs ...string) ([]*resource.NetworkPolicy, error) {
+	resourcePath := "/policies"
+	if len(appGUIDs) > 0 {
+		values := url.Values{}
+		values.Set("id", strings.Join(appGUIDs, ","))
+		resourcePath = path.Format("/policies?%s", values)
+	}
+	var list resource.NetworkPolicyList
+	if err := c.policies(ctx, "NetworkPolicies.List", http.MethodGet, resourcePath, nil, &list); err != nil {
+		return nil, err
+	}
+	return list.Policies, nil
+}
+
+// ListForAppNames lists the policies where one of the apps in the space, identified by name, is the source
+// or destination
+func (c *NetworkPolicyClient) ListForAppNames(ctx context.Context, spaceGUID string, appNames ...string) ([]*resource.NetworkPolicy, error) {
+	guids, err := c.AppGUIDs(ctx, spaceGUID, appNames...)
+	if err != nil {
+		return nil, err
+	}
+	appGUIDs := make([]string, 0, len(appNames))
+	for _, name := range appNames {
+		appGUIDs = append(appGUIDs, guids[name])
+	}
+	return c.List(ctx, appGUIDs...)
+}
+
+// AppGUIDs resolves the names of apps in the space to their GUIDs keyed by app name, an error is returned if
+// any of the apps don't exist
+func (c *NetworkPolicyClient) AppGUIDs(ctx context.Context, spaceGUID string, appNames ...string) (map[string]string, error) {
+	if len(appNames) == 0 {
+		return nil, errors.New("expected at least one app name")
+	}
+	opts := NewAppListOptions()
+	opts.SpaceGUIDs.EqualTo(spaceGUID)
+	opts.Names.EqualTo(appNames...)
+	apps, err := c.client.Applications.ListAll(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	guids := make(map[string]string, len(apps))
+	for _, app := range apps {
+		guids[app.Name] = app.GUID
+	}
+	for _, name := range appNames {
+		if _, ok := guids[name]; !ok {
+			return nil, fmt.Errorf("app %s not found in space %s", name, spaceGUID)
+		}
+	}
+	return guids, nil
+}
+
+func (c *NetworkPolicyClient) newForAppNames(ctx context.Context, spaceGUID, sourceAppName, destinationAppName string,
+	protocol resource.NetworkPolicyProtocol, startPort, endPort int) (*resource.NetworkPolicy, error) {
+	guids, err := c.AppGUIDs(ctx, spaceGUID, sourceAppName, destinationAppName)
+	if err != nil {
+		return nil, err
+	}
+	return resource.NewNetworkPolicy(guids[sourceAppName], guids[destinationAppName], protocol, startPort, endPort), nil
+}
+
+func (c *NetworkPolicyClient) policies(ctx context.Context, operation, method, resourcePath string, params, result any) error {
+	links, err := c.client.links(ctx)
+	if err != nil {
+		return err
+	}
+	if links.NetworkPolicyV1.Href == "" {
+		return ErrNoNetworkPolicy
+	}
+	return c.client.external(ctx, operation, method, links.NetworkPolicyV1.Href, resourcePath, params, result)
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestNetworkPolicies(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(11)
+	spaceGUID := testutil.RandomGUID()
+	frontend := g.Application()
+	backend := g.Application()
+	other := testutil.RandomGUID()
+
+	existing := resource.NewNetworkPolicy(other, backend.GUID, resource.NetworkPolicyProtocolUDP, 53, 53)
+	policyServer := testutil.SetupFakePolicyServer(existing)
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:      "GET",
+			Endpoint:    "/v3/apps",
+			Output:      g.Paged([]string{frontend.JSON, backend.JSON}),
+			Status:      http.StatusOK,
+			QueryString: "names=" + frontend.Name + "," + backend.Name + "&page=1&per_page=50&space_guids=" + spaceGUID,
+		},
+	}, t)
+	defer testutil.Teardown()
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	require.NoError(t, err)
+	c, err := New(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	policy, err := c.NetworkPolicies.CreateForAppNames(ctx, spaceGUID, frontend.Name, backend.Name,
+		resource.NetworkPolicyProtocolTCP, 8080, 8090)
+	require.NoError(t, err)
+	require.Equal(t, resource.NewNetworkPolicy(frontend.GUID, backend.GUID, resource.NetworkPolicyProtocolTCP, 8080, 8090), policy)
+	require.Equal(t, []*resource.NetworkPolicy{existing, policy}, policyServer.Policies())
+
+	policies, err := c.NetworkPolicies.List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*resource.NetworkPolicy{existing, policy}, policies)
+
+	policies, err = c.NetworkPolicies.List(ctx, frontend.GUID)
+	require.NoError(t, err)
+	require.Equal(t, []*resource.NetworkPolicy{policy}, policies)
+
+	policies, err = c.NetworkPolicies.List(ctx, other, testutil.RandomGUID())
+	require.NoError(t, err)
+	require.Equal(t, []*resource.NetworkPolicy{existing}, policies)
+
+	err = c.NetworkPolicies.Create(ctx, resource.NewNetworkPolicy(frontend.GUID, backend.GUID, "icmp", 1, 1))
+	var httpErr resource.CloudFoundryHTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+	require.Contains(t, string(httpErr.Body), "invalid destination protocol")
+
+	err = c.NetworkPolicies.Delete(ctx, policy)
+	require.NoError(t, err)
+	require.Equal(t, []*resource.NetworkPolicy{existing}, policyServer.Policies())
+}
+
+func TestNetworkPolicyAppGUIDs(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(11)
+	spaceGUID := testutil.RandomGUID()
+	frontend := g.Application()
+
+	serverURL := testutil.Setup(testutil.MockRoute{
+		Method:      "GET",
+		Endpoint:    "/v3/apps",
+		Output:      g.Paged([]string{frontend.JSON}),
+		Status:      http.StatusOK,
+		QueryString: "names=" + frontend.Name + ",missing&page=1&per_page=50&space_guids=" + spaceGUID,
+	}, t)
+	defer testutil.Teardown()
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	require.NoError(t, err)
+	c, err := New(cfg)
+	require.NoError(t, err)
+
+	err = c.NetworkPolicies.DeleteForAppNames(context.Background(), spaceGUID, frontend.Name, "missing",
+		resource.NetworkPolicyProtocolTCP, 8080, 8080)
+	require.EqualError(t, err, "app missing not found in space "+spaceGUID)
+}
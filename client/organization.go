@@ -41,14 +41,14 @@ func (c *OrganizationClient) AssignDefaultIsolationSegment(ctx context.Context,
 	if isolationSegmentGUID == "" {
 		r.Data.GUID = nil // set data to null to remove the relationship
 	}
-	_, err := c.client.patch(ctx, path.Format("/v3/organizations/%s/relationships/default_isolation_segment", guid), r, nil)
+	_, err := c.client.patch(ctx, "Organizations.AssignDefaultIsolationSegment", path.Format("/v3/organizations/%s/relationships/default_isolation_segment", guid), r, nil)
 	return err
 }
 
 // Create an organization
 func (c *OrganizationClient) Create(ctx context.Context, r *resource.OrganizationCreate) (*resource.Organization, error) {
 	var org resource.Organization
-	_, err := c.client.post(ctx, "/v3/organizations", r, &org)
+	_, err := c.client.post(ctx, "Organizations.Create", "/v3/organizations", r, &org)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func (c *OrganizationClient) Create(ctx context.Context, r *resource.Organizatio
 
 // Delete the specified organization asynchronously and return a jobGUID
 func (c *OrganizationClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/organizations/%s", guid))
+	return c.client.delete(ctx, "Organizations.Delete", path.Format("/v3/organizations/%s", guid))
 }
 
 // First returns the first organization matching the options or an error when less than 1 match
@@ -77,7 +77,7 @@ func (c *OrganizationClient) FirstForIsolationSegment(ctx context.Context, isola
 // Get the specified organization
 func (c *OrganizationClient) Get(ctx context.Context, guid string) (*resource.Organization, error) {
 	var org resource.Organization
-	err := c.client.get(ctx, path.Format("/v3/organizations/%s", guid), &org)
+	err := c.client.get(ctx, "Organizations.Get", path.Format("/v3/organizations/%s", guid), &org)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +87,7 @@ func (c *OrganizationClient) Get(ctx context.Context, guid string) (*resource.Or
 // GetDefaultIsolationSegment gets the specified organization's default iso segment GUID if any
 func (c *OrganizationClient) GetDefaultIsolationSegment(ctx context.Context, guid string) (string, error) {
 	var relation resource.ToOneRelationship
-	err := c.client.get(ctx, path.Format("/v3/organizations/%s/relationships/default_isolation_segment", guid), &relation)
+	err := c.client.get(ctx, "Organizations.GetDefaultIsolationSegment", path.Format("/v3/organizations/%s/relationships/default_isolation_segment", guid), &relation)
 	if err != nil {
 		return "", err
 	}
@@ -100,7 +100,7 @@ func (c *OrganizationClient) GetDefaultIsolationSegment(ctx context.Context, gui
 // GetDefaultDomain gets the specified organization's default domain if any
 func (c *OrganizationClient) GetDefaultDomain(ctx context.Context, guid string) (*resource.Domain, error) {
 	var domain resource.Domain
-	err := c.client.get(ctx, path.Format("/v3/organizations/%s/domains/default", guid), &domain)
+	err := c.client.get(ctx, "Organizations.GetDefaultDomain", path.Format("/v3/organizations/%s/domains/default", guid), &domain)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +110,7 @@ func (c *OrganizationClient) GetDefaultDomain(ctx context.Context, guid string)
 // GetUsageSummary gets the specified organization's usage summary
 func (c *OrganizationClient) GetUsageSummary(ctx context.Context, guid string) (*resource.OrganizationUsageSummary, error) {
 	var summary resource.OrganizationUsageSummary
-	err := c.client.get(ctx, path.Format("/v3/organizations/%s/usage_summary", guid), &summary)
+	err := c.client.get(ctx, "Organizations.GetUsageSummary", path.Format("/v3/organizations/%s/usage_summary", guid), &summary)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +123,7 @@ func (c *OrganizationClient) List(ctx context.Context, opts *OrganizationListOpt
 		opts = NewOrganizationListOptions()
 	}
 	var res resource.OrganizationList
-	err := c.client.list(ctx, "/v3/organizations", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Organizations.List", "/v3/organizations", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -136,7 +136,17 @@ func (c *OrganizationClient) ListAll(ctx context.Context, opts *OrganizationList
 	if opts == nil {
 		opts = NewOrganizationListOptions()
 	}
-	return AutoPage[*OrganizationListOptions, *resource.Organization](opts, func(opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error) {
+	return AutoPageConcurrent[*OrganizationListOptions, *resource.Organization](opts, c.client.PageConcurrency(), func(opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all organizations the user has access to
+func (c *OrganizationClient) Iter(ctx context.Context, opts *OrganizationListOptions) Seq2[*resource.Organization, error] {
+	if opts == nil {
+		opts = NewOrganizationListOptions()
+	}
+	return AutoPageIter[*OrganizationListOptions, *resource.Organization](ctx, opts, func(opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -147,7 +157,7 @@ func (c *OrganizationClient) ListForIsolationSegment(ctx context.Context, isolat
 		opts = NewOrganizationListOptions()
 	}
 	var res resource.OrganizationList
-	err := c.client.list(ctx, "/v3/isolation_segments/"+isolationSegmentGUID+"/organizations", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Organizations.ListForIsolationSegment", "/v3/isolation_segments/"+isolationSegmentGUID+"/organizations", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -160,7 +170,17 @@ func (c *OrganizationClient) ListForIsolationSegmentAll(ctx context.Context, iso
 	if opts == nil {
 		opts = NewOrganizationListOptions()
 	}
-	return AutoPage[*OrganizationListOptions, *resource.Organization](opts, func(opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error) {
+	return AutoPageConcurrent[*OrganizationListOptions, *resource.Organization](opts, c.client.PageConcurrency(), func(opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error) {
+		return c.ListForIsolationSegment(ctx, isolationSegmentGUID, opts)
+	})
+}
+
+// IterForIsolationSegment returns an iterator over all organizations for the specified isolation segment
+func (c *OrganizationClient) IterForIsolationSegment(ctx context.Context, isolationSegmentGUID string, opts *OrganizationListOptions) Seq2[*resource.Organization, error] {
+	if opts == nil {
+		opts = NewOrganizationListOptions()
+	}
+	return AutoPageIter[*OrganizationListOptions, *resource.Organization](ctx, opts, func(opts *OrganizationListOptions) ([]*resource.Organization, *Pager, error) {
 		return c.ListForIsolationSegment(ctx, isolationSegmentGUID, opts)
 	})
 }
@@ -171,7 +191,7 @@ func (c *OrganizationClient) ListUsers(ctx context.Context, guid string, opts *U
 		opts = NewUserListOptions()
 	}
 	var res resource.UserList
-	err := c.client.list(ctx, "/v3/organizations/"+guid+"/users", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Organizations.ListUsers", "/v3/organizations/"+guid+"/users", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -184,7 +204,17 @@ func (c *OrganizationClient) ListUsersAll(ctx context.Context, guid string, opts
 	if opts == nil {
 		opts = NewUserListOptions()
 	}
-	return AutoPage[*UserListOptions, *resource.User](opts, func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
+	return AutoPageConcurrent[*UserListOptions, *resource.User](opts, c.client.PageConcurrency(), func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
+		return c.ListUsers(ctx, guid, opts)
+	})
+}
+
+// IterUsers returns an iterator over all users that are members of the specified organization
+func (c *OrganizationClient) IterUsers(ctx context.Context, guid string, opts *UserListOptions) Seq2[*resource.User, error] {
+	if opts == nil {
+		opts = NewUserListOptions()
+	}
+	return AutoPageIter[*UserListOptions, *resource.User](ctx, opts, func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
 		return c.ListUsers(ctx, guid, opts)
 	})
 }
@@ -206,7 +236,7 @@ func (c *OrganizationClient) SingleForIsolationSegment(ctx context.Context, isol
 // Update the organization's specified attributes
 func (c *OrganizationClient) Update(ctx context.Context, guid string, r *resource.OrganizationUpdate) (*resource.Organization, error) {
 	var org resource.Organization
-	_, err := c.client.patch(ctx, path.Format("/v3/organizations/%s", guid), r, &org)
+	_, err := c.client.patch(ctx, "Organizations.Update", path.Format("/v3/organizations/%s", guid), r, &org)
 	if err != nil {
 		return nil, err
 	}
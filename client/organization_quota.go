@@ -35,7 +35,7 @@ func (o OrganizationQuotaListOptions) ToQueryString() (url.Values, error) {
 func (c *OrganizationQuotaClient) Apply(ctx context.Context, guid string, organizationGUIDs []string) ([]string, error) {
 	req := resource.NewToManyRelationships(organizationGUIDs)
 	var relation resource.ToManyRelationships
-	_, err := c.client.post(ctx, path.Format("/v3/organization_quotas/%s/relationships/organizations", guid), req, &relation)
+	_, err := c.client.post(ctx, "OrganizationQuotas.Apply", path.Format("/v3/organization_quotas/%s/relationships/organizations", guid), req, &relation)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ func (c *OrganizationQuotaClient) Apply(ctx context.Context, guid string, organi
 // Create a new organization quota
 func (c *OrganizationQuotaClient) Create(ctx context.Context, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error) {
 	var q resource.OrganizationQuota
-	_, err := c.client.post(ctx, "/v3/organization_quotas", r, &q)
+	_, err := c.client.post(ctx, "OrganizationQuotas.Create", "/v3/organization_quotas", r, &q)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +58,7 @@ func (c *OrganizationQuotaClient) Create(ctx context.Context, r *resource.Organi
 
 // Delete the specified organization quota
 func (c *OrganizationQuotaClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/organization_quotas/%s", guid))
+	return c.client.delete(ctx, "OrganizationQuotas.Delete", path.Format("/v3/organization_quotas/%s", guid))
 }
 
 // First returns the first organization quota matching the options or an error when less than 1 match
@@ -71,7 +71,7 @@ func (c *OrganizationQuotaClient) First(ctx context.Context, opts *OrganizationQ
 // Get the specified organization quota
 func (c *OrganizationQuotaClient) Get(ctx context.Context, guid string) (*resource.OrganizationQuota, error) {
 	var app resource.OrganizationQuota
-	err := c.client.get(ctx, path.Format("/v3/organization_quotas/%s", guid), &app)
+	err := c.client.get(ctx, "OrganizationQuotas.Get", path.Format("/v3/organization_quotas/%s", guid), &app)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +85,7 @@ func (c *OrganizationQuotaClient) List(ctx context.Context, opts *OrganizationQu
 	}
 
 	var res resource.OrganizationQuotaList
-	err := c.client.list(ctx, "/v3/organization_quotas", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "OrganizationQuotas.List", "/v3/organization_quotas", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,7 +98,17 @@ func (c *OrganizationQuotaClient) ListAll(ctx context.Context, opts *Organizatio
 	if opts == nil {
 		opts = NewOrganizationQuotaListOptions()
 	}
-	return AutoPage[*OrganizationQuotaListOptions, *resource.OrganizationQuota](opts, func(opts *OrganizationQuotaListOptions) ([]*resource.OrganizationQuota, *Pager, error) {
+	return AutoPageConcurrent[*OrganizationQuotaListOptions, *resource.OrganizationQuota](opts, c.client.PageConcurrency(), func(opts *OrganizationQuotaListOptions) ([]*resource.OrganizationQuota, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all organization quotas the user has access to
+func (c *OrganizationQuotaClient) Iter(ctx context.Context, opts *OrganizationQuotaListOptions) Seq2[*resource.OrganizationQuota, error] {
+	if opts == nil {
+		opts = NewOrganizationQuotaListOptions()
+	}
+	return AutoPageIter[*OrganizationQuotaListOptions, *resource.OrganizationQuota](ctx, opts, func(opts *OrganizationQuotaListOptions) ([]*resource.OrganizationQuota, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -113,7 +123,7 @@ func (c *OrganizationQuotaClient) Single(ctx context.Context, opts *Organization
 // Update the specified attributes of the organization quota
 func (c *OrganizationQuotaClient) Update(ctx context.Context, guid string, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error) {
 	var q resource.OrganizationQuota
-	_, err := c.client.patch(ctx, path.Format("/v3/organization_quotas/%s", guid), r, &q)
+	_, err := c.client.patch(ctx, "OrganizationQuotas.Update", path.Format("/v3/organization_quotas/%s", guid), r, &q)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,9 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/url"
 
@@ -35,7 +38,7 @@ func (o PackageListOptions) ToQueryString() (url.Values, error) {
 func (c *PackageClient) Copy(ctx context.Context, srcPackageGUID string, destAppGUID string) (*resource.Package, error) {
 	var d resource.Package
 	r := resource.NewPackageCopy(destAppGUID)
-	_, err := c.client.post(ctx, path.Format("/v3/packages?source_guid=%s", srcPackageGUID), r, &d)
+	_, err := c.client.post(ctx, "Packages.Copy", path.Format("/v3/packages?source_guid=%s", srcPackageGUID), r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +48,7 @@ func (c *PackageClient) Copy(ctx context.Context, srcPackageGUID string, destApp
 // Create a new package
 func (c *PackageClient) Create(ctx context.Context, r *resource.PackageCreate) (*resource.Package, error) {
 	var p resource.Package
-	_, err := c.client.post(ctx, "/v3/packages", r, &p)
+	_, err := c.client.post(ctx, "Packages.Create", "/v3/packages", r, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +57,7 @@ func (c *PackageClient) Create(ctx context.Context, r *resource.PackageCreate) (
 
 // Delete the specified package asynchronously and return a jobGUID
 func (c *PackageClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/packages/%s", guid))
+	return c.client.delete(ctx, "Packages.Delete", path.Format("/v3/packages/%s", guid))
 }
 
 // Download the bits of an existing package
@@ -64,7 +67,7 @@ func (c *PackageClient) Download(ctx context.Context, guid string) (io.ReadClose
 	// The client will not automatically follow this redirect and uses a secondary
 	// unauthenticated client to download the bits
 	// https://v3-apidocs.cloudfoundry.org/version/3.128.0/index.html#download-package-bits
-	return c.client.download(ctx, path.Format("/v3/packages/%s/download", guid))
+	return c.client.download(ctx, "Packages.Download", path.Format("/v3/packages/%s/download", guid))
 }
 
 // First returns the first package matching the options or an error when less than 1 match
@@ -84,7 +87,7 @@ func (c *PackageClient) FirstForApp(ctx context.Context, appGUID string, opts *P
 // Get the specified build
 func (c *PackageClient) Get(ctx context.Context, guid string) (*resource.Package, error) {
 	var p resource.Package
-	err := c.client.get(ctx, path.Format("/v3/packages/%s", guid), &p)
+	err := c.client.get(ctx, "Packages.Get", path.Format("/v3/packages/%s", guid), &p)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +100,7 @@ func (c *PackageClient) List(ctx context.Context, opts *PackageListOptions) ([]*
 		opts = NewPackageListOptions()
 	}
 	var res resource.PackageList
-	if err := c.client.list(ctx, "/v3/packages", opts.ToQueryString, &res); err != nil {
+	if err := c.client.list(ctx, "Packages.List", "/v3/packages", opts.ToQueryString, &res); err != nil {
 		return nil, nil, err
 	}
 	pager := NewPager(res.Pagination)
@@ -109,7 +112,17 @@ func (c *PackageClient) ListAll(ctx context.Context, opts *PackageListOptions) (
 	if opts == nil {
 		opts = NewPackageListOptions()
 	}
-	return AutoPage[*PackageListOptions, *resource.Package](opts, func(opts *PackageListOptions) ([]*resource.Package, *Pager, error) {
+	return AutoPageConcurrent[*PackageListOptions, *resource.Package](opts, c.client.PageConcurrency(), func(opts *PackageListOptions) ([]*resource.Package, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all packages the user has access to
+func (c *PackageClient) Iter(ctx context.Context, opts *PackageListOptions) Seq2[*resource.Package, error] {
+	if opts == nil {
+		opts = NewPackageListOptions()
+	}
+	return AutoPageIter[*PackageListOptions, *resource.Package](ctx, opts, func(opts *PackageListOptions) ([]*resource.Package, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -120,7 +133,7 @@ func (c *PackageClient) ListForApp(ctx context.Context, appGUID string, opts *Pa
 		opts = NewPackageListOptions()
 	}
 	var res resource.PackageList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/packages", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Packages.ListForApp", "/v3/apps/"+appGUID+"/packages", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,7 +146,17 @@ func (c *PackageClient) ListForAppAll(ctx context.Context, appGUID string, opts
 	if opts == nil {
 		opts = NewPackageListOptions()
 	}
-	return AutoPage[*PackageListOptions, *resource.Package](opts, func(opts *PackageListOptions) ([]*resource.Package, *Pager, error) {
+	return AutoPageConcurrent[*PackageListOptions, *resource.Package](opts, c.client.PageConcurrency(), func(opts *PackageListOptions) ([]*resource.Package, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all packages the user has access to
+func (c *PackageClient) IterForApp(ctx context.Context, appGUID string, opts *PackageListOptions) Seq2[*resource.Package, error] {
+	if opts == nil {
+		opts = NewPackageListOptions()
+	}
+	return AutoPageIter[*PackageListOptions, *resource.Package](ctx, opts, func(opts *PackageListOptions) ([]*resource.Package, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -166,7 +189,7 @@ func (c *PackageClient) SingleForApp(ctx context.Context, appGUID string, opts *
 // Update the specified attributes of the package
 func (c *PackageClient) Update(ctx context.Context, guid string, r *resource.PackageUpdate) (*resource.Package, error) {
 	var p resource.Package
-	_, err := c.client.patch(ctx, path.Format("/v3/packages/%s", guid), r, &p)
+	_, err := c.client.patch(ctx, "Packages.Update", path.Format("/v3/packages/%s", guid), r, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +200,30 @@ func (c *PackageClient) Update(ctx context.Context, guid string, r *resource.Pac
 func (c *PackageClient) Upload(ctx context.Context, guid string, zipFile io.Reader) (*resource.Package, error) {
 	p := path.Format("/v3/packages/%s/upload", guid)
 	var pkg resource.Package
-	_, err := c.client.postFileUpload(ctx, p, "bits", "package.zip", zipFile, &pkg)
+	_, err := c.client.postFileUpload(ctx, "Packages.Upload", p, "bits", "package.zip", zipFile, &pkg)
 	return &pkg, err
 }
+
+// UploadWithResources uploads an app's zip file containing only the files that aren't already cached, along with the
+// resources previously matched by ResourceMatches.Create which are copied from the cache. The zip file may be nil
+// if all the app's files were matched.
+func (c *PackageClient) UploadWithResources(ctx context.Context, guid string, zipFile io.Reader, resources []resource.ResourceMatch) (*resource.Package, error) {
+	if resources == nil {
+		resources = []resource.ResourceMatch{}
+	}
+	if zipFile == nil && len(resources) == 0 {
+		return nil, errors.New("expected a zip file or matched resources to upload")
+	}
+	resourcesJSON, err := json.Marshal(resources)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling matched resources: %w", err)
+	}
+	p := path.Format("/v3/packages/%s/upload", guid)
+	fields := []formField{{name: "resources", value: string(resourcesJSON)}}
+	var pkg resource.Package
+	_, err = c.client.postMultipartForm(ctx, "Packages.UploadWithResources", p, fields, "bits", "package.zip", zipFile, &pkg)
+	if err != nil {
+		return nil, err
+	}
+	return &pkg, nil
+}
@@ -216,6 +216,28 @@ func TestPackages(t *testing.T) {
 				return c.Packages.Upload(context.Background(), "8d1f1d2e-08b1-4a10-a8df-471a1418cb8b", zipFile)
 			},
 		},
+		{
+			Description: "Upload package with matched resources",
+			Route: testutil.MockRoute{
+				Method:   "POST-FILE",
+				Endpoint: "/v3/packages/8d1f1d2e-08b1-4a10-a8df-471a1418cb8b/upload",
+				Output:   g.Single(pkg),
+				Status:   http.StatusOK,
+				PostForm: `[{"checksum":{"value":"a9993e364706816aba3e25717850c26c9cd0d89d"},"size_in_bytes":1,"path":"path/to/file","mode":"644"}]`,
+			},
+			Expected: pkg,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.Packages.UploadWithResources(context.Background(), "8d1f1d2e-08b1-4a10-a8df-471a1418cb8b", nil,
+					[]resource.ResourceMatch{
+						{
+							Checksum:    resource.ResourceMatchChecksum{Value: "a9993e364706816aba3e25717850c26c9cd0d89d"},
+							SizeInBytes: 1,
+							Path:        "path/to/file",
+							Mode:        "644",
+						},
+					})
+			},
+		},
 	}
 	ExecuteTests(tests, t)
 }
@@ -1,7 +1,10 @@
 package client
 
 import (
+	"context"
 	"errors"
+	"reflect"
+	"sync"
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
 	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
@@ -72,6 +75,147 @@ func AutoPage[T ListOptioner, R any](opts T, list ListFunc[T, R]) ([]R, error) {
 	return all, nil
 }
 
+// AutoPageConcurrent retrieves all pages like AutoPage, but after the first page is returned the remaining
+// pages are requested in parallel using up to concurrency workers. Results are returned in page order.
+//
+// The total number of pages is taken from the first page's pagination, so resources created or deleted while
+// paging may be skipped or duplicated in the same way as sequential paging. A concurrency of 1 or less, or list
+// options that cannot be copied, falls back to sequential paging. Once a request fails no further pages are
+// requested and the first error encountered is returned.
+func AutoPageConcurrent[T ListOptioner, R any](opts T, concurrency int, list ListFunc[T, R]) ([]R, error) {
+	if concurrency <= 1 {
+		return AutoPage[T, R](opts, list)
+	}
+	if _, ok := cloneListOptions(opts); !ok {
+		return AutoPage[T, R](opts, list)
+	}
+
+	first, pager, err := list(opts)
+	if err != nil {
+		return nil, err
+	}
+	if !pager.HasNextPage() || pager.TotalPages < 2 {
+		return first, nil
+	}
+	nextPage := pager.NextPageReader.Int(PageField)
+	perPage := pager.NextPageReader.Int(PerPageField)
+	if nextPage < 1 || nextPage > pager.TotalPages {
+		pager.NextPage(opts)
+		rest, err := AutoPage[T, R](opts, list)
+		if err != nil {
+			return nil, err
+		}
+		return append(first, rest...), nil
+	}
+
+	pages := make([][]R, pager.TotalPages-nextPage+2)
+	pages[0] = first
+	workCh := make(chan int)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	workers := concurrency
+	if remaining := len(pages) - 1; remaining < workers {
+		workers = remaining
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range workCh {
+				pageOpts, _ := cloneListOptions(opts)
+				pageOpts.CurrentPage(page, perPage)
+				results, _, err := list(pageOpts)
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				pages[page-nextPage+1] = results
+				mu.Unlock()
+			}
+		}()
+	}
+	for page := nextPage; page <= pager.TotalPages && !failed(); page++ {
+		workCh <- page
+	}
+	close(workCh)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	var all []R
+	for _, page := range pages {
+		all = append(all, page...)
+	}
+	return all, nil
+}
+
+// cloneListOptions returns a copy of the list options pointer with its own copy of the embedded ListOptions so
+// that the current page can be changed without affecting the original, or false if opts can't be copied.
+func cloneListOptions[T ListOptioner](opts T) (T, bool) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return opts, false
+	}
+	clone := reflect.New(v.Elem().Type())
+	clone.Elem().Set(v.Elem())
+
+	listOptionsType := reflect.TypeOf(&ListOptions{})
+	for i := 0; i < clone.Elem().NumField(); i++ {
+		field := clone.Elem().Field(i)
+		if field.Type() != listOptionsType || field.IsNil() || !field.CanSet() {
+			continue
+		}
+		lo := *field.Interface().(*ListOptions)
+		field.Set(reflect.ValueOf(&lo))
+	}
+	return clone.Interface().(T), true
+}
+
+// Seq2 is an iterator over sequences of pairs of values. It has the same shape as iter.Seq2 so on
+// Go 1.23+ it can be used directly in a for-range loop, while older Go versions can invoke it with a
+// yield function.
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// AutoPageIter returns an iterator that lazily pages through all results of the list function.
+//
+// A page is only requested once all the objects from the previous page have been consumed. Iteration
+// stops when the last page is reached, the caller breaks out of the loop or an error occurs. Errors,
+// including context cancellation, are yielded once as the final element of the sequence. Each iteration
+// pages through a copy of opts, so the sequence can be ranged over more than once and opts isn't changed.
+func AutoPageIter[T ListOptioner, R any](ctx context.Context, opts T, list ListFunc[T, R]) Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		pageOpts, _ := cloneListOptions(opts)
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(*new(R), err)
+				return
+			}
+			page, pager, err := list(pageOpts)
+			if err != nil {
+				yield(*new(R), err)
+				return
+			}
+			for _, r := range page {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if !pager.HasNextPage() {
+				return
+			}
+			pager.NextPage(pageOpts)
+		}
+	}
+}
+
 // Single returns a single object from the call to list or an error if matches > 1 or matches < 1
 func Single[T ListOptioner, R any](opts T, list ListFunc[T, R]) (R, error) {
 	matches, _, err := list(opts)
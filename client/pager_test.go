@@ -1,6 +1,10 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
@@ -106,3 +110,143 @@ func TestPager(t *testing.T) {
 	require.Equal(t, 1, listOpts.Page)
 	require.Equal(t, 50, listOpts.PerPage)
 }
+
+func TestAutoPageIter(t *testing.T) {
+	pages := []resource.Pagination{
+		{
+			TotalResults: 5,
+			TotalPages:   3,
+			Next:         resource.Link{Href: "https://api.example.org/v3/apps?page=2&per_page=2"},
+		},
+		{
+			TotalResults: 5,
+			TotalPages:   3,
+			Next:         resource.Link{Href: "https://api.example.org/v3/apps?page=3&per_page=2"},
+		},
+		{
+			TotalResults: 5,
+			TotalPages:   3,
+		},
+	}
+	results := [][]int{{1, 2}, {3, 4}, {5}}
+
+	var calls int
+	list := func(opts *AppListOptions) ([]int, *Pager, error) {
+		calls++
+		return results[opts.Page-1], NewPager(pages[opts.Page-1]), nil
+	}
+
+	collect := func(seq Seq2[int, error], limit int) ([]int, error) {
+		var all []int
+		var err error
+		seq(func(i int, iterErr error) bool {
+			if iterErr != nil {
+				err = iterErr
+				return false
+			}
+			all = append(all, i)
+			return len(all) < limit
+		})
+		return all, err
+	}
+
+	// all pages
+	all, err := collect(AutoPageIter[*AppListOptions, int](context.Background(), NewAppListOptions(), list), 100)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, all)
+	require.Equal(t, 3, calls)
+
+	// the sequence can be ranged over again without changing the caller's options
+	calls = 0
+	opts := NewAppListOptions()
+	seq := AutoPageIter[*AppListOptions, int](context.Background(), opts, list)
+	all, err = collect(seq, 100)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, all)
+	all, err = collect(seq, 100)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, all)
+	require.Equal(t, 6, calls)
+	require.Equal(t, 1, opts.Page)
+
+	// early break only fetches the pages needed
+	calls = 0
+	all, err = collect(AutoPageIter[*AppListOptions, int](context.Background(), NewAppListOptions(), list), 2)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, all)
+	require.Equal(t, 1, calls)
+
+	// list errors are yielded and stop iteration
+	listErr := errors.New("list failed")
+	all, err = collect(AutoPageIter[*AppListOptions, int](context.Background(), NewAppListOptions(),
+		func(opts *AppListOptions) ([]int, *Pager, error) {
+			return nil, nil, listErr
+		}), 100)
+	require.ErrorIs(t, err, listErr)
+	require.Empty(t, all)
+
+	// a cancelled context stops before the next page is requested
+	calls = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	all, err = collect(AutoPageIter[*AppListOptions, int](ctx, NewAppListOptions(),
+		func(opts *AppListOptions) ([]int, *Pager, error) {
+			cancel()
+			return list(opts)
+		}), 100)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{1, 2}, all)
+	require.Equal(t, 1, calls)
+}
+
+func TestAutoPageConcurrent(t *testing.T) {
+	const totalPages = 7
+	pagination := func(page int) resource.Pagination {
+		p := resource.Pagination{
+			TotalResults: totalPages * 2,
+			TotalPages:   totalPages,
+		}
+		if page < totalPages {
+			p.Next.Href = fmt.Sprintf("https://api.example.org/v3/apps?page=%d&per_page=2", page+1)
+		}
+		return p
+	}
+
+	var mu sync.Mutex
+	var requestedPages []int
+	list := func(opts *AppListOptions) ([]int, *Pager, error) {
+		mu.Lock()
+		requestedPages = append(requestedPages, opts.Page)
+		mu.Unlock()
+		require.Equal(t, "spring-music", opts.Names.Values[0])
+		return []int{opts.Page*2 - 1, opts.Page * 2}, NewPager(pagination(opts.Page)), nil
+	}
+
+	opts := NewAppListOptions()
+	opts.Names.EqualTo("spring-music")
+	all, err := AutoPageConcurrent[*AppListOptions, int](opts, 3, list)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14}, all)
+	require.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7}, requestedPages)
+	require.Equal(t, 1, requestedPages[0])
+	require.Equal(t, 1, opts.Page, "the caller's options should not be modified by the workers")
+
+	// sequential when concurrency is not greater than 1
+	requestedPages = nil
+	all, err = AutoPageConcurrent[*AppListOptions, int](opts, 1, list)
+	require.NoError(t, err)
+	require.Len(t, all, totalPages*2)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, requestedPages)
+
+	// any failed page fails the whole request
+	listErr := errors.New("page 4 failed")
+	opts = NewAppListOptions()
+	opts.Names.EqualTo("spring-music")
+	all, err = AutoPageConcurrent[*AppListOptions, int](opts, 4, func(opts *AppListOptions) ([]int, *Pager, error) {
+		if opts.Page == 4 {
+			return nil, nil, listErr
+		}
+		return list(opts)
+	})
+	require.ErrorIs(t, err, listErr)
+	require.Nil(t, all)
+}
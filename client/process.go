@@ -50,7 +50,7 @@ func (c *ProcessClient) FirstForApp(ctx context.Context, appGUID string, opts *P
 // Get the specified process
 func (c *ProcessClient) Get(ctx context.Context, guid string) (*resource.Process, error) {
 	var iso resource.Process
-	err := c.client.get(ctx, path.Format("/v3/processes/%s", guid), &iso)
+	err := c.client.get(ctx, "Processes.Get", path.Format("/v3/processes/%s", guid), &iso)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func (c *ProcessClient) Get(ctx context.Context, guid string) (*resource.Process
 // GetStats for the specified process
 func (c *ProcessClient) GetStats(ctx context.Context, guid string) (*resource.ProcessStats, error) {
 	var stats resource.ProcessStats
-	err := c.client.get(ctx, path.Format("/v3/processes/%s/stats", guid), &stats)
+	err := c.client.get(ctx, "Processes.GetStats", path.Format("/v3/processes/%s/stats", guid), &stats)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +70,7 @@ func (c *ProcessClient) GetStats(ctx context.Context, guid string) (*resource.Pr
 // GetStatsForApp for the specified app
 func (c *ProcessClient) GetStatsForApp(ctx context.Context, appGUID, processType string) (*resource.ProcessStats, error) {
 	var stats resource.ProcessStats
-	err := c.client.get(ctx, path.Format("/v3/apps/%s/processes/%s/stats", appGUID, processType), &stats)
+	err := c.client.get(ctx, "Processes.GetStatsForApp", path.Format("/v3/apps/%s/processes/%s/stats", appGUID, processType), &stats)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +84,7 @@ func (c *ProcessClient) List(ctx context.Context, opts *ProcessListOptions) ([]*
 	}
 
 	var isos resource.ProcessList
-	err := c.client.list(ctx, "/v3/processes", opts.ToQueryString, &isos)
+	err := c.client.list(ctx, "Processes.List", "/v3/processes", opts.ToQueryString, &isos)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,7 +97,17 @@ func (c *ProcessClient) ListAll(ctx context.Context, opts *ProcessListOptions) (
 	if opts == nil {
 		opts = NewProcessOptions()
 	}
-	return AutoPage[*ProcessListOptions, *resource.Process](opts, func(opts *ProcessListOptions) ([]*resource.Process, *Pager, error) {
+	return AutoPageConcurrent[*ProcessListOptions, *resource.Process](opts, c.client.PageConcurrency(), func(opts *ProcessListOptions) ([]*resource.Process, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all processes
+func (c *ProcessClient) Iter(ctx context.Context, opts *ProcessListOptions) Seq2[*resource.Process, error] {
+	if opts == nil {
+		opts = NewProcessOptions()
+	}
+	return AutoPageIter[*ProcessListOptions, *resource.Process](ctx, opts, func(opts *ProcessListOptions) ([]*resource.Process, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -109,7 +119,7 @@ func (c *ProcessClient) ListForApp(ctx context.Context, appGUID string, opts *Pr
 	}
 
 	var processes resource.ProcessList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/processes", opts.ToQueryString, &processes)
+	err := c.client.list(ctx, "Processes.ListForApp", "/v3/apps/"+appGUID+"/processes", opts.ToQueryString, &processes)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +132,17 @@ func (c *ProcessClient) ListForAppAll(ctx context.Context, appGUID string, opts
 	if opts == nil {
 		opts = NewProcessOptions()
 	}
-	return AutoPage[*ProcessListOptions, *resource.Process](opts, func(opts *ProcessListOptions) ([]*resource.Process, *Pager, error) {
+	return AutoPageConcurrent[*ProcessListOptions, *resource.Process](opts, c.client.PageConcurrency(), func(opts *ProcessListOptions) ([]*resource.Process, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all processes for the specified app
+func (c *ProcessClient) IterForApp(ctx context.Context, appGUID string, opts *ProcessListOptions) Seq2[*resource.Process, error] {
+	if opts == nil {
+		opts = NewProcessOptions()
+	}
+	return AutoPageIter[*ProcessListOptions, *resource.Process](ctx, opts, func(opts *ProcessListOptions) ([]*resource.Process, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -130,7 +150,7 @@ func (c *ProcessClient) ListForAppAll(ctx context.Context, appGUID string, opts
 // Scale the process using the specified scaling requirements
 func (c *ProcessClient) Scale(ctx context.Context, guid string, scale *resource.ProcessScale) (*resource.Process, error) {
 	var process resource.Process
-	_, err := c.client.post(ctx, path.Format("/v3/processes/%s/actions/scale", guid), scale, &process)
+	_, err := c.client.post(ctx, "Processes.Scale", path.Format("/v3/processes/%s/actions/scale", guid), scale, &process)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +174,7 @@ func (c *ProcessClient) SingleForApp(ctx context.Context, appGUID string, opts *
 // Update the specified attributes of the process
 func (c *ProcessClient) Update(ctx context.Context, guid string, r *resource.ProcessUpdate) (*resource.Process, error) {
 	var process resource.Process
-	_, err := c.client.patch(ctx, path.Format("/v3/processes/%s", guid), r, &process)
+	_, err := c.client.patch(ctx, "Processes.Update", path.Format("/v3/processes/%s", guid), r, &process)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +183,6 @@ func (c *ProcessClient) Update(ctx context.Context, guid string, r *resource.Pro
 
 // Terminate an instance of a specific process. Health management will eventually restart the instance.
 func (c *ProcessClient) Terminate(ctx context.Context, guid string, index int) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/processes/%s/instances/%d", guid, index))
+	_, err := c.client.delete(ctx, "Processes.Terminate", path.Format("/v3/processes/%s/instances/%d", guid, index))
 	return err
 }
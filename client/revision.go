@@ -38,7 +38,7 @@ func (c *RevisionClient) FirstForApp(ctx context.Context, appGUID string, opts *
 // Get the specified revision
 func (c *RevisionClient) Get(ctx context.Context, guid string) (*resource.Revision, error) {
 	var res resource.Revision
-	err := c.client.get(ctx, path.Format("/v3/revisions/%s", guid), &res)
+	err := c.client.get(ctx, "Revisions.Get", path.Format("/v3/revisions/%s", guid), &res)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ func (c *RevisionClient) Get(ctx context.Context, guid string) (*resource.Revisi
 // GetEnvironmentVariables retrieves the specified revision's environment variables
 func (c *RevisionClient) GetEnvironmentVariables(ctx context.Context, guid string) (map[string]*string, error) {
 	var res resource.EnvVarResponse
-	err := c.client.get(ctx, path.Format("/v3/revisions/%s/environment_variables", guid), &res)
+	err := c.client.get(ctx, "Revisions.GetEnvironmentVariables", path.Format("/v3/revisions/%s/environment_variables", guid), &res)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +61,7 @@ func (c *RevisionClient) ListForApp(ctx context.Context, appGUID string, opts *R
 		opts = NewRevisionListOptions()
 	}
 	var res resource.RevisionList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/revisions", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Revisions.ListForApp", "/v3/apps/"+appGUID+"/revisions", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,7 +74,17 @@ func (c *RevisionClient) ListForAppAll(ctx context.Context, appGUID string, opts
 	if opts == nil {
 		opts = NewRevisionListOptions()
 	}
-	return AutoPage[*RevisionListOptions, *resource.Revision](opts, func(opts *RevisionListOptions) ([]*resource.Revision, *Pager, error) {
+	return AutoPageConcurrent[*RevisionListOptions, *resource.Revision](opts, c.client.PageConcurrency(), func(opts *RevisionListOptions) ([]*resource.Revision, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all revisions that are associated with the specified app
+func (c *RevisionClient) IterForApp(ctx context.Context, appGUID string, opts *RevisionListOptions) Seq2[*resource.Revision, error] {
+	if opts == nil {
+		opts = NewRevisionListOptions()
+	}
+	return AutoPageIter[*RevisionListOptions, *resource.Revision](ctx, opts, func(opts *RevisionListOptions) ([]*resource.Revision, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -85,7 +95,7 @@ func (c *RevisionClient) ListForAppDeployed(ctx context.Context, appGUID string,
 		opts = NewRevisionListOptions()
 	}
 	var res resource.RevisionList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/revisions/deployed", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Revisions.ListForAppDeployed", "/v3/apps/"+appGUID+"/revisions/deployed", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,7 +108,17 @@ func (c *RevisionClient) ListForAppDeployedAll(ctx context.Context, appGUID stri
 	if opts == nil {
 		opts = NewRevisionListOptions()
 	}
-	return AutoPage[*RevisionListOptions, *resource.Revision](opts, func(opts *RevisionListOptions) ([]*resource.Revision, *Pager, error) {
+	return AutoPageConcurrent[*RevisionListOptions, *resource.Revision](opts, c.client.PageConcurrency(), func(opts *RevisionListOptions) ([]*resource.Revision, *Pager, error) {
+		return c.ListForAppDeployed(ctx, appGUID, opts)
+	})
+}
+
+// IterForAppDeployed returns an iterator over all deployed revisions that are associated with the specified app
+func (c *RevisionClient) IterForAppDeployed(ctx context.Context, appGUID string, opts *RevisionListOptions) Seq2[*resource.Revision, error] {
+	if opts == nil {
+		opts = NewRevisionListOptions()
+	}
+	return AutoPageIter[*RevisionListOptions, *resource.Revision](ctx, opts, func(opts *RevisionListOptions) ([]*resource.Revision, *Pager, error) {
 		return c.ListForAppDeployed(ctx, appGUID, opts)
 	})
 }
@@ -120,7 +140,7 @@ func (c *RevisionClient) SingleForAppDeployed(ctx context.Context, appGUID strin
 // Update the specified attributes of the deployment
 func (c *RevisionClient) Update(ctx context.Context, guid string, r *resource.RevisionUpdate) (*resource.Revision, error) {
 	var res resource.Revision
-	_, err := c.client.patch(ctx, path.Format("/v3/revisions/%s", guid), r, &res)
+	_, err := c.client.patch(ctx, "Revisions.Update", path.Format("/v3/revisions/%s", guid), r, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func (o *RoleListOptions) WithSpaceRoleType(roleType ...resource.SpaceRoleType)
 func (c *RoleClient) CreateSpaceRole(ctx context.Context, spaceGUID, userGUID string, roleType resource.SpaceRoleType) (*resource.Role, error) {
 	req := resource.NewRoleSpaceCreate(spaceGUID, userGUID, roleType)
 	var r resource.Role
-	_, err := c.client.post(ctx, "/v3/roles", req, &r)
+	_, err := c.client.post(ctx, "Roles.CreateSpaceRole", "/v3/roles", req, &r)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func (c *RoleClient) CreateSpaceRole(ctx context.Context, spaceGUID, userGUID st
 func (c *RoleClient) CreateOrganizationRole(ctx context.Context, organizationGUID, userGUID string, roleType resource.OrganizationRoleType) (*resource.Role, error) {
 	req := resource.NewRoleOrganizationCreate(organizationGUID, userGUID, roleType)
 	var r resource.Role
-	_, err := c.client.post(ctx, "/v3/roles", req, &r)
+	_, err := c.client.post(ctx, "Roles.CreateOrganizationRole", "/v3/roles", req, &r)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func (c *RoleClient) CreateOrganizationRole(ctx context.Context, organizationGUI
 
 // Delete the specified role asynchronously and return a jobGUID
 func (c *RoleClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/roles/%s", guid))
+	return c.client.delete(ctx, "Roles.Delete", path.Format("/v3/roles/%s", guid))
 }
 
 // First returns the first role matching the options or an error when less than 1 match
@@ -96,7 +96,7 @@ func (c *RoleClient) First(ctx context.Context, opts *RoleListOptions) (*resourc
 // Get the specified role
 func (c *RoleClient) Get(ctx context.Context, guid string) (*resource.Role, error) {
 	var r resource.Role
-	err := c.client.get(ctx, path.Format("/v3/roles/%s", guid), &r)
+	err := c.client.get(ctx, "Roles.Get", path.Format("/v3/roles/%s", guid), &r)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +106,7 @@ func (c *RoleClient) Get(ctx context.Context, guid string) (*resource.Role, erro
 // GetIncludeOrganizations allows callers to fetch a role and include any assigned organizations
 func (c *RoleClient) GetIncludeOrganizations(ctx context.Context, guid string) (*resource.Role, []*resource.Organization, error) {
 	var role resource.RoleWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/roles/%s?include=%s", guid, resource.RoleIncludeOrganization), &role)
+	err := c.client.get(ctx, "Roles.GetIncludeOrganizations", path.Format("/v3/roles/%s?include=%s", guid, resource.RoleIncludeOrganization), &role)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,7 +116,7 @@ func (c *RoleClient) GetIncludeOrganizations(ctx context.Context, guid string) (
 // GetIncludeSpaces allows callers to fetch a role and include any assigned spaces
 func (c *RoleClient) GetIncludeSpaces(ctx context.Context, guid string) (*resource.Role, []*resource.Space, error) {
 	var role resource.RoleWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/roles/%s?include=%s", guid, resource.RoleIncludeSpace), &role)
+	err := c.client.get(ctx, "Roles.GetIncludeSpaces", path.Format("/v3/roles/%s?include=%s", guid, resource.RoleIncludeSpace), &role)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -126,7 +126,7 @@ func (c *RoleClient) GetIncludeSpaces(ctx context.Context, guid string) (*resour
 // GetIncludeUsers allows callers to fetch a role and include any assigned users
 func (c *RoleClient) GetIncludeUsers(ctx context.Context, guid string) (*resource.Role, []*resource.User, error) {
 	var role resource.RoleWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/roles/%s?include=%s", guid, resource.RoleIncludeUser), &role)
+	err := c.client.get(ctx, "Roles.GetIncludeUsers", path.Format("/v3/roles/%s?include=%s", guid, resource.RoleIncludeUser), &role)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -139,7 +139,7 @@ func (c *RoleClient) List(ctx context.Context, opts *RoleListOptions) ([]*resour
 		opts = NewRoleListOptions()
 	}
 	var res resource.RoleList
-	err := c.client.list(ctx, "/v3/roles", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Roles.List", "/v3/roles", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,7 +152,17 @@ func (c *RoleClient) ListAll(ctx context.Context, opts *RoleListOptions) ([]*res
 	if opts == nil {
 		opts = NewRoleListOptions()
 	}
-	return AutoPage[*RoleListOptions, *resource.Role](opts, func(opts *RoleListOptions) ([]*resource.Role, *Pager, error) {
+	return AutoPageConcurrent[*RoleListOptions, *resource.Role](opts, c.client.PageConcurrency(), func(opts *RoleListOptions) ([]*resource.Role, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all roles the user has access to
+func (c *RoleClient) Iter(ctx context.Context, opts *RoleListOptions) Seq2[*resource.Role, error] {
+	if opts == nil {
+		opts = NewRoleListOptions()
+	}
+	return AutoPageIter[*RoleListOptions, *resource.Role](ctx, opts, func(opts *RoleListOptions) ([]*resource.Role, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -165,7 +175,7 @@ func (c *RoleClient) ListIncludeOrganizations(ctx context.Context, opts *RoleLis
 	opts.Include = resource.RoleIncludeOrganization
 
 	var res resource.RoleList
-	err := c.client.list(ctx, "/v3/roles", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Roles.ListIncludeOrganizations", "/v3/roles", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -174,6 +184,9 @@ func (c *RoleClient) ListIncludeOrganizations(ctx context.Context, opts *RoleLis
 }
 
 // ListIncludeOrganizationsAll retrieves all roles and specified and includes organizations that have the roles
+//
+// There's no iterator over the resources with their included resources, use ListIncludeOrganizations to fetch them a page at
+// a time instead.
 func (c *RoleClient) ListIncludeOrganizationsAll(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.Organization, error) {
 	if opts == nil {
 		opts = NewRoleListOptions()
@@ -205,7 +218,7 @@ func (c *RoleClient) ListIncludeSpaces(ctx context.Context, opts *RoleListOption
 	opts.Include = resource.RoleIncludeSpace
 
 	var res resource.RoleList
-	err := c.client.list(ctx, "/v3/roles", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Roles.ListIncludeSpaces", "/v3/roles", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -214,6 +227,9 @@ func (c *RoleClient) ListIncludeSpaces(ctx context.Context, opts *RoleListOption
 }
 
 // ListIncludeSpacesAll retrieves all roles and specified and includes spaces that have the roles
+//
+// There's no iterator over the resources with their included resources, use ListIncludeSpaces to fetch them a page at
+// a time instead.
 func (c *RoleClient) ListIncludeSpacesAll(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.Space, error) {
 	if opts == nil {
 		opts = NewRoleListOptions()
@@ -245,7 +261,7 @@ func (c *RoleClient) ListIncludeUsers(ctx context.Context, opts *RoleListOptions
 	opts.Include = resource.RoleIncludeUser
 
 	var res resource.RoleList
-	err := c.client.list(ctx, "/v3/roles", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Roles.ListIncludeUsers", "/v3/roles", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -254,6 +270,9 @@ func (c *RoleClient) ListIncludeUsers(ctx context.Context, opts *RoleListOptions
 }
 
 // ListIncludeUsersAll retrieves all roles and all the users that belong to those roles
+//
+// There's no iterator over the resources with their included resources, use ListIncludeUsers to fetch them a page at
+// a time instead.
 func (c *RoleClient) ListIncludeUsersAll(ctx context.Context, opts *RoleListOptions) ([]*resource.Role, []*resource.User, error) {
 	if opts == nil {
 		opts = NewRoleListOptions()
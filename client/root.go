@@ -17,7 +17,7 @@ func (c *RootClient) Get(ctx context.Context) (*resource.Root, error) {
 	var root resource.Root
 
 	// NOTE - this will end up needlessly sending an auth header which the endpoint will ignore
-	err := c.client.get(ctx, "/", &root)
+	err := c.client.get(ctx, "Root.Get", "/", &root)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +30,7 @@ func (c *RootClient) Get(ctx context.Context) (*resource.Root, error) {
 func (c *RootClient) GetV3(ctx context.Context) (*resource.V3Root, error) {
 	var v3Root resource.V3Root
 	// NOTE - this will end up needlessly sending an auth header which the endpoint will ignore
-	if err := c.client.get(ctx, "/v3", &v3Root); err != nil {
+	if err := c.client.get(ctx, "Root.GetV3", "/v3", &v3Root); err != nil {
 		return nil, err
 	}
 	return &v3Root, nil
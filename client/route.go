@@ -62,7 +62,7 @@ func (o RouteReservationListOptions) ToQueryString() (url.Values, error) {
 // Create a new route
 func (c *RouteClient) Create(ctx context.Context, r *resource.RouteCreate) (*resource.Route, error) {
 	var Route resource.Route
-	_, err := c.client.post(ctx, "/v3/routes", r, &Route)
+	_, err := c.client.post(ctx, "Routes.Create", "/v3/routes", r, &Route)
 	if err != nil {
 		return nil, err
 	}
@@ -71,13 +71,13 @@ func (c *RouteClient) Create(ctx context.Context, r *resource.RouteCreate) (*res
 
 // Delete the specified route asynchronously and return a jobGUID
 func (c *RouteClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/routes/%s", guid))
+	return c.client.delete(ctx, "Routes.Delete", path.Format("/v3/routes/%s", guid))
 }
 
 // DeleteUnmappedRoutesForSpace deletes all routes in a space that are not mapped to any applications and not
 // bound to any service instances and returns the async JobGUID
 func (c *RouteClient) DeleteUnmappedRoutesForSpace(ctx context.Context, spaceGUID string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/spaces/%s/routes?unmapped=true", spaceGUID))
+	return c.client.delete(ctx, "Routes.DeleteUnmappedRoutesForSpace", path.Format("/v3/spaces/%s/routes?unmapped=true", spaceGUID))
 }
 
 // First returns the first route matching the options or an error when less than 1 match
@@ -97,7 +97,7 @@ func (c *RouteClient) FirstForApp(ctx context.Context, appGUID string, opts *Rou
 // Get the specified route
 func (c *RouteClient) Get(ctx context.Context, guid string) (*resource.Route, error) {
 	var r resource.Route
-	err := c.client.get(ctx, path.Format("/v3/routes/%s", guid), &r)
+	err := c.client.get(ctx, "Routes.Get", path.Format("/v3/routes/%s", guid), &r)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +107,7 @@ func (c *RouteClient) Get(ctx context.Context, guid string) (*resource.Route, er
 // GetIncludeDomain allows callers to fetch a route and include the parent domain
 func (c *RouteClient) GetIncludeDomain(ctx context.Context, guid string) (*resource.Route, *resource.Domain, error) {
 	var r resource.RouteWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/routes/%s?include=%s", guid, resource.RouteIncludeDomain), &r)
+	err := c.client.get(ctx, "Routes.GetIncludeDomain", path.Format("/v3/routes/%s?include=%s", guid, resource.RouteIncludeDomain), &r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,7 +117,7 @@ func (c *RouteClient) GetIncludeDomain(ctx context.Context, guid string) (*resou
 // GetIncludeSpace allows callers to fetch a route and include the parent space
 func (c *RouteClient) GetIncludeSpace(ctx context.Context, guid string) (*resource.Route, *resource.Space, error) {
 	var r resource.RouteWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/routes/%s?include=%s", guid, resource.RouteIncludeSpaceOrganization), &r)
+	err := c.client.get(ctx, "Routes.GetIncludeSpace", path.Format("/v3/routes/%s?include=%s", guid, resource.RouteIncludeSpaceOrganization), &r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -127,7 +127,7 @@ func (c *RouteClient) GetIncludeSpace(ctx context.Context, guid string) (*resour
 // GetIncludeSpaceAndOrganization allows callers to fetch a route and include the parent space and organization
 func (c *RouteClient) GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.Route, *resource.Space, *resource.Organization, error) {
 	var r resource.RouteWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/routes/%s?include=%s", guid, resource.RouteIncludeSpaceOrganization), &r)
+	err := c.client.get(ctx, "Routes.GetIncludeSpaceAndOrganization", path.Format("/v3/routes/%s?include=%s", guid, resource.RouteIncludeSpaceOrganization), &r)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -137,7 +137,7 @@ func (c *RouteClient) GetIncludeSpaceAndOrganization(ctx context.Context, guid s
 // GetSharedSpacesRelationships retrieves the spaces that the route has been shared to
 func (c *RouteClient) GetSharedSpacesRelationships(ctx context.Context, guid string) (*resource.RouteSharedSpaceRelationships, error) {
 	var r resource.RouteSharedSpaceRelationships
-	err := c.client.get(ctx, path.Format("/v3/routes/%s/relationships/shared_spaces", guid), &r)
+	err := c.client.get(ctx, "Routes.GetSharedSpacesRelationships", path.Format("/v3/routes/%s/relationships/shared_spaces", guid), &r)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +147,7 @@ func (c *RouteClient) GetSharedSpacesRelationships(ctx context.Context, guid str
 // GetDestinations retrieves all destinations associated with a route
 func (c *RouteClient) GetDestinations(ctx context.Context, guid string) (*resource.RouteDestinations, error) {
 	var r resource.RouteDestinations
-	err := c.client.get(ctx, path.Format("/v3/routes/%s/destinations", guid), &r)
+	err := c.client.get(ctx, "Routes.GetDestinations", path.Format("/v3/routes/%s/destinations", guid), &r)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +163,7 @@ func (c *RouteClient) InsertDestinations(ctx context.Context, guid string, dest
 		Destinations: dest,
 	}
 	var r resource.RouteDestinations
-	_, err := c.client.post(ctx, path.Format("/v3/routes/%s/destinations", guid), destinations, &r)
+	_, err := c.client.post(ctx, "Routes.InsertDestinations", path.Format("/v3/routes/%s/destinations", guid), destinations, &r)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +177,7 @@ func (c *RouteClient) IsRouteReserved(ctx context.Context, domainGUID string, op
 		opts = NewRouteReservationListOptions()
 	}
 	var match map[string]bool
-	err := c.client.list(ctx, "/v3/domains/"+domainGUID+"/route_reservations", opts.ToQueryString, &match)
+	err := c.client.list(ctx, "Routes.IsRouteReserved", "/v3/domains/"+domainGUID+"/route_reservations", opts.ToQueryString, &match)
 	if err != nil {
 		return false, err
 	}
@@ -192,7 +192,7 @@ func (c *RouteClient) List(ctx context.Context, opts *RouteListOptions) ([]*reso
 	opts.Include = resource.RouteIncludeNone
 
 	var res resource.RouteList
-	err := c.client.list(ctx, "/v3/routes", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Routes.List", "/v3/routes", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -205,7 +205,17 @@ func (c *RouteClient) ListAll(ctx context.Context, opts *RouteListOptions) ([]*r
 	if opts == nil {
 		opts = NewRouteListOptions()
 	}
-	return AutoPage[*RouteListOptions, *resource.Route](opts, func(opts *RouteListOptions) ([]*resource.Route, *Pager, error) {
+	return AutoPageConcurrent[*RouteListOptions, *resource.Route](opts, c.client.PageConcurrency(), func(opts *RouteListOptions) ([]*resource.Route, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all routes the user has access to
+func (c *RouteClient) Iter(ctx context.Context, opts *RouteListOptions) Seq2[*resource.Route, error] {
+	if opts == nil {
+		opts = NewRouteListOptions()
+	}
+	return AutoPageIter[*RouteListOptions, *resource.Route](ctx, opts, func(opts *RouteListOptions) ([]*resource.Route, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -218,7 +228,7 @@ func (c *RouteClient) ListForApp(ctx context.Context, appGUID string, opts *Rout
 	opts.Include = resource.RouteIncludeNone
 
 	var res resource.RouteList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/routes", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Routes.ListForApp", "/v3/apps/"+appGUID+"/routes", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -231,7 +241,17 @@ func (c *RouteClient) ListForAppAll(ctx context.Context, appGUID string, opts *R
 	if opts == nil {
 		opts = NewRouteListOptions()
 	}
-	return AutoPage[*RouteListOptions, *resource.Route](opts, func(opts *RouteListOptions) ([]*resource.Route, *Pager, error) {
+	return AutoPageConcurrent[*RouteListOptions, *resource.Route](opts, c.client.PageConcurrency(), func(opts *RouteListOptions) ([]*resource.Route, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all routes for the specified app the user has access to
+func (c *RouteClient) IterForApp(ctx context.Context, appGUID string, opts *RouteListOptions) Seq2[*resource.Route, error] {
+	if opts == nil {
+		opts = NewRouteListOptions()
+	}
+	return AutoPageIter[*RouteListOptions, *resource.Route](ctx, opts, func(opts *RouteListOptions) ([]*resource.Route, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -244,7 +264,7 @@ func (c *RouteClient) ListIncludeDomains(ctx context.Context, opts *RouteListOpt
 	opts.Include = resource.RouteIncludeDomain
 
 	var res resource.RouteList
-	err := c.client.list(ctx, "/v3/routes", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Routes.ListIncludeDomains", "/v3/routes", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -253,6 +273,9 @@ func (c *RouteClient) ListIncludeDomains(ctx context.Context, opts *RouteListOpt
 }
 
 // ListIncludeDomainsAll retrieves all routes the user has access to and includes the parent domains
+//
+// There's no iterator over the resources with their included resources, use ListIncludeDomains to fetch them a page at
+// a time instead.
 func (c *RouteClient) ListIncludeDomainsAll(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Domain, error) {
 	if opts == nil {
 		opts = NewRouteListOptions()
@@ -283,7 +306,7 @@ func (c *RouteClient) ListIncludeSpaces(ctx context.Context, opts *RouteListOpti
 	opts.Include = resource.RouteIncludeSpace
 
 	var res resource.RouteList
-	err := c.client.list(ctx, "/v3/routes", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Routes.ListIncludeSpaces", "/v3/routes", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -292,6 +315,9 @@ func (c *RouteClient) ListIncludeSpaces(ctx context.Context, opts *RouteListOpti
 }
 
 // ListIncludeSpacesAll retrieves all routes the user has access to and includes the parent spaces
+//
+// There's no iterator over the resources with their included resources, use ListIncludeSpaces to fetch them a page at
+// a time instead.
 func (c *RouteClient) ListIncludeSpacesAll(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Space, error) {
 	if opts == nil {
 		opts = NewRouteListOptions()
@@ -322,7 +348,7 @@ func (c *RouteClient) ListIncludeSpacesAndOrganizations(ctx context.Context, opt
 	opts.Include = resource.RouteIncludeSpaceOrganization
 
 	var res resource.RouteList
-	err := c.client.list(ctx, "/v3/routes", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Routes.ListIncludeSpacesAndOrganizations", "/v3/routes", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -331,6 +357,9 @@ func (c *RouteClient) ListIncludeSpacesAndOrganizations(ctx context.Context, opt
 }
 
 // ListIncludeSpacesAndOrganizationsAll retrieves all routes the user has access to and includes the parent spaces and organization
+//
+// There's no iterator over the resources with their included resources, use ListIncludeSpacesAndOrganizations to fetch them a page at
+// a time instead.
 func (c *RouteClient) ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *RouteListOptions) ([]*resource.Route, []*resource.Space, []*resource.Organization, error) {
 	if opts == nil {
 		opts = NewRouteListOptions()
@@ -357,7 +386,7 @@ func (c *RouteClient) ListIncludeSpacesAndOrganizationsAll(ctx context.Context,
 
 // RemoveDestination removes a destination from a route
 func (c *RouteClient) RemoveDestination(ctx context.Context, guid, destinationGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/routes/%s/destinations/%s", guid, destinationGUID))
+	_, err := c.client.delete(ctx, "Routes.RemoveDestination", path.Format("/v3/routes/%s/destinations/%s", guid, destinationGUID))
 	return err
 }
 
@@ -371,7 +400,7 @@ func (c *RouteClient) ReplaceDestinations(ctx context.Context, guid string, dest
 		Destinations: dest,
 	}
 	var r resource.RouteDestinations
-	_, err := c.client.patch(ctx, path.Format("/v3/routes/%s/destinations", guid), destinations, &r)
+	_, err := c.client.patch(ctx, "Routes.ReplaceDestinations", path.Format("/v3/routes/%s/destinations", guid), destinations, &r)
 	if err != nil {
 		return nil, err
 	}
@@ -391,7 +420,7 @@ func (c *RouteClient) ShareWithSpace(ctx context.Context, guid string, spaceGUID
 func (c *RouteClient) ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.RouteSharedSpaceRelationships, error) {
 	req := resource.NewToManyRelationships(spaceGUIDs)
 	var relationships resource.RouteSharedSpaceRelationships
-	_, err := c.client.post(ctx, path.Format("/v3/routes/%s/relationships/shared_spaces", guid), req, &relationships)
+	_, err := c.client.post(ctx, "Routes.ShareWithSpaces", path.Format("/v3/routes/%s/relationships/shared_spaces", guid), req, &relationships)
 	if err != nil {
 		return nil, err
 	}
@@ -423,7 +452,7 @@ func (c *RouteClient) TransferOwnership(ctx context.Context, guid string, spaceG
 			GUID: spaceGUID,
 		},
 	}
-	_, err := c.client.patch(ctx, path.Format("/v3/routes/%s/relationships/space", guid), req, nil)
+	_, err := c.client.patch(ctx, "Routes.TransferOwnership", path.Format("/v3/routes/%s/relationships/space", guid), req, nil)
 	return err
 }
 
@@ -432,7 +461,7 @@ func (c *RouteClient) TransferOwnership(ctx context.Context, guid string, spaceG
 // This will automatically unbind any applications bound to this route in the specified space
 // Un-sharing a route from a space will not delete any service keys
 func (c *RouteClient) UnShareWithSpace(ctx context.Context, guid string, spaceGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/routes/%s/relationships/shared_spaces/%s", guid, spaceGUID))
+	_, err := c.client.delete(ctx, "Routes.UnShareWithSpace", path.Format("/v3/routes/%s/relationships/shared_spaces/%s", guid, spaceGUID))
 	return err
 }
 
@@ -453,7 +482,7 @@ func (c *RouteClient) UnShareWithSpaces(ctx context.Context, guid string, spaceG
 // Update the specified attributes of the app
 func (c *RouteClient) Update(ctx context.Context, guid string, r *resource.RouteUpdate) (*resource.Route, error) {
 	var res resource.Route
-	_, err := c.client.patch(ctx, path.Format("/v3/routes/%s", guid), r, &res)
+	_, err := c.client.patch(ctx, "Routes.Update", path.Format("/v3/routes/%s", guid), r, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -474,7 +503,7 @@ func (c *RouteClient) UpdateDestinationProtocol(ctx context.Context, guid, desti
 		Protocol: p,
 	}
 	var r resource.RouteDestinationWithLinks
-	_, err := c.client.patch(ctx, path.Format("/v3/routes/%s/destinations/%s", guid, destinationGUID), u, &r)
+	_, err := c.client.patch(ctx, "Routes.UpdateDestinationProtocol", path.Format("/v3/routes/%s/destinations/%s", guid, destinationGUID), u, &r)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// ErrNoRoutingAPI is returned when the API root doesn't link to a routing API
+var ErrNoRoutingAPI = errors.New("the Cloud Foundry API root doesn't link to a routing API")
+
+// RoutingAPIClient manages router groups and TCP routes using the routing API
+type RoutingAPIClient commonClient
+
+// CreateTCPRoutes creates or refreshes the TCP route mappings
+func (c *RoutingAPIClient) CreateTCPRoutes(ctx context.Context, routes ...*resource.TCPRoute) error {
+	return c.routing(ctx, "RoutingAPI.CreateTCPRoutes", http.MethodPost, "/v1/tcp_routes/create", routes, nil)
+}
+
+// DeleteTCPRoutes deletes the TCP route mappings
+func (c *RoutingAPIClient) DeleteTCPRoutes(ctx context.Context, routes ...*resource.TCPRoute) error {
+	return c.routing(ctx, "RoutingAPI.DeleteTCPRoutes", http.MethodPost, "/v1/tcp_routes/delete", routes, nil)
+}
+
+// FreePort returns the lowest reservable port of the TCP domain's router group that isn't used by a route of any
+// domain sharing the router group, for use with Routes.Create
+func (c *RoutingAPIClient) FreePort(ctx context.Context, domainGUID string) (int, error) {
+	domain, err := c.client.Domains.Get(ctx, domainGUID)
+	if err != nil {
+		return 0, err
+	}
+	if domain.RouterGroup == nil || domain.RouterGroup.GUID == "" {
+		return 0, fmt.Errorf("domain %s is not a TCP domain", domain.Name)
+	}
+	routerGroup, err := c.GetRouterGroup(ctx, domain.RouterGroup.GUID)
+	if err != nil {
+		return 0, err
+	}
+	ranges, err := routerGroup.PortRanges()
+	if err != nil {
+		return 0, err
+	}
+
+	// ports are reserved per router group, so include the routes of every domain sharing it
+	domains, err := c.client.Domains.ListAll(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	domainGUIDs := []string{domainGUID}
+	for _, d := range domains {
+		if d.GUID != domainGUID && d.RouterGroup != nil && d.RouterGroup.GUID == routerGroup.GUID {
+			domainGUIDs = append(domainGUIDs, d.GUID)
+		}
+	}
+	opts := NewRouteListOptions()
+	opts.DomainGUIDs.EqualTo(domainGUIDs...)
+	routes, err := c.client.Routes.ListAll(ctx, opts)
+	if err != nil {
+		return 0, err
+	}
+	used := make(map[int]bool, len(routes))
+	for _, r := range routes {
+		if r.Port != nil {
+			used[*r.Port] = true
+		}
+	}
+	for _, r := range ranges {
+		for port := r.Start; port <= r.End; port++ {
+			if !used[port] {
+				return port, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no free ports in router group %s", routerGroup.Name)
+}
+
+// GetRouterGroup gets the specified router group
+func (c *RoutingAPIClient) GetRouterGroup(ctx context.Context, guid string) (*resource.RouterGroup, error) {
+	routerGroups, err := c.ListRouterGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rg := range routerGroups {
+		if rg.GUID == guid {
+			return rg, nil
+		}
+	}
+	return nil, fmt.Errorf("router group %s not found", guid)
+}
+
+// GetRouterGroupByName gets the router group with the specified name, e.g. default-tcp
+func (c *RoutingAPIClient) GetRouterGroupByName(ctx context.Context, name string) (*resource.RouterGroup, error) {
+	values := url.Values{}
+	values.Set("name", name)
+	var routerGroups []*resource.RouterGroup
+	err := c.routing(ctx, "RoutingAPI.GetRouterGroupByName", http.MethodGet, path.Format("/v1/router_groups?%s", values), nil, &routerGroups)
+	if err != nil {
+		return nil, err
+	}
+	if len(routerGroups) == 0 {
+		return nil, fmt.Errorf("router group %s not found", name)
+	}
+	return routerGroups[0], nil
+}
+
+// ListRouterGroups lists all the router groups
+func (c *RoutingAPIClient) ListRouterGroups(ctx context.Context) ([]*resource.RouterGroup, error) {
+	var routerGroups []*resource.RouterGroup
+	if err := c.routing(ctx, "RoutingAPI.ListRouterGroups", http.MethodGet, "/v1/router_groups", nil, &routerGroups); err != nil {
+		return nil, err
+	}
+	return routerGroups, nil
+}
+
+// ListTCPRoutes lists all the TCP route mappings
+func (c *RoutingAPIClient) ListTCPRoutes(ctx context.Context) ([]*resource.TCPRoute, error) {
+	var routes []*resource.TCPRoute
+	if err := c.routing(ctx, "RoutingAPI.ListTCPRoutes", http.MethodGet, "/v1/tcp_routes", nil, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// ListTCPRoutesForRouterGroup lists the TCP route mappings of the specified router group
+func (c *RoutingAPIClient) ListTCPRoutesForRouterGroup(ctx context.Context, routerGroupGUID string) ([]*resource.TCPRoute, error) {
+	routes, err := c.ListTCPRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var filtered []*resource.TCPRoute
+	for _, r := range routes {
+		if r.RouterGroupGUID == routerGroupGUID {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+// UpdateRouterGroup updates the reservable ports of the specified router group
+func (c *RoutingAPIClient) UpdateRouterGroup(ctx context.Context, guid string, r *resource.RouterGroupUpdate) (*resource.RouterGroup, error) {
+	if _, err := resource.ParsePortRanges(r.ReservablePorts); err != nil {
+		return nil, err
+	}
+	var routerGroup resource.RouterGroup
+	err := c.routing(ctx, "RoutingAPI.UpdateRouterGroup", http.MethodPut, path.Format("/v1/router_groups/%s", guid), r, &routerGroup)
+	if err != nil {
+		return nil, err
+	}
+	return &routerGroup, nil
+}
+
+func (c *RoutingAPIClient) routing(ctx context.Context, operation, method, resourcePath string, params, result any) error {
+	links, err := c.client.links(ctx)
+	if err != nil {
+		return err
+	}
+	if links.Routing.Href == "" {
+		return ErrNoRoutingAPI
+	}
+	return c.client.external(ctx, operation, method, links.Routing.Href, resourcePath, params, result)
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestRoutingAPI(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(13)
+	routerGroup := g.RouterGroup("1024-1033")
+	routerGroup2 := g.RouterGroup("2000,3000-3010")
+	tcpRoute := g.TCPRoute(routerGroup.GUID, 1024)
+	tcpRoute2 := g.TCPRoute(routerGroup2.GUID, 2000)
+
+	tests := []RouteTest{
+		{
+			Description: "List router groups",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/routing/v1/router_groups",
+				Output:   g.Single(g.Array(routerGroup.JSON, routerGroup2.JSON)),
+				Status:   http.StatusOK,
+			},
+			Expected: g.Array(routerGroup.JSON, routerGroup2.JSON),
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.RoutingAPI.ListRouterGroups(context.Background())
+			},
+		},
+		{
+			Description: "Get router group",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/routing/v1/router_groups",
+				Output:   g.Single(g.Array(routerGroup.JSON, routerGroup2.JSON)),
+				Status:   http.StatusOK,
+			},
+			Expected: routerGroup2.JSON,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.RoutingAPI.GetRouterGroup(context.Background(), routerGroup2.GUID)
+			},
+		},
+		{
+			Description: "Get router group by name",
+			Route: testutil.MockRoute{
+				Method:      "GET",
+				Endpoint:    "/routing/v1/router_groups",
+				Output:      g.Single(g.Array(routerGroup.JSON)),
+				Status:      http.StatusOK,
+				QueryString: "name=" + routerGroup.Name,
+			},
+			Expected: routerGroup.JSON,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.RoutingAPI.GetRouterGroupByName(context.Background(), routerGroup.Name)
+			},
+		},
+		{
+			Description: "Update router group reservable ports",
+			Route: testutil.MockRoute{
+				Method:   "PUT",
+				Endpoint: "/routing/v1/router_groups/" + routerGroup.GUID,
+				Output:   g.Single(routerGroup.JSON),
+				Status:   http.StatusOK,
+				PostForm: `{"reservable_ports":"1024-1033"}`,
+			},
+			Expected: routerGroup.JSON,
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.RoutingAPI.UpdateRouterGroup(context.Background(), routerGroup.GUID, &resource.RouterGroupUpdate{
+					ReservablePorts: "1024-1033",
+				})
+			},
+		},
+		{
+			Description: "List TCP routes",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/routing/v1/tcp_routes",
+				Output:   g.Single(g.Array(tcpRoute.JSON, tcpRoute2.JSON)),
+				Status:   http.StatusOK,
+			},
+			Expected: g.Array(tcpRoute.JSON, tcpRoute2.JSON),
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.RoutingAPI.ListTCPRoutes(context.Background())
+			},
+		},
+		{
+			Description: "List TCP routes for router group",
+			Route: testutil.MockRoute{
+				Method:   "GET",
+				Endpoint: "/routing/v1/tcp_routes",
+				Output:   g.Single(g.Array(tcpRoute.JSON, tcpRoute2.JSON)),
+				Status:   http.StatusOK,
+			},
+			Expected: g.Array(tcpRoute2.JSON),
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return c.RoutingAPI.ListTCPRoutesForRouterGroup(context.Background(), routerGroup2.GUID)
+			},
+		},
+		{
+			Description: "Create TCP routes",
+			Route: testutil.MockRoute{
+				Method:   "POST",
+				Endpoint: "/routing/v1/tcp_routes/create",
+				Status:   http.StatusCreated,
+				PostForm: g.Array(tcpRoute.JSON),
+			},
+			Action: func(c *Client, t *testing.T) (any, error) {
+				ttl := 120
+				return nil, c.RoutingAPI.CreateTCPRoutes(context.Background(), &resource.TCPRoute{
+					RouterGroupGUID: routerGroup.GUID,
+					Port:            1024,
+					BackendIP:       "10.244.0.16",
+					BackendPort:     61001,
+					InstanceID:      "6a8b4c2d-1e3f-4a5b-9c7d-0e1f2a3b4c5d",
+					TTL:             &ttl,
+					ModificationTag: &resource.ModificationTag{
+						GUID:  "cd4e8ea1-0a10-4c13-5bf1-cd0f4b3a8e25",
+						Index: 3,
+					},
+				})
+			},
+		},
+		{
+			Description: "Delete TCP routes",
+			Route: testutil.MockRoute{
+				Method:   "POST",
+				Endpoint: "/routing/v1/tcp_routes/delete",
+				Status:   http.StatusNoContent,
+				PostForm: `[{"router_group_guid":"` + routerGroup.GUID + `","port":1024,"backend_ip":"10.244.0.16","backend_port":61001}]`,
+			},
+			Action: func(c *Client, t *testing.T) (any, error) {
+				return nil, c.RoutingAPI.DeleteTCPRoutes(context.Background(), &resource.TCPRoute{
+					RouterGroupGUID: routerGroup.GUID,
+					Port:            1024,
+					BackendIP:       "10.244.0.16",
+					BackendPort:     61001,
+				})
+			},
+		},
+	}
+	ExecuteTests(tests, t)
+}
+
+func TestRoutingAPIFreePort(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(13)
+	routerGroup := g.RouterGroup("1024-1025,2000,3000-3010")
+	domain := g.Domain()
+	tcpDomain := strings.Replace(domain.JSON, `"router_group": null`, `"router_group": {"guid": "`+routerGroup.GUID+`"}`, 1)
+	sharedDomain := g.Domain()
+	sharedTCPDomain := strings.Replace(sharedDomain.JSON, `"router_group": null`, `"router_group": {"guid": "`+routerGroup.GUID+`"}`, 1)
+	otherTCPDomain := strings.Replace(g.Domain().JSON, `"router_group": null`, `"router_group": {"guid": "other-router-group"}`, 1)
+	httpDomain := g.Domain()
+	route := func(port string) string {
+		return strings.Replace(g.Route().JSON, `"port": 6666`, `"port": `+port, 1)
+	}
+
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:   "GET",
+			Endpoint: "/v3/domains/" + domain.GUID,
+			Output:   g.Single(tcpDomain),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   "GET",
+			Endpoint: "/routing/v1/router_groups",
+			Output:   g.Single(g.Array(routerGroup.JSON)),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   "GET",
+			Endpoint: "/v3/domains",
+			Output:   g.Paged([]string{tcpDomain, sharedTCPDomain, otherTCPDomain, httpDomain.JSON}),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:      "GET",
+			Endpoint:    "/v3/routes",
+			Output:      g.Paged([]string{route("1024"), route("1025"), route("2000"), route("3000"), route("3001")}),
+			Status:      http.StatusOK,
+			QueryString: "domain_guids=" + domain.GUID + "," + sharedDomain.GUID + "&page=1&per_page=50",
+		},
+	}, t)
+	defer testutil.Teardown()
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	require.NoError(t, err)
+	c, err := New(cfg)
+	require.NoError(t, err)
+
+	// port 3000 is used by a route of the other domain sharing the router group
+	port, err := c.RoutingAPI.FreePort(context.Background(), domain.GUID)
+	require.NoError(t, err)
+	require.Equal(t, 3002, port)
+}
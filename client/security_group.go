@@ -63,7 +63,7 @@ func (o SecurityGroupSpaceListOptions) ToQueryString() (url.Values, error) {
 func (c *SecurityGroupClient) BindRunningSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
 	req := resource.NewToManyRelationships(spaceGUIDs)
 	var relation resource.ToManyRelationships
-	_, err := c.client.post(ctx, path.Format("/v3/security_groups/%s/relationships/running_spaces", guid), req, &relation)
+	_, err := c.client.post(ctx, "SecurityGroups.BindRunningSecurityGroup", path.Format("/v3/security_groups/%s/relationships/running_spaces", guid), req, &relation)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +84,7 @@ func (c *SecurityGroupClient) BindRunningSecurityGroup(ctx context.Context, guid
 func (c *SecurityGroupClient) BindStagingSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
 	req := resource.NewToManyRelationships(spaceGUIDs)
 	var relation resource.ToManyRelationships
-	_, err := c.client.post(ctx, path.Format("/v3/security_groups/%s/relationships/staging_spaces", guid), req, &relation)
+	_, err := c.client.post(ctx, "SecurityGroups.BindStagingSecurityGroup", path.Format("/v3/security_groups/%s/relationships/staging_spaces", guid), req, &relation)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +98,7 @@ func (c *SecurityGroupClient) BindStagingSecurityGroup(ctx context.Context, guid
 // Create a new domain
 func (c *SecurityGroupClient) Create(ctx context.Context, r *resource.SecurityGroupCreate) (*resource.SecurityGroup, error) {
 	var d resource.SecurityGroup
-	_, err := c.client.post(ctx, "/v3/security_groups", r, &d)
+	_, err := c.client.post(ctx, "SecurityGroups.Create", "/v3/security_groups", r, &d)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +107,7 @@ func (c *SecurityGroupClient) Create(ctx context.Context, r *resource.SecurityGr
 
 // Delete the specified security group asynchronously and return a jobGUID
 func (c *SecurityGroupClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/security_groups/%s", guid))
+	return c.client.delete(ctx, "SecurityGroups.Delete", path.Format("/v3/security_groups/%s", guid))
 }
 
 // First returns the first security group matching the options or an error when less than 1 match
@@ -120,7 +120,7 @@ func (c *SecurityGroupClient) First(ctx context.Context, opts *SecurityGroupList
 // Get the specified security group
 func (c *SecurityGroupClient) Get(ctx context.Context, guid string) (*resource.SecurityGroup, error) {
 	var d resource.SecurityGroup
-	err := c.client.get(ctx, path.Format("/v3/security_groups/%s", guid), &d)
+	err := c.client.get(ctx, "SecurityGroups.Get", path.Format("/v3/security_groups/%s", guid), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +133,7 @@ func (c *SecurityGroupClient) List(ctx context.Context, opts *SecurityGroupListO
 		opts = NewSecurityGroupListOptions()
 	}
 	var res resource.SecurityGroupList
-	err := c.client.list(ctx, "/v3/security_groups", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "SecurityGroups.List", "/v3/security_groups", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,7 +146,17 @@ func (c *SecurityGroupClient) ListAll(ctx context.Context, opts *SecurityGroupLi
 	if opts == nil {
 		opts = NewSecurityGroupListOptions()
 	}
-	return AutoPage[*SecurityGroupListOptions, *resource.SecurityGroup](opts, func(opts *SecurityGroupListOptions) ([]*resource.SecurityGroup, *Pager, error) {
+	return AutoPageConcurrent[*SecurityGroupListOptions, *resource.SecurityGroup](opts, c.client.PageConcurrency(), func(opts *SecurityGroupListOptions) ([]*resource.SecurityGroup, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all SecurityGroups the user has access to
+func (c *SecurityGroupClient) Iter(ctx context.Context, opts *SecurityGroupListOptions) Seq2[*resource.SecurityGroup, error] {
+	if opts == nil {
+		opts = NewSecurityGroupListOptions()
+	}
+	return AutoPageIter[*SecurityGroupListOptions, *resource.SecurityGroup](ctx, opts, func(opts *SecurityGroupListOptions) ([]*resource.SecurityGroup, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -164,7 +174,7 @@ func (c *SecurityGroupClient) ListRunningForSpace(ctx context.Context, spaceGUID
 		opts = NewSecurityGroupSpaceListOptions()
 	}
 	var res resource.SecurityGroupList
-	err := c.client.list(ctx, "/v3/spaces/"+spaceGUID+"/running_security_groups", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "SecurityGroups.ListRunningForSpace", "/v3/spaces/"+spaceGUID+"/running_security_groups", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -177,7 +187,17 @@ func (c *SecurityGroupClient) ListRunningForSpaceAll(ctx context.Context, spaceG
 	if opts == nil {
 		opts = NewSecurityGroupSpaceListOptions()
 	}
-	return AutoPage[*SecurityGroupSpaceListOptions, *resource.SecurityGroup](opts, func(opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error) {
+	return AutoPageConcurrent[*SecurityGroupSpaceListOptions, *resource.SecurityGroup](opts, c.client.PageConcurrency(), func(opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error) {
+		return c.ListRunningForSpace(ctx, spaceGUID, opts)
+	})
+}
+
+// IterRunningForSpace returns an iterator over all security groups that are enabled for running globally or at the space level for the given space
+func (c *SecurityGroupClient) IterRunningForSpace(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) Seq2[*resource.SecurityGroup, error] {
+	if opts == nil {
+		opts = NewSecurityGroupSpaceListOptions()
+	}
+	return AutoPageIter[*SecurityGroupSpaceListOptions, *resource.SecurityGroup](ctx, opts, func(opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error) {
 		return c.ListRunningForSpace(ctx, spaceGUID, opts)
 	})
 }
@@ -188,7 +208,7 @@ func (c *SecurityGroupClient) ListStagingForSpace(ctx context.Context, spaceGUID
 		opts = NewSecurityGroupSpaceListOptions()
 	}
 	var res resource.SecurityGroupList
-	err := c.client.list(ctx, "/v3/spaces/"+spaceGUID+"/staging_security_groups", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "SecurityGroups.ListStagingForSpace", "/v3/spaces/"+spaceGUID+"/staging_security_groups", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -201,7 +221,17 @@ func (c *SecurityGroupClient) ListStagingForSpaceAll(ctx context.Context, spaceG
 	if opts == nil {
 		opts = NewSecurityGroupSpaceListOptions()
 	}
-	return AutoPage[*SecurityGroupSpaceListOptions, *resource.SecurityGroup](opts, func(opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error) {
+	return AutoPageConcurrent[*SecurityGroupSpaceListOptions, *resource.SecurityGroup](opts, c.client.PageConcurrency(), func(opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error) {
+		return c.ListStagingForSpace(ctx, spaceGUID, opts)
+	})
+}
+
+// IterStagingForSpace returns an iterator over all security groups that are enabled for staging globally or at the space level for the given space
+func (c *SecurityGroupClient) IterStagingForSpace(ctx context.Context, spaceGUID string, opts *SecurityGroupSpaceListOptions) Seq2[*resource.SecurityGroup, error] {
+	if opts == nil {
+		opts = NewSecurityGroupSpaceListOptions()
+	}
+	return AutoPageIter[*SecurityGroupSpaceListOptions, *resource.SecurityGroup](ctx, opts, func(opts *SecurityGroupSpaceListOptions) ([]*resource.SecurityGroup, *Pager, error) {
 		return c.ListStagingForSpace(ctx, spaceGUID, opts)
 	})
 }
@@ -210,7 +240,7 @@ func (c *SecurityGroupClient) ListStagingForSpaceAll(ctx context.Context, spaceG
 //
 // Apps within this space must be restarted for these changes to take effect.
 func (c *SecurityGroupClient) UnBindRunningSecurityGroup(ctx context.Context, guid string, spaceGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/security_groups/%s/relationships/running_spaces/%s", guid, spaceGUID))
+	_, err := c.client.delete(ctx, "SecurityGroups.UnBindRunningSecurityGroup", path.Format("/v3/security_groups/%s/relationships/running_spaces/%s", guid, spaceGUID))
 	return err
 }
 
@@ -218,14 +248,14 @@ func (c *SecurityGroupClient) UnBindRunningSecurityGroup(ctx context.Context, gu
 //
 // Apps within this space must be restarted for these changes to take effect.
 func (c *SecurityGroupClient) UnBindStagingSecurityGroup(ctx context.Context, guid string, spaceGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/security_groups/%s/relationships/staging_spaces/%s", guid, spaceGUID))
+	_, err := c.client.delete(ctx, "SecurityGroups.UnBindStagingSecurityGroup", path.Format("/v3/security_groups/%s/relationships/staging_spaces/%s", guid, spaceGUID))
 	return err
 }
 
 // Update the specified attributes of the app
 func (c *SecurityGroupClient) Update(ctx context.Context, guid string, r *resource.SecurityGroupUpdate) (*resource.SecurityGroup, error) {
 	var d resource.SecurityGroup
-	_, err := c.client.patch(ctx, path.Format("/v3/security_groups/%s", guid), r, &d)
+	_, err := c.client.patch(ctx, "SecurityGroups.Update", path.Format("/v3/security_groups/%s", guid), r, &d)
 	if err != nil {
 		return nil, err
 	}
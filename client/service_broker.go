@@ -32,12 +32,12 @@ func (o ServiceBrokerListOptions) ToQueryString() (url.Values, error) {
 
 // Create a new service broker asynchronously and return a jobGUID
 func (c *ServiceBrokerClient) Create(ctx context.Context, r *resource.ServiceBrokerCreate) (string, error) {
-	return c.client.post(ctx, "/v3/service_brokers", r, nil)
+	return c.client.post(ctx, "ServiceBrokers.Create", "/v3/service_brokers", r, nil)
 }
 
 // Delete the specified service broker asynchronously and return a jobGUID
 func (c *ServiceBrokerClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/service_brokers/%s", guid))
+	return c.client.delete(ctx, "ServiceBrokers.Delete", path.Format("/v3/service_brokers/%s", guid))
 }
 
 // First returns the first service broker matching the options or an error when less than 1 match
@@ -50,7 +50,7 @@ func (c *ServiceBrokerClient) First(ctx context.Context, opts *ServiceBrokerList
 // Get the specified service broker
 func (c *ServiceBrokerClient) Get(ctx context.Context, guid string) (*resource.ServiceBroker, error) {
 	var sb resource.ServiceBroker
-	err := c.client.get(ctx, path.Format("/v3/service_brokers/%s", guid), &sb)
+	err := c.client.get(ctx, "ServiceBrokers.Get", path.Format("/v3/service_brokers/%s", guid), &sb)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +64,7 @@ func (c *ServiceBrokerClient) List(ctx context.Context, opts *ServiceBrokerListO
 	}
 
 	var res resource.ServiceBrokerList
-	err := c.client.list(ctx, "/v3/service_brokers", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceBrokers.List", "/v3/service_brokers", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -77,7 +77,17 @@ func (c *ServiceBrokerClient) ListAll(ctx context.Context, opts *ServiceBrokerLi
 	if opts == nil {
 		opts = NewServiceBrokerListOptions()
 	}
-	return AutoPage[*ServiceBrokerListOptions, *resource.ServiceBroker](opts, func(opts *ServiceBrokerListOptions) ([]*resource.ServiceBroker, *Pager, error) {
+	return AutoPageConcurrent[*ServiceBrokerListOptions, *resource.ServiceBroker](opts, c.client.PageConcurrency(), func(opts *ServiceBrokerListOptions) ([]*resource.ServiceBroker, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all service brokers the user has access to
+func (c *ServiceBrokerClient) Iter(ctx context.Context, opts *ServiceBrokerListOptions) Seq2[*resource.ServiceBroker, error] {
+	if opts == nil {
+		opts = NewServiceBrokerListOptions()
+	}
+	return AutoPageIter[*ServiceBrokerListOptions, *resource.ServiceBroker](ctx, opts, func(opts *ServiceBrokerListOptions) ([]*resource.ServiceBroker, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -93,7 +103,7 @@ func (c *ServiceBrokerClient) Single(ctx context.Context, opts *ServiceBrokerLis
 // Only metadata updates synchronously and return a service broker instance, all other updates return a jobGUID
 func (c *ServiceBrokerClient) Update(ctx context.Context, guid string, r *resource.ServiceBrokerUpdate) (string, *resource.ServiceBroker, error) {
 	var sb resource.ServiceBroker
-	jobGUID, err := c.client.patch(ctx, path.Format("/v3/service_brokers/%s", guid), r, &sb)
+	jobGUID, err := c.client.patch(ctx, "ServiceBrokers.Update", path.Format("/v3/service_brokers/%s", guid), r, &sb)
 	if err != nil {
 		return "", nil, err
 	}
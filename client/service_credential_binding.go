@@ -43,7 +43,7 @@ func (o ServiceCredentialBindingListOptions) ToQueryString() (url.Values, error)
 // Create a new service credential binding
 func (c *ServiceCredentialBindingClient) Create(ctx context.Context, r *resource.ServiceCredentialBindingCreate) (string, *resource.ServiceCredentialBinding, error) {
 	var d resource.ServiceCredentialBinding
-	jobGUID, err := c.client.post(ctx, "/v3/service_credential_bindings", r, &d)
+	jobGUID, err := c.client.post(ctx, "ServiceCredentialBindings.Create", "/v3/service_credential_bindings", r, &d)
 	if err != nil {
 		return "", nil, err
 	}
@@ -55,7 +55,7 @@ func (c *ServiceCredentialBindingClient) Create(ctx context.Context, r *resource
 
 // Delete the specified service credential binding
 func (c *ServiceCredentialBindingClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/service_credential_bindings/%s", guid))
+	_, err := c.client.delete(ctx, "ServiceCredentialBindings.Delete", path.Format("/v3/service_credential_bindings/%s", guid))
 	return err
 }
 
@@ -69,7 +69,7 @@ func (c *ServiceCredentialBindingClient) First(ctx context.Context, opts *Servic
 // Get the specified service credential binding
 func (c *ServiceCredentialBindingClient) Get(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, error) {
 	var d resource.ServiceCredentialBinding
-	err := c.client.get(ctx, path.Format("/v3/service_credential_bindings/%s", guid), &d)
+	err := c.client.get(ctx, "ServiceCredentialBindings.Get", path.Format("/v3/service_credential_bindings/%s", guid), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +79,7 @@ func (c *ServiceCredentialBindingClient) Get(ctx context.Context, guid string) (
 // GetDetails the specified service credential binding details
 func (c *ServiceCredentialBindingClient) GetDetails(ctx context.Context, guid string) (*resource.ServiceCredentialBindingDetails, error) {
 	var d resource.ServiceCredentialBindingDetails
-	err := c.client.get(ctx, path.Format("/v3/service_credential_bindings/%s/details", guid), &d)
+	err := c.client.get(ctx, "ServiceCredentialBindings.GetDetails", path.Format("/v3/service_credential_bindings/%s/details", guid), &d)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +89,7 @@ func (c *ServiceCredentialBindingClient) GetDetails(ctx context.Context, guid st
 // GetParameters the specified service credential binding details
 func (c *ServiceCredentialBindingClient) GetParameters(ctx context.Context, guid string) (map[string]string, error) {
 	var p map[string]string
-	err := c.client.get(ctx, path.Format("/v3/service_credential_bindings/%s/parameters", guid), &p)
+	err := c.client.get(ctx, "ServiceCredentialBindings.GetParameters", path.Format("/v3/service_credential_bindings/%s/parameters", guid), &p)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +99,7 @@ func (c *ServiceCredentialBindingClient) GetParameters(ctx context.Context, guid
 // GetIncludeApp allows callers to fetch a service credential binding and include the associated app
 func (c *ServiceCredentialBindingClient) GetIncludeApp(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, *resource.App, error) {
 	var r resource.ServiceCredentialBindingWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/service_credential_bindings/%s?include=%s", guid, resource.ServiceCredentialBindingIncludeApp), &r)
+	err := c.client.get(ctx, "ServiceCredentialBindings.GetIncludeApp", path.Format("/v3/service_credential_bindings/%s?include=%s", guid, resource.ServiceCredentialBindingIncludeApp), &r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,7 +109,7 @@ func (c *ServiceCredentialBindingClient) GetIncludeApp(ctx context.Context, guid
 // GetIncludeServiceInstance allows callers to fetch a service credential binding and include the associated service instance
 func (c *ServiceCredentialBindingClient) GetIncludeServiceInstance(ctx context.Context, guid string) (*resource.ServiceCredentialBinding, *resource.ServiceInstance, error) {
 	var r resource.ServiceCredentialBindingWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/service_credential_bindings/%s?include=%s", guid, resource.ServiceCredentialBindingIncludeServiceInstance), &r)
+	err := c.client.get(ctx, "ServiceCredentialBindings.GetIncludeServiceInstance", path.Format("/v3/service_credential_bindings/%s?include=%s", guid, resource.ServiceCredentialBindingIncludeServiceInstance), &r)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -119,7 +119,7 @@ func (c *ServiceCredentialBindingClient) GetIncludeServiceInstance(ctx context.C
 // List pages ServiceCredentialBindings the user has access to
 func (c *ServiceCredentialBindingClient) List(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, *Pager, error) {
 	var res resource.ServiceCredentialBindingList
-	err := c.client.list(ctx, "/v3/service_credential_bindings", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceCredentialBindings.List", "/v3/service_credential_bindings", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,7 +132,17 @@ func (c *ServiceCredentialBindingClient) ListAll(ctx context.Context, opts *Serv
 	if opts == nil {
 		opts = NewServiceCredentialBindingListOptions()
 	}
-	return AutoPage[*ServiceCredentialBindingListOptions, *resource.ServiceCredentialBinding](opts, func(opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, *Pager, error) {
+	return AutoPageConcurrent[*ServiceCredentialBindingListOptions, *resource.ServiceCredentialBinding](opts, c.client.PageConcurrency(), func(opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all ServiceCredentialBindings the user has access to
+func (c *ServiceCredentialBindingClient) Iter(ctx context.Context, opts *ServiceCredentialBindingListOptions) Seq2[*resource.ServiceCredentialBinding, error] {
+	if opts == nil {
+		opts = NewServiceCredentialBindingListOptions()
+	}
+	return AutoPageIter[*ServiceCredentialBindingListOptions, *resource.ServiceCredentialBinding](ctx, opts, func(opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -145,7 +155,7 @@ func (c *ServiceCredentialBindingClient) ListIncludeApps(ctx context.Context, op
 	opts.Include = resource.ServiceCredentialBindingIncludeApp
 
 	var res resource.ServiceCredentialBindingList
-	err := c.client.list(ctx, "/v3/service_credential_bindings", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceCredentialBindings.ListIncludeApps", "/v3/service_credential_bindings", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -154,6 +164,9 @@ func (c *ServiceCredentialBindingClient) ListIncludeApps(ctx context.Context, op
 }
 
 // ListIncludeAppsAll retrieves all service credential bindings the user has access to and include the associated apps
+//
+// There's no iterator over the resources with their included resources, use ListIncludeApps to fetch them a page at
+// a time instead.
 func (c *ServiceCredentialBindingClient) ListIncludeAppsAll(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.App, error) {
 	if opts == nil {
 		opts = NewServiceCredentialBindingListOptions()
@@ -184,7 +197,7 @@ func (c *ServiceCredentialBindingClient) ListIncludeServiceInstances(ctx context
 	opts.Include = resource.ServiceCredentialBindingIncludeServiceInstance
 
 	var res resource.ServiceCredentialBindingList
-	err := c.client.list(ctx, "/v3/service_credential_bindings", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceCredentialBindings.ListIncludeServiceInstances", "/v3/service_credential_bindings", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -193,6 +206,9 @@ func (c *ServiceCredentialBindingClient) ListIncludeServiceInstances(ctx context
 }
 
 // ListIncludeServiceInstancesAll retrieves all service credential bindings the user has access to and include the associated SIs
+//
+// There's no iterator over the resources with their included resources, use ListIncludeServiceInstances to fetch them a page at
+// a time instead.
 func (c *ServiceCredentialBindingClient) ListIncludeServiceInstancesAll(ctx context.Context, opts *ServiceCredentialBindingListOptions) ([]*resource.ServiceCredentialBinding, []*resource.ServiceInstance, error) {
 	if opts == nil {
 		opts = NewServiceCredentialBindingListOptions()
@@ -225,7 +241,7 @@ func (c *ServiceCredentialBindingClient) Single(ctx context.Context, opts *Servi
 // Update the specified attributes of the app
 func (c *ServiceCredentialBindingClient) Update(ctx context.Context, guid string, r *resource.ServiceCredentialBindingUpdate) (*resource.ServiceCredentialBinding, error) {
 	var d resource.ServiceCredentialBinding
-	_, err := c.client.patch(ctx, path.Format("/v3/service_credential_bindings/%s", guid), r, &d)
+	_, err := c.client.patch(ctx, "ServiceCredentialBindings.Update", path.Format("/v3/service_credential_bindings/%s", guid), r, &d)
 	if err != nil {
 		return nil, err
 	}
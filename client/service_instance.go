@@ -39,7 +39,7 @@ func (o ServiceInstanceListOptions) ToQueryString() (url.Values, error) {
 // of this call is an error or the jobGUID.
 func (c *ServiceInstanceClient) CreateManaged(ctx context.Context, r *resource.ServiceInstanceManagedCreate) (string, error) {
 	var si resource.ServiceInstance
-	jobGUID, err := c.client.post(ctx, "/v3/service_instances", r, &si)
+	jobGUID, err := c.client.post(ctx, "ServiceInstances.CreateManaged", "/v3/service_instances", r, &si)
 	if err != nil {
 		return "", err
 	}
@@ -50,7 +50,7 @@ func (c *ServiceInstanceClient) CreateManaged(ctx context.Context, r *resource.S
 // do not require interactions with service brokers.
 func (c *ServiceInstanceClient) CreateUserProvided(ctx context.Context, r *resource.ServiceInstanceUserProvidedCreate) (*resource.ServiceInstance, error) {
 	var si resource.ServiceInstance
-	_, err := c.client.post(ctx, "/v3/service_instances", r, &si)
+	_, err := c.client.post(ctx, "ServiceInstances.CreateUserProvided", "/v3/service_instances", r, &si)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ func (c *ServiceInstanceClient) CreateUserProvided(ctx context.Context, r *resou
 
 // Delete the specified service instance returning the async deletion jobGUID
 func (c *ServiceInstanceClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/service_instances/%s", guid))
+	return c.client.delete(ctx, "ServiceInstances.Delete", path.Format("/v3/service_instances/%s", guid))
 }
 
 // First returns the first service instance matching the options or an error when less than 1 match
@@ -72,7 +72,7 @@ func (c *ServiceInstanceClient) First(ctx context.Context, opts *ServiceInstance
 // Get the specified service instance
 func (c *ServiceInstanceClient) Get(ctx context.Context, guid string) (*resource.ServiceInstance, error) {
 	var si resource.ServiceInstance
-	err := c.client.get(ctx, path.Format("/v3/service_instances/%s", guid), &si)
+	err := c.client.get(ctx, "ServiceInstances.Get", path.Format("/v3/service_instances/%s", guid), &si)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +88,7 @@ func (c *ServiceInstanceClient) Get(ctx context.Context, guid string) (*resource
 // see the Cloud Foundry documentation on Dashboard Single Sign-On.
 func (c *ServiceInstanceClient) GetUserPermissions(ctx context.Context, guid string) (*resource.ServiceInstanceUserPermissions, error) {
 	var permissions resource.ServiceInstanceUserPermissions
-	err := c.client.get(ctx, path.Format("/v3/service_instances/%s/permissions", guid), &permissions)
+	err := c.client.get(ctx, "ServiceInstances.GetUserPermissions", path.Format("/v3/service_instances/%s/permissions", guid), &permissions)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +101,7 @@ func (c *ServiceInstanceClient) GetUserPermissions(ctx context.Context, guid str
 // Check the Service Offering object for the value of this feature flag.
 func (c *ServiceInstanceClient) GetManagedParameters(ctx context.Context, guid string) (*json.RawMessage, error) {
 	var parameters json.RawMessage
-	err := c.client.get(ctx, path.Format("/v3/service_instances/%s/parameters", guid), &parameters)
+	err := c.client.get(ctx, "ServiceInstances.GetManagedParameters", path.Format("/v3/service_instances/%s/parameters", guid), &parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +111,7 @@ func (c *ServiceInstanceClient) GetManagedParameters(ctx context.Context, guid s
 // GetUserProvidedCredentials the specified user provided service instance credentials
 func (c *ServiceInstanceClient) GetUserProvidedCredentials(ctx context.Context, guid string) (*json.RawMessage, error) {
 	var credentials json.RawMessage
-	err := c.client.get(ctx, path.Format("/v3/service_instances/%s/credentials", guid), &credentials)
+	err := c.client.get(ctx, "ServiceInstances.GetUserProvidedCredentials", path.Format("/v3/service_instances/%s/credentials", guid), &credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +121,7 @@ func (c *ServiceInstanceClient) GetUserProvidedCredentials(ctx context.Context,
 // GetSharedSpaceRelationships lists the spaces that the service instance has been shared to
 func (c *ServiceInstanceClient) GetSharedSpaceRelationships(ctx context.Context, guid string) (*resource.ServiceInstanceSharedSpaceRelationships, error) {
 	var relations resource.ServiceInstanceSharedSpaceRelationships
-	err := c.client.get(ctx, path.Format("/v3/service_instances/%s/relationships/shared_spaces", guid), &relations)
+	err := c.client.get(ctx, "ServiceInstances.GetSharedSpaceRelationships", path.Format("/v3/service_instances/%s/relationships/shared_spaces", guid), &relations)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +131,7 @@ func (c *ServiceInstanceClient) GetSharedSpaceRelationships(ctx context.Context,
 // GetSharedSpaceUsageSummary retrieves the number of bound apps in spaces where the service instance has been shared to
 func (c *ServiceInstanceClient) GetSharedSpaceUsageSummary(ctx context.Context, guid string) (*resource.ServiceInstanceUsageSummary, error) {
 	var usage resource.ServiceInstanceUsageSummary
-	err := c.client.get(ctx, path.Format("/v3/service_instances/%s/relationships/shared_spaces/usage_summary", guid), &usage)
+	err := c.client.get(ctx, "ServiceInstances.GetSharedSpaceUsageSummary", path.Format("/v3/service_instances/%s/relationships/shared_spaces/usage_summary", guid), &usage)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +144,7 @@ func (c *ServiceInstanceClient) List(ctx context.Context, opts *ServiceInstanceL
 		opts = NewServiceInstanceListOptions()
 	}
 	var res resource.ServiceInstanceList
-	err := c.client.list(ctx, "/v3/service_instances", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceInstances.List", "/v3/service_instances", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -157,7 +157,17 @@ func (c *ServiceInstanceClient) ListAll(ctx context.Context, opts *ServiceInstan
 	if opts == nil {
 		opts = NewServiceInstanceListOptions()
 	}
-	return AutoPage[*ServiceInstanceListOptions, *resource.ServiceInstance](opts, func(opts *ServiceInstanceListOptions) ([]*resource.ServiceInstance, *Pager, error) {
+	return AutoPageConcurrent[*ServiceInstanceListOptions, *resource.ServiceInstance](opts, c.client.PageConcurrency(), func(opts *ServiceInstanceListOptions) ([]*resource.ServiceInstance, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all service instances the user has access to
+func (c *ServiceInstanceClient) Iter(ctx context.Context, opts *ServiceInstanceListOptions) Seq2[*resource.ServiceInstance, error] {
+	if opts == nil {
+		opts = NewServiceInstanceListOptions()
+	}
+	return AutoPageIter[*ServiceInstanceListOptions, *resource.ServiceInstance](ctx, opts, func(opts *ServiceInstanceListOptions) ([]*resource.ServiceInstance, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -175,7 +185,7 @@ func (c *ServiceInstanceClient) ShareWithSpace(ctx context.Context, guid string,
 func (c *ServiceInstanceClient) ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.ServiceInstanceSharedSpaceRelationships, error) {
 	req := resource.NewToManyRelationships(spaceGUIDs)
 	var relationships resource.ServiceInstanceSharedSpaceRelationships
-	_, err := c.client.post(ctx, path.Format("/v3/service_instances/%s/relationships/shared_spaces", guid), req, &relationships)
+	_, err := c.client.post(ctx, "ServiceInstances.ShareWithSpaces", path.Format("/v3/service_instances/%s/relationships/shared_spaces", guid), req, &relationships)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +204,7 @@ func (c *ServiceInstanceClient) Single(ctx context.Context, opts *ServiceInstanc
 // This will automatically unbind any applications bound to this service instance in the specified space
 // Un-sharing a service instance from a space will not delete any service keys
 func (c *ServiceInstanceClient) UnShareWithSpace(ctx context.Context, guid string, spaceGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/service_instances/%s/relationships/shared_spaces/%s", guid, spaceGUID))
+	_, err := c.client.delete(ctx, "ServiceInstances.UnShareWithSpace", path.Format("/v3/service_instances/%s/relationships/shared_spaces/%s", guid, spaceGUID))
 	return err
 }
 
@@ -219,7 +229,7 @@ func (c *ServiceInstanceClient) UnShareWithSpaces(ctx context.Context, guid stri
 // instance object, all other updates return a jobGUID
 func (c *ServiceInstanceClient) UpdateManaged(ctx context.Context, guid string, r *resource.ServiceInstanceManagedUpdate) (string, *resource.ServiceInstance, error) {
 	var si resource.ServiceInstance
-	jobGUID, err := c.client.patch(ctx, path.Format("/v3/service_instances/%s", guid), r, &si)
+	jobGUID, err := c.client.patch(ctx, "ServiceInstances.UpdateManaged", path.Format("/v3/service_instances/%s", guid), r, &si)
 	if err != nil {
 		return "", nil, err
 	}
@@ -233,7 +243,7 @@ func (c *ServiceInstanceClient) UpdateManaged(ctx context.Context, guid string,
 // service instance object
 func (c *ServiceInstanceClient) UpdateUserProvided(ctx context.Context, guid string, r *resource.ServiceInstanceUserProvidedUpdate) (*resource.ServiceInstance, error) {
 	var si resource.ServiceInstance
-	_, err := c.client.patch(ctx, path.Format("/v3/service_instances/%s", guid), r, &si)
+	_, err := c.client.patch(ctx, "ServiceInstances.UpdateUserProvided", path.Format("/v3/service_instances/%s", guid), r, &si)
 	if err != nil {
 		return nil, err
 	}
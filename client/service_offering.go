@@ -36,7 +36,7 @@ func (o ServiceOfferingListOptions) ToQueryString() (url.Values, error) {
 
 // Delete the specified service offering
 func (c *ServiceOfferingClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/service_offerings/%s", guid))
+	_, err := c.client.delete(ctx, "ServiceOfferings.Delete", path.Format("/v3/service_offerings/%s", guid))
 	return err
 }
 
@@ -50,7 +50,7 @@ func (c *ServiceOfferingClient) First(ctx context.Context, opts *ServiceOffering
 // Get the specified service offering
 func (c *ServiceOfferingClient) Get(ctx context.Context, guid string) (*resource.ServiceOffering, error) {
 	var ServiceOffering resource.ServiceOffering
-	err := c.client.get(ctx, path.Format("/v3/service_offerings/%s", guid), &ServiceOffering)
+	err := c.client.get(ctx, "ServiceOfferings.Get", path.Format("/v3/service_offerings/%s", guid), &ServiceOffering)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +64,7 @@ func (c *ServiceOfferingClient) List(ctx context.Context, opts *ServiceOfferingL
 	}
 
 	var res resource.ServiceOfferingList
-	err := c.client.list(ctx, "/v3/service_offerings", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceOfferings.List", "/v3/service_offerings", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -77,7 +77,17 @@ func (c *ServiceOfferingClient) ListAll(ctx context.Context, opts *ServiceOfferi
 	if opts == nil {
 		opts = NewServiceOfferingListOptions()
 	}
-	return AutoPage[*ServiceOfferingListOptions, *resource.ServiceOffering](opts, func(opts *ServiceOfferingListOptions) ([]*resource.ServiceOffering, *Pager, error) {
+	return AutoPageConcurrent[*ServiceOfferingListOptions, *resource.ServiceOffering](opts, c.client.PageConcurrency(), func(opts *ServiceOfferingListOptions) ([]*resource.ServiceOffering, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all service offerings the user has access to
+func (c *ServiceOfferingClient) Iter(ctx context.Context, opts *ServiceOfferingListOptions) Seq2[*resource.ServiceOffering, error] {
+	if opts == nil {
+		opts = NewServiceOfferingListOptions()
+	}
+	return AutoPageIter[*ServiceOfferingListOptions, *resource.ServiceOffering](ctx, opts, func(opts *ServiceOfferingListOptions) ([]*resource.ServiceOffering, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -92,7 +102,7 @@ func (c *ServiceOfferingClient) Single(ctx context.Context, opts *ServiceOfferin
 // Update the specified attributes of the service offering
 func (c *ServiceOfferingClient) Update(ctx context.Context, guid string, r *resource.ServiceOfferingUpdate) (*resource.ServiceOffering, error) {
 	var res resource.ServiceOffering
-	_, err := c.client.patch(ctx, path.Format("/v3/service_offerings/%s", guid), r, &res)
+	_, err := c.client.patch(ctx, "ServiceOfferings.Update", path.Format("/v3/service_offerings/%s", guid), r, &res)
 	if err != nil {
 		return nil, err
 	}
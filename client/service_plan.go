@@ -42,7 +42,7 @@ func (o ServicePlanListOptions) ToQueryString() (url.Values, error) {
 
 // Delete the specified service plan
 func (c *ServicePlanClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/service_plans/%s", guid))
+	_, err := c.client.delete(ctx, "ServicePlans.Delete", path.Format("/v3/service_plans/%s", guid))
 	return err
 }
 
@@ -56,7 +56,7 @@ func (c *ServicePlanClient) First(ctx context.Context, opts *ServicePlanListOpti
 // Get the specified service plan
 func (c *ServicePlanClient) Get(ctx context.Context, guid string) (*resource.ServicePlan, error) {
 	var ServicePlan resource.ServicePlan
-	err := c.client.get(ctx, path.Format("/v3/service_plans/%s", guid), &ServicePlan)
+	err := c.client.get(ctx, "ServicePlans.Get", path.Format("/v3/service_plans/%s", guid), &ServicePlan)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (c *ServicePlanClient) Get(ctx context.Context, guid string) (*resource.Ser
 // GetIncludeServicePlan allows callers to fetch a service plan and include the associated service offering
 func (c *ServicePlanClient) GetIncludeServicePlan(ctx context.Context, guid string) (*resource.ServicePlan, *resource.ServiceOffering, error) {
 	var servicePlan resource.ServicePlanWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/service_plans/%s?include=%s", guid, resource.ServicePlanIncludeServiceOffering), &servicePlan)
+	err := c.client.get(ctx, "ServicePlans.GetIncludeServicePlan", path.Format("/v3/service_plans/%s?include=%s", guid, resource.ServicePlanIncludeServiceOffering), &servicePlan)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -76,7 +76,7 @@ func (c *ServicePlanClient) GetIncludeServicePlan(ctx context.Context, guid stri
 // GetIncludeSpaceAndOrganization allows callers to fetch a service plan and include the parent space and organization
 func (c *ServicePlanClient) GetIncludeSpaceAndOrganization(ctx context.Context, guid string) (*resource.ServicePlan, *resource.Space, *resource.Organization, error) {
 	var servicePlan resource.ServicePlanWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/service_plans/%s?include=%s", guid, resource.ServicePlanIncludeSpaceOrganization), &servicePlan)
+	err := c.client.get(ctx, "ServicePlans.GetIncludeSpaceAndOrganization", path.Format("/v3/service_plans/%s?include=%s", guid, resource.ServicePlanIncludeSpaceOrganization), &servicePlan)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -90,7 +90,7 @@ func (c *ServicePlanClient) List(ctx context.Context, opts *ServicePlanListOptio
 	}
 
 	var res resource.ServicePlanList
-	err := c.client.list(ctx, "/v3/service_plans", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServicePlans.List", "/v3/service_plans", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,7 +103,17 @@ func (c *ServicePlanClient) ListAll(ctx context.Context, opts *ServicePlanListOp
 	if opts == nil {
 		opts = NewServicePlanListOptions()
 	}
-	return AutoPage[*ServicePlanListOptions, *resource.ServicePlan](opts, func(opts *ServicePlanListOptions) ([]*resource.ServicePlan, *Pager, error) {
+	return AutoPageConcurrent[*ServicePlanListOptions, *resource.ServicePlan](opts, c.client.PageConcurrency(), func(opts *ServicePlanListOptions) ([]*resource.ServicePlan, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all service plans the user has access to
+func (c *ServicePlanClient) Iter(ctx context.Context, opts *ServicePlanListOptions) Seq2[*resource.ServicePlan, error] {
+	if opts == nil {
+		opts = NewServicePlanListOptions()
+	}
+	return AutoPageIter[*ServicePlanListOptions, *resource.ServicePlan](ctx, opts, func(opts *ServicePlanListOptions) ([]*resource.ServicePlan, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -116,7 +126,7 @@ func (c *ServicePlanClient) ListIncludeServiceOffering(ctx context.Context, opts
 	opts.Include = resource.ServicePlanIncludeServiceOffering
 
 	var res resource.ServicePlanList
-	err := c.client.list(ctx, "/v3/service_plans", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServicePlans.ListIncludeServiceOffering", "/v3/service_plans", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -125,6 +135,9 @@ func (c *ServicePlanClient) ListIncludeServiceOffering(ctx context.Context, opts
 }
 
 // ListIncludeServiceOfferingAll retrieves all service plans the user has access to and include the associated service offerings
+//
+// There's no iterator over the resources with their included resources, use ListIncludeServiceOffering to fetch them a page at
+// a time instead.
 func (c *ServicePlanClient) ListIncludeServiceOfferingAll(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.ServiceOffering, error) {
 	if opts == nil {
 		opts = NewServicePlanListOptions()
@@ -155,7 +168,7 @@ func (c *ServicePlanClient) ListIncludeSpacesAndOrganizations(ctx context.Contex
 	opts.Include = resource.ServicePlanIncludeSpaceOrganization
 
 	var res resource.ServicePlanList
-	err := c.client.list(ctx, "/v3/service_plans", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServicePlans.ListIncludeSpacesAndOrganizations", "/v3/service_plans", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -164,6 +177,9 @@ func (c *ServicePlanClient) ListIncludeSpacesAndOrganizations(ctx context.Contex
 }
 
 // ListIncludeSpacesAndOrganizationsAll retrieves all service plans the user has access to and include the associated spaces and organizations
+//
+// There's no iterator over the resources with their included resources, use ListIncludeSpacesAndOrganizations to fetch them a page at
+// a time instead.
 func (c *ServicePlanClient) ListIncludeSpacesAndOrganizationsAll(ctx context.Context, opts *ServicePlanListOptions) ([]*resource.ServicePlan, []*resource.Space, []*resource.Organization, error) {
 	if opts == nil {
 		opts = NewServicePlanListOptions()
@@ -198,7 +214,7 @@ func (c *ServicePlanClient) Single(ctx context.Context, opts *ServicePlanListOpt
 // Update the specified attributes of the service plan
 func (c *ServicePlanClient) Update(ctx context.Context, guid string, r *resource.ServicePlanUpdate) (*resource.ServicePlan, error) {
 	var res resource.ServicePlan
-	_, err := c.client.patch(ctx, path.Format("/v3/service_plans/%s", guid), r, &res)
+	_, err := c.client.patch(ctx, "ServicePlans.Update", path.Format("/v3/service_plans/%s", guid), r, &res)
 	if err != nil {
 		return nil, err
 	}
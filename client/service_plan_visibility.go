@@ -13,7 +13,7 @@ type ServicePlanVisibilityClient commonClient
 // organization visible
 func (c *ServicePlanVisibilityClient) Apply(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error) {
 	var res resource.ServicePlanVisibility
-	_, err := c.client.post(ctx, path.Format("/v3/service_plans/%s/visibility", servicePlanGUID), r, &res)
+	_, err := c.client.post(ctx, "ServicePlansVisibility.Apply", path.Format("/v3/service_plans/%s/visibility", servicePlanGUID), r, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -23,14 +23,14 @@ func (c *ServicePlanVisibilityClient) Apply(ctx context.Context, servicePlanGUID
 // Delete an organization from a service plan visibility list of organizations
 // It is only defined for service plans which are organization restricted
 func (c *ServicePlanVisibilityClient) Delete(ctx context.Context, servicePlanGUID, organizationGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/service_plans/%s/visibility/%s", servicePlanGUID, organizationGUID))
+	_, err := c.client.delete(ctx, "ServicePlansVisibility.Delete", path.Format("/v3/service_plans/%s/visibility/%s", servicePlanGUID, organizationGUID))
 	return err
 }
 
 // Get the specified service plan visibility
 func (c *ServicePlanVisibilityClient) Get(ctx context.Context, servicePlanGUID string) (*resource.ServicePlanVisibility, error) {
 	var s resource.ServicePlanVisibility
-	err := c.client.get(ctx, path.Format("/v3/service_plans/%s/visibility", servicePlanGUID), &s)
+	err := c.client.get(ctx, "ServicePlansVisibility.Get", path.Format("/v3/service_plans/%s/visibility", servicePlanGUID), &s)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (c *ServicePlanVisibilityClient) Get(ctx context.Context, servicePlanGUID s
 // organization visible
 func (c *ServicePlanVisibilityClient) Update(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error) {
 	var res resource.ServicePlanVisibility
-	_, err := c.client.patch(ctx, path.Format("/v3/service_plans/%s/visibility", servicePlanGUID), r, &res)
+	_, err := c.client.patch(ctx, "ServicePlansVisibility.Update", path.Format("/v3/service_plans/%s/visibility", servicePlanGUID), r, &res)
 	if err != nil {
 		return nil, err
 	}
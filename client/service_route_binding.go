@@ -37,7 +37,7 @@ func (o ServiceRouteBindingListOptions) ToQueryString() (url.Values, error) {
 // service route binding object for user provided service instances
 func (c *ServiceRouteBindingClient) Create(ctx context.Context, r *resource.ServiceRouteBindingCreate) (string, *resource.ServiceRouteBinding, error) {
 	var srb resource.ServiceRouteBinding
-	jobGUID, err := c.client.post(ctx, "/v3/service_route_bindings", r, &srb)
+	jobGUID, err := c.client.post(ctx, "ServiceRouteBindings.Create", "/v3/service_route_bindings", r, &srb)
 	if err != nil {
 		return "", nil, err
 	}
@@ -50,7 +50,7 @@ func (c *ServiceRouteBindingClient) Create(ctx context.Context, r *resource.Serv
 // Delete the specified service route binding returning the jobGUID for managed service instances or empty string
 // for user provided service instances
 func (c *ServiceRouteBindingClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/service_route_bindings/%s", guid))
+	return c.client.delete(ctx, "ServiceRouteBindings.Delete", path.Format("/v3/service_route_bindings/%s", guid))
 }
 
 // First returns the first service route binding matching the options or an error when less than 1 match
@@ -63,7 +63,7 @@ func (c *ServiceRouteBindingClient) First(ctx context.Context, opts *ServiceRout
 // Get the specified service route binding
 func (c *ServiceRouteBindingClient) Get(ctx context.Context, guid string) (*resource.ServiceRouteBinding, error) {
 	var srb resource.ServiceRouteBinding
-	err := c.client.get(ctx, path.Format("/v3/service_route_bindings/%s", guid), &srb)
+	err := c.client.get(ctx, "ServiceRouteBindings.Get", path.Format("/v3/service_route_bindings/%s", guid), &srb)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +73,7 @@ func (c *ServiceRouteBindingClient) Get(ctx context.Context, guid string) (*reso
 // GetIncludeRoute allows callers to fetch a service route binding and include the associated route
 func (c *ServiceRouteBindingClient) GetIncludeRoute(ctx context.Context, guid string) (*resource.ServiceRouteBinding, *resource.Route, error) {
 	var srb resource.ServiceRouteBindingWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/service_route_bindings/%s?include=%s", guid, resource.ServiceRouteBindingIncludeRoute), &srb)
+	err := c.client.get(ctx, "ServiceRouteBindings.GetIncludeRoute", path.Format("/v3/service_route_bindings/%s?include=%s", guid, resource.ServiceRouteBindingIncludeRoute), &srb)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,7 +83,7 @@ func (c *ServiceRouteBindingClient) GetIncludeRoute(ctx context.Context, guid st
 // GetIncludeServiceInstance allows callers to fetch a service route binding and include the associated service instance
 func (c *ServiceRouteBindingClient) GetIncludeServiceInstance(ctx context.Context, guid string) (*resource.ServiceRouteBinding, *resource.ServiceInstance, error) {
 	var srb resource.ServiceRouteBindingWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/service_route_bindings/%s?include=%s", guid, resource.ServiceRouteBindingIncludeServiceInstance), &srb)
+	err := c.client.get(ctx, "ServiceRouteBindings.GetIncludeServiceInstance", path.Format("/v3/service_route_bindings/%s?include=%s", guid, resource.ServiceRouteBindingIncludeServiceInstance), &srb)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,7 +93,7 @@ func (c *ServiceRouteBindingClient) GetIncludeServiceInstance(ctx context.Contex
 // GetParameters queries the Service Broker for the parameters associated with this service route binding
 func (c *ServiceRouteBindingClient) GetParameters(ctx context.Context, guid string) (map[string]string, error) {
 	var srbEnv map[string]string
-	err := c.client.get(ctx, path.Format("/v3/service_route_bindings/%s/parameters", guid), &srbEnv)
+	err := c.client.get(ctx, "ServiceRouteBindings.GetParameters", path.Format("/v3/service_route_bindings/%s/parameters", guid), &srbEnv)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +108,7 @@ func (c *ServiceRouteBindingClient) List(ctx context.Context, opts *ServiceRoute
 	opts.Include = resource.ServiceRouteBindingIncludeNone
 
 	var res resource.ServiceRouteBindingList
-	err := c.client.list(ctx, "/v3/service_route_bindings", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceRouteBindings.List", "/v3/service_route_bindings", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -121,7 +121,17 @@ func (c *ServiceRouteBindingClient) ListAll(ctx context.Context, opts *ServiceRo
 	if opts == nil {
 		opts = NewServiceRouteBindingListOptions()
 	}
-	return AutoPage[*ServiceRouteBindingListOptions, *resource.ServiceRouteBinding](opts, func(opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, *Pager, error) {
+	return AutoPageConcurrent[*ServiceRouteBindingListOptions, *resource.ServiceRouteBinding](opts, c.client.PageConcurrency(), func(opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all service route bindings the user has access to
+func (c *ServiceRouteBindingClient) Iter(ctx context.Context, opts *ServiceRouteBindingListOptions) Seq2[*resource.ServiceRouteBinding, error] {
+	if opts == nil {
+		opts = NewServiceRouteBindingListOptions()
+	}
+	return AutoPageIter[*ServiceRouteBindingListOptions, *resource.ServiceRouteBinding](ctx, opts, func(opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -134,7 +144,7 @@ func (c *ServiceRouteBindingClient) ListIncludeRoutes(ctx context.Context, opts
 	opts.Include = resource.ServiceRouteBindingIncludeNone
 
 	var res resource.ServiceRouteBindingList
-	err := c.client.list(ctx, "/v3/service_route_bindings", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceRouteBindings.ListIncludeRoutes", "/v3/service_route_bindings", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -143,6 +153,9 @@ func (c *ServiceRouteBindingClient) ListIncludeRoutes(ctx context.Context, opts
 }
 
 // ListIncludeRoutesAll retrieves all service route bindings the user has access to and include the associated routes
+//
+// There's no iterator over the resources with their included resources, use ListIncludeRoutes to fetch them a page at
+// a time instead.
 func (c *ServiceRouteBindingClient) ListIncludeRoutesAll(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.Route, error) {
 	if opts == nil {
 		opts = NewServiceRouteBindingListOptions()
@@ -174,7 +187,7 @@ func (c *ServiceRouteBindingClient) ListIncludeServiceInstances(ctx context.Cont
 	opts.Include = resource.ServiceRouteBindingIncludeNone
 
 	var res resource.ServiceRouteBindingList
-	err := c.client.list(ctx, "/v3/service_route_bindings", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceRouteBindings.ListIncludeServiceInstances", "/v3/service_route_bindings", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -184,6 +197,9 @@ func (c *ServiceRouteBindingClient) ListIncludeServiceInstances(ctx context.Cont
 
 // ListIncludeServiceInstancesAll retrieves all service route bindings the user has access to and include the
 // associated service instances
+//
+// There's no iterator over the resources with their included resources, use ListIncludeServiceInstances to fetch
+// them a page at a time instead.
 func (c *ServiceRouteBindingClient) ListIncludeServiceInstancesAll(ctx context.Context, opts *ServiceRouteBindingListOptions) ([]*resource.ServiceRouteBinding, []*resource.ServiceInstance, error) {
 	if opts == nil {
 		opts = NewServiceRouteBindingListOptions()
@@ -216,7 +232,7 @@ func (c *ServiceRouteBindingClient) Single(ctx context.Context, opts *ServiceRou
 // Update the specified attributes of the service route binding
 func (c *ServiceRouteBindingClient) Update(ctx context.Context, guid string, r *resource.ServiceRouteBindingUpdate) (*resource.ServiceRouteBinding, error) {
 	var srb resource.ServiceRouteBinding
-	_, err := c.client.patch(ctx, path.Format("/v3/service_route_bindings/%s", guid), r, &srb)
+	_, err := c.client.patch(ctx, "ServiceRouteBindings.Update", path.Format("/v3/service_route_bindings/%s", guid), r, &srb)
 	if err != nil {
 		return nil, err
 	}
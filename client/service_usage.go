@@ -41,7 +41,7 @@ func (c *ServiceUsageClient) First(ctx context.Context, opts *ServiceUsageListOp
 // Get retrieves the specified service event
 func (c *ServiceUsageClient) Get(ctx context.Context, guid string) (*resource.ServiceUsage, error) {
 	var a resource.ServiceUsage
-	err := c.client.get(ctx, path.Format("/v3/service_usage_events/%s", guid), &a)
+	err := c.client.get(ctx, "ServiceUsageEvents.Get", path.Format("/v3/service_usage_events/%s", guid), &a)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ func (c *ServiceUsageClient) List(ctx context.Context, opts *ServiceUsageListOpt
 		opts = NewServiceUsageOptions()
 	}
 	var res resource.ServiceUsageList
-	err := c.client.list(ctx, "/v3/service_usage_events", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "ServiceUsageEvents.List", "/v3/service_usage_events", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,7 +67,17 @@ func (c *ServiceUsageClient) ListAll(ctx context.Context, opts *ServiceUsageList
 	if opts == nil {
 		opts = NewServiceUsageOptions()
 	}
-	return AutoPage[*ServiceUsageListOptions, *resource.ServiceUsage](opts, func(opts *ServiceUsageListOptions) ([]*resource.ServiceUsage, *Pager, error) {
+	return AutoPageConcurrent[*ServiceUsageListOptions, *resource.ServiceUsage](opts, c.client.PageConcurrency(), func(opts *ServiceUsageListOptions) ([]*resource.ServiceUsage, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all service usage events
+func (c *ServiceUsageClient) Iter(ctx context.Context, opts *ServiceUsageListOptions) Seq2[*resource.ServiceUsage, error] {
+	if opts == nil {
+		opts = NewServiceUsageOptions()
+	}
+	return AutoPageIter[*ServiceUsageListOptions, *resource.ServiceUsage](ctx, opts, func(opts *ServiceUsageListOptions) ([]*resource.ServiceUsage, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -78,7 +88,7 @@ func (c *ServiceUsageClient) ListAll(ctx context.Context, opts *ServiceUsageList
 // There is the potential race condition if service instances are currently being created or deleted.
 // The seeded usage events will have the same guid as the service instance.
 func (c *ServiceUsageClient) Purge(ctx context.Context) error {
-	_, err := c.client.post(ctx, "/v3/service_usage_events/actions/destructively_purge_all_and_reseed", nil, nil)
+	_, err := c.client.post(ctx, "ServiceUsageEvents.Purge", "/v3/service_usage_events/actions/destructively_purge_all_and_reseed", nil, nil)
 	return err
 }
 
@@ -30,7 +30,7 @@ func (o SidecarListOptions) ToQueryString() (url.Values, error) {
 // Create a new app sidecar
 func (c *SidecarClient) Create(ctx context.Context, appGUID string, r *resource.SidecarCreate) (*resource.Sidecar, error) {
 	var sc resource.Sidecar
-	_, err := c.client.post(ctx, path.Format("/v3/apps/%s/sidecars", appGUID), r, &sc)
+	_, err := c.client.post(ctx, "Sidecars.Create", path.Format("/v3/apps/%s/sidecars", appGUID), r, &sc)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +39,7 @@ func (c *SidecarClient) Create(ctx context.Context, appGUID string, r *resource.
 
 // Delete the specified sidecar
 func (c *SidecarClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/sidecars/%s", guid))
+	_, err := c.client.delete(ctx, "Sidecars.Delete", path.Format("/v3/sidecars/%s", guid))
 	return err
 }
 
@@ -60,7 +60,7 @@ func (c *SidecarClient) FirstForProcess(ctx context.Context, processGUID string,
 // Get the specified app
 func (c *SidecarClient) Get(ctx context.Context, guid string) (*resource.Sidecar, error) {
 	var sc resource.Sidecar
-	err := c.client.get(ctx, path.Format("/v3/sidecars/%s", guid), &sc)
+	err := c.client.get(ctx, "Sidecars.Get", path.Format("/v3/sidecars/%s", guid), &sc)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +73,7 @@ func (c *SidecarClient) ListForApp(ctx context.Context, appGUID string, opts *Si
 		opts = NewSidecarListOptions()
 	}
 	var res resource.SidecarList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/sidecars", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Sidecars.ListForApp", "/v3/apps/"+appGUID+"/sidecars", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -86,7 +86,17 @@ func (c *SidecarClient) ListForAppAll(ctx context.Context, appGUID string, opts
 	if opts == nil {
 		opts = NewSidecarListOptions()
 	}
-	return AutoPage[*SidecarListOptions, *resource.Sidecar](opts, func(opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error) {
+	return AutoPageConcurrent[*SidecarListOptions, *resource.Sidecar](opts, c.client.PageConcurrency(), func(opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all sidecars associated with the specified app
+func (c *SidecarClient) IterForApp(ctx context.Context, appGUID string, opts *SidecarListOptions) Seq2[*resource.Sidecar, error] {
+	if opts == nil {
+		opts = NewSidecarListOptions()
+	}
+	return AutoPageIter[*SidecarListOptions, *resource.Sidecar](ctx, opts, func(opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -97,7 +107,7 @@ func (c *SidecarClient) ListForProcess(ctx context.Context, processGUID string,
 		opts = NewSidecarListOptions()
 	}
 	var res resource.SidecarList
-	err := c.client.list(ctx, "/v3/processes/"+processGUID+"/sidecars", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Sidecars.ListForProcess", "/v3/processes/"+processGUID+"/sidecars", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -110,7 +120,17 @@ func (c *SidecarClient) ListForProcessAll(ctx context.Context, processGUID strin
 	if opts == nil {
 		opts = NewSidecarListOptions()
 	}
-	return AutoPage[*SidecarListOptions, *resource.Sidecar](opts, func(opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error) {
+	return AutoPageConcurrent[*SidecarListOptions, *resource.Sidecar](opts, c.client.PageConcurrency(), func(opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error) {
+		return c.ListForProcess(ctx, processGUID, opts)
+	})
+}
+
+// IterForProcess returns an iterator over all sidecars associated with the specified process
+func (c *SidecarClient) IterForProcess(ctx context.Context, processGUID string, opts *SidecarListOptions) Seq2[*resource.Sidecar, error] {
+	if opts == nil {
+		opts = NewSidecarListOptions()
+	}
+	return AutoPageIter[*SidecarListOptions, *resource.Sidecar](ctx, opts, func(opts *SidecarListOptions) ([]*resource.Sidecar, *Pager, error) {
 		return c.ListForProcess(ctx, processGUID, opts)
 	})
 }
@@ -132,7 +152,7 @@ func (c *SidecarClient) SingleForProcess(ctx context.Context, processGUID string
 // Update the specified attributes of the app
 func (c *SidecarClient) Update(ctx context.Context, guid string, r *resource.SidecarUpdate) (*resource.Sidecar, error) {
 	var sc resource.Sidecar
-	_, err := c.client.patch(ctx, path.Format("/v3/sidecars/%s", guid), r, &sc)
+	_, err := c.client.patch(ctx, "Sidecars.Update", path.Format("/v3/sidecars/%s", guid), r, &sc)
 	if err != nil {
 		return nil, err
 	}
@@ -46,14 +46,14 @@ func (c *SpaceClient) AssignIsolationSegment(ctx context.Context, guid, isolatio
 	if isolationSegmentGUID == "" {
 		r.Data.GUID = nil // set data to null to remove the relationship
 	}
-	_, err := c.client.patch(ctx, path.Format("/v3/spaces/%s/relationships/isolation_segment", guid), r, nil)
+	_, err := c.client.patch(ctx, "Spaces.AssignIsolationSegment", path.Format("/v3/spaces/%s/relationships/isolation_segment", guid), r, nil)
 	return err
 }
 
 // Create a new space
 func (c *SpaceClient) Create(ctx context.Context, r *resource.SpaceCreate) (*resource.Space, error) {
 	var space resource.Space
-	_, err := c.client.post(ctx, "/v3/spaces", r, &space)
+	_, err := c.client.post(ctx, "Spaces.Create", "/v3/spaces", r, &space)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +62,7 @@ func (c *SpaceClient) Create(ctx context.Context, r *resource.SpaceCreate) (*res
 
 // Delete the specified space asynchronously and return a jobGUID
 func (c *SpaceClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/spaces/%s", guid))
+	return c.client.delete(ctx, "Spaces.Delete", path.Format("/v3/spaces/%s", guid))
 }
 
 // First returns the first space matching the options or an error when less than 1 match
@@ -75,7 +75,7 @@ func (c *SpaceClient) First(ctx context.Context, opts *SpaceListOptions) (*resou
 // Get the specified space
 func (c *SpaceClient) Get(ctx context.Context, guid string) (*resource.Space, error) {
 	var space resource.Space
-	err := c.client.get(ctx, path.Format("/v3/spaces/%s", guid), &space)
+	err := c.client.get(ctx, "Spaces.Get", path.Format("/v3/spaces/%s", guid), &space)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +85,7 @@ func (c *SpaceClient) Get(ctx context.Context, guid string) (*resource.Space, er
 // GetAssignedIsolationSegment gets the space's assigned isolation segment, if any
 func (c *SpaceClient) GetAssignedIsolationSegment(ctx context.Context, guid string) (string, error) {
 	var relation resource.ToOneRelationship
-	err := c.client.get(ctx, path.Format("/v3/spaces/%s/relationships/isolation_segment", guid), &relation)
+	err := c.client.get(ctx, "Spaces.GetAssignedIsolationSegment", path.Format("/v3/spaces/%s/relationships/isolation_segment", guid), &relation)
 	if err != nil {
 		return "", err
 	}
@@ -98,7 +98,7 @@ func (c *SpaceClient) GetAssignedIsolationSegment(ctx context.Context, guid stri
 // GetIncludeOrganization allows callers to fetch a space and include the parent organization
 func (c *SpaceClient) GetIncludeOrganization(ctx context.Context, guid string) (*resource.Space, *resource.Organization, error) {
 	var space resource.SpaceWithIncluded
-	err := c.client.get(ctx, path.Format("/v3/spaces/%s?include=%s", guid, resource.SpaceIncludeOrganization), &space)
+	err := c.client.get(ctx, "Spaces.GetIncludeOrganization", path.Format("/v3/spaces/%s?include=%s", guid, resource.SpaceIncludeOrganization), &space)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -113,7 +113,7 @@ func (c *SpaceClient) List(ctx context.Context, opts *SpaceListOptions) ([]*reso
 	opts.Include = resource.SpaceIncludeNone
 
 	var res resource.SpaceList
-	err := c.client.list(ctx, "/v3/spaces", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Spaces.List", "/v3/spaces", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -126,7 +126,17 @@ func (c *SpaceClient) ListAll(ctx context.Context, opts *SpaceListOptions) ([]*r
 	if opts == nil {
 		opts = NewSpaceListOptions()
 	}
-	return AutoPage[*SpaceListOptions, *resource.Space](opts, func(opts *SpaceListOptions) ([]*resource.Space, *Pager, error) {
+	return AutoPageConcurrent[*SpaceListOptions, *resource.Space](opts, c.client.PageConcurrency(), func(opts *SpaceListOptions) ([]*resource.Space, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all spaces the user has access to
+func (c *SpaceClient) Iter(ctx context.Context, opts *SpaceListOptions) Seq2[*resource.Space, error] {
+	if opts == nil {
+		opts = NewSpaceListOptions()
+	}
+	return AutoPageIter[*SpaceListOptions, *resource.Space](ctx, opts, func(opts *SpaceListOptions) ([]*resource.Space, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -139,7 +149,7 @@ func (c *SpaceClient) ListIncludeOrganizations(ctx context.Context, opts *SpaceL
 	opts.Include = resource.SpaceIncludeOrganization
 
 	var res resource.SpaceList
-	err := c.client.list(ctx, "/v3/spaces", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Spaces.ListIncludeOrganizations", "/v3/spaces", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -148,6 +158,9 @@ func (c *SpaceClient) ListIncludeOrganizations(ctx context.Context, opts *SpaceL
 }
 
 // ListIncludeOrganizationsAll retrieves all spaces the user has access to and include the parent organizations
+//
+// There's no iterator over the resources with their included resources, use ListIncludeOrganizations to fetch them a page at
+// a time instead.
 func (c *SpaceClient) ListIncludeOrganizationsAll(ctx context.Context, opts *SpaceListOptions) ([]*resource.Space, []*resource.Organization, error) {
 	if opts == nil {
 		opts = NewSpaceListOptions()
@@ -176,7 +189,7 @@ func (c *SpaceClient) ListUsers(ctx context.Context, spaceGUID string, opts *Use
 		opts = NewUserListOptions()
 	}
 	var res resource.UserList
-	err := c.client.list(ctx, "/v3/spaces/"+spaceGUID+"/users", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Spaces.ListUsers", "/v3/spaces/"+spaceGUID+"/users", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +202,17 @@ func (c *SpaceClient) ListUsersAll(ctx context.Context, spaceGUID string, opts *
 	if opts == nil {
 		opts = NewUserListOptions()
 	}
-	return AutoPage[*UserListOptions, *resource.User](opts, func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
+	return AutoPageConcurrent[*UserListOptions, *resource.User](opts, c.client.PageConcurrency(), func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
+		return c.ListUsers(ctx, spaceGUID, opts)
+	})
+}
+
+// IterUsers returns an iterator over all users by space GUID
+func (c *SpaceClient) IterUsers(ctx context.Context, spaceGUID string, opts *UserListOptions) Seq2[*resource.User, error] {
+	if opts == nil {
+		opts = NewUserListOptions()
+	}
+	return AutoPageIter[*UserListOptions, *resource.User](ctx, opts, func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
 		return c.ListUsers(ctx, spaceGUID, opts)
 	})
 }
@@ -204,7 +227,7 @@ func (c *SpaceClient) Single(ctx context.Context, opts *SpaceListOptions) (*reso
 // Update the specified attributes of a space
 func (c *SpaceClient) Update(ctx context.Context, guid string, r *resource.SpaceUpdate) (*resource.Space, error) {
 	var space resource.Space
-	_, err := c.client.patch(ctx, path.Format("/v3/spaces/%s", guid), r, &space)
+	_, err := c.client.patch(ctx, "Spaces.Update", path.Format("/v3/spaces/%s", guid), r, &space)
 	if err != nil {
 		return nil, err
 	}
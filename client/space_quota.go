@@ -36,7 +36,7 @@ func (o SpaceQuotaListOptions) ToQueryString() (url.Values, error) {
 func (c *SpaceQuotaClient) Apply(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
 	req := resource.NewToManyRelationships(spaceGUIDs)
 	var relation resource.ToManyRelationships
-	_, err := c.client.post(ctx, path.Format("/v3/space_quotas/%s/relationships/spaces", guid), req, &relation)
+	_, err := c.client.post(ctx, "SpaceQuotas.Apply", path.Format("/v3/space_quotas/%s/relationships/spaces", guid), req, &relation)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +50,7 @@ func (c *SpaceQuotaClient) Apply(ctx context.Context, guid string, spaceGUIDs []
 // Create a new space quota
 func (c *SpaceQuotaClient) Create(ctx context.Context, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error) {
 	var q resource.SpaceQuota
-	_, err := c.client.post(ctx, "/v3/space_quotas", r, &q)
+	_, err := c.client.post(ctx, "SpaceQuotas.Create", "/v3/space_quotas", r, &q)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ func (c *SpaceQuotaClient) Create(ctx context.Context, r *resource.SpaceQuotaCre
 
 // Delete the specified space quota asynchronously and return a jobGUID
 func (c *SpaceQuotaClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/space_quotas/%s", guid))
+	return c.client.delete(ctx, "SpaceQuotas.Delete", path.Format("/v3/space_quotas/%s", guid))
 }
 
 // First returns the first space quota matching the options or an error when less than 1 match
@@ -72,7 +72,7 @@ func (c *SpaceQuotaClient) First(ctx context.Context, opts *SpaceQuotaListOption
 // Get the specified space quota
 func (c *SpaceQuotaClient) Get(ctx context.Context, guid string) (*resource.SpaceQuota, error) {
 	var q resource.SpaceQuota
-	err := c.client.get(ctx, path.Format("/v3/space_quotas/%s", guid), &q)
+	err := c.client.get(ctx, "SpaceQuotas.Get", path.Format("/v3/space_quotas/%s", guid), &q)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +86,7 @@ func (c *SpaceQuotaClient) List(ctx context.Context, opts *SpaceQuotaListOptions
 	}
 
 	var res resource.SpaceQuotaList
-	err := c.client.list(ctx, "/v3/space_quotas", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "SpaceQuotas.List", "/v3/space_quotas", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -99,14 +99,24 @@ func (c *SpaceQuotaClient) ListAll(ctx context.Context, opts *SpaceQuotaListOpti
 	if opts == nil {
 		opts = NewSpaceQuotaListOptions()
 	}
-	return AutoPage[*SpaceQuotaListOptions, *resource.SpaceQuota](opts, func(opts *SpaceQuotaListOptions) ([]*resource.SpaceQuota, *Pager, error) {
+	return AutoPageConcurrent[*SpaceQuotaListOptions, *resource.SpaceQuota](opts, c.client.PageConcurrency(), func(opts *SpaceQuotaListOptions) ([]*resource.SpaceQuota, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all space quotas the user has access to
+func (c *SpaceQuotaClient) Iter(ctx context.Context, opts *SpaceQuotaListOptions) Seq2[*resource.SpaceQuota, error] {
+	if opts == nil {
+		opts = NewSpaceQuotaListOptions()
+	}
+	return AutoPageIter[*SpaceQuotaListOptions, *resource.SpaceQuota](ctx, opts, func(opts *SpaceQuotaListOptions) ([]*resource.SpaceQuota, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
 
 // Remove the space quota from the specified space
 func (c *SpaceQuotaClient) Remove(ctx context.Context, guid, spaceGUID string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/space_quotas/%s/relationships/spaces/%s", guid, spaceGUID))
+	_, err := c.client.delete(ctx, "SpaceQuotas.Remove", path.Format("/v3/space_quotas/%s/relationships/spaces/%s", guid, spaceGUID))
 	return err
 }
 
@@ -120,7 +130,7 @@ func (c *SpaceQuotaClient) Single(ctx context.Context, opts *SpaceQuotaListOptio
 // Update the specified attributes of the organization quota
 func (c *SpaceQuotaClient) Update(ctx context.Context, guid string, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error) {
 	var q resource.SpaceQuota
-	_, err := c.client.patch(ctx, path.Format("/v3/space_quotas/%s", guid), r, &q)
+	_, err := c.client.patch(ctx, "SpaceQuotas.Update", path.Format("/v3/space_quotas/%s", guid), r, &q)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +31,7 @@ func (o StackListOptions) ToQueryString() (url.Values, error) {
 // Create a new stack
 func (c *StackClient) Create(ctx context.Context, r *resource.StackCreate) (*resource.Stack, error) {
 	var stack resource.Stack
-	_, err := c.client.post(ctx, "/v3/stacks", r, &stack)
+	_, err := c.client.post(ctx, "Stacks.Create", "/v3/stacks", r, &stack)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (c *StackClient) Create(ctx context.Context, r *resource.StackCreate) (*res
 
 // Delete the specified stack
 func (c *StackClient) Delete(ctx context.Context, guid string) error {
-	_, err := c.client.delete(ctx, path.Format("/v3/stacks/%s", guid))
+	_, err := c.client.delete(ctx, "Stacks.Delete", path.Format("/v3/stacks/%s", guid))
 	return err
 }
 
@@ -54,7 +54,7 @@ func (c *StackClient) First(ctx context.Context, opts *StackListOptions) (*resou
 // Get the specified stack
 func (c *StackClient) Get(ctx context.Context, guid string) (*resource.Stack, error) {
 	var stack resource.Stack
-	err := c.client.get(ctx, path.Format("/v3/stacks/%s", guid), &stack)
+	err := c.client.get(ctx, "Stacks.Get", path.Format("/v3/stacks/%s", guid), &stack)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +67,7 @@ func (c *StackClient) List(ctx context.Context, opts *StackListOptions) ([]*reso
 		opts = NewStackListOptions()
 	}
 	var res resource.StackList
-	err := c.client.list(ctx, "/v3/stacks", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Stacks.List", "/v3/stacks", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,7 +80,17 @@ func (c *StackClient) ListAll(ctx context.Context, opts *StackListOptions) ([]*r
 	if opts == nil {
 		opts = NewStackListOptions()
 	}
-	return AutoPage[*StackListOptions, *resource.Stack](opts, func(opts *StackListOptions) ([]*resource.Stack, *Pager, error) {
+	return AutoPageConcurrent[*StackListOptions, *resource.Stack](opts, c.client.PageConcurrency(), func(opts *StackListOptions) ([]*resource.Stack, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all stacks the user has access to
+func (c *StackClient) Iter(ctx context.Context, opts *StackListOptions) Seq2[*resource.Stack, error] {
+	if opts == nil {
+		opts = NewStackListOptions()
+	}
+	return AutoPageIter[*StackListOptions, *resource.Stack](ctx, opts, func(opts *StackListOptions) ([]*resource.Stack, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -91,7 +101,7 @@ func (c *StackClient) ListAppsOnStack(ctx context.Context, guid string, opts *St
 		opts = NewStackListOptions()
 	}
 	var res resource.AppList
-	err := c.client.list(ctx, "/v3/stacks/"+guid+"/apps", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Stacks.ListAppsOnStack", "/v3/stacks/"+guid+"/apps", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -104,7 +114,17 @@ func (c *StackClient) ListAppsOnStackAll(ctx context.Context, guid string, opts
 	if opts == nil {
 		opts = NewStackListOptions()
 	}
-	return AutoPage[*StackListOptions, *resource.App](opts, func(opts *StackListOptions) ([]*resource.App, *Pager, error) {
+	return AutoPageConcurrent[*StackListOptions, *resource.App](opts, c.client.PageConcurrency(), func(opts *StackListOptions) ([]*resource.App, *Pager, error) {
+		return c.ListAppsOnStack(ctx, guid, opts)
+	})
+}
+
+// IterAppsOnStack returns an iterator over all apps using a given stack
+func (c *StackClient) IterAppsOnStack(ctx context.Context, guid string, opts *StackListOptions) Seq2[*resource.App, error] {
+	if opts == nil {
+		opts = NewStackListOptions()
+	}
+	return AutoPageIter[*StackListOptions, *resource.App](ctx, opts, func(opts *StackListOptions) ([]*resource.App, *Pager, error) {
 		return c.ListAppsOnStack(ctx, guid, opts)
 	})
 }
@@ -119,7 +139,7 @@ func (c *StackClient) Single(ctx context.Context, opts *StackListOptions) (*reso
 // Update the specified attributes of a stack
 func (c *StackClient) Update(ctx context.Context, guid string, r *resource.StackUpdate) (*resource.Stack, error) {
 	var stack resource.Stack
-	_, err := c.client.patch(ctx, path.Format("/v3/stacks/%s", guid), r, &stack)
+	_, err := c.client.patch(ctx, "Stacks.Update", path.Format("/v3/stacks/%s", guid), r, &stack)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (o TaskListOptions) ToQueryString() (url.Values, error) {
 // task when the request is executed. Canceling a task that is in SUCCEEDED or FAILED state will return an error.
 func (c *TaskClient) Cancel(ctx context.Context, guid string) (*resource.Task, error) {
 	var task resource.Task
-	_, err := c.client.post(ctx, path.Format("/v3/tasks/%s/actions/cancel", guid), nil, &task)
+	_, err := c.client.post(ctx, "Tasks.Cancel", path.Format("/v3/tasks/%s/actions/cancel", guid), nil, &task)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +50,7 @@ func (c *TaskClient) Cancel(ctx context.Context, guid string) (*resource.Task, e
 // Create a new task for the specified app
 func (c *TaskClient) Create(ctx context.Context, appGUID string, r *resource.TaskCreate) (*resource.Task, error) {
 	var task resource.Task
-	_, err := c.client.post(ctx, path.Format("/v3/apps/%s/tasks", appGUID), r, &task)
+	_, err := c.client.post(ctx, "Tasks.Create", path.Format("/v3/apps/%s/tasks", appGUID), r, &task)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func (c *TaskClient) FirstForApp(ctx context.Context, appGUID string, opts *Task
 // Get the specified task
 func (c *TaskClient) Get(ctx context.Context, guid string) (*resource.Task, error) {
 	var task resource.Task
-	err := c.client.get(ctx, path.Format("/v3/tasks/%s", guid), &task)
+	err := c.client.get(ctx, "Tasks.Get", path.Format("/v3/tasks/%s", guid), &task)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +88,7 @@ func (c *TaskClient) List(ctx context.Context, opts *TaskListOptions) ([]*resour
 	}
 
 	var res resource.TaskList
-	err := c.client.list(ctx, "/v3/tasks", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Tasks.List", "/v3/tasks", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,7 +101,17 @@ func (c *TaskClient) ListAll(ctx context.Context, opts *TaskListOptions) ([]*res
 	if opts == nil {
 		opts = NewTaskListOptions()
 	}
-	return AutoPage[*TaskListOptions, *resource.Task](opts, func(opts *TaskListOptions) ([]*resource.Task, *Pager, error) {
+	return AutoPageConcurrent[*TaskListOptions, *resource.Task](opts, c.client.PageConcurrency(), func(opts *TaskListOptions) ([]*resource.Task, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all tasks the user has access to. The command field is excluded in the response.
+func (c *TaskClient) Iter(ctx context.Context, opts *TaskListOptions) Seq2[*resource.Task, error] {
+	if opts == nil {
+		opts = NewTaskListOptions()
+	}
+	return AutoPageIter[*TaskListOptions, *resource.Task](ctx, opts, func(opts *TaskListOptions) ([]*resource.Task, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -114,7 +124,7 @@ func (c *TaskClient) ListForApp(ctx context.Context, appGUID string, opts *TaskL
 	}
 
 	var res resource.TaskList
-	err := c.client.list(ctx, "/v3/apps/"+appGUID+"/tasks", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Tasks.ListForApp", "/v3/apps/"+appGUID+"/tasks", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,7 +138,18 @@ func (c *TaskClient) ListForAppAll(ctx context.Context, appGUID string, opts *Ta
 	if opts == nil {
 		opts = NewTaskListOptions()
 	}
-	return AutoPage[*TaskListOptions, *resource.Task](opts, func(opts *TaskListOptions) ([]*resource.Task, *Pager, error) {
+	return AutoPageConcurrent[*TaskListOptions, *resource.Task](opts, c.client.PageConcurrency(), func(opts *TaskListOptions) ([]*resource.Task, *Pager, error) {
+		return c.ListForApp(ctx, appGUID, opts)
+	})
+}
+
+// IterForApp returns an iterator over all tasks for the specified app that the user has access to. The command field
+// may be excluded in the response based on the user’s role.
+func (c *TaskClient) IterForApp(ctx context.Context, appGUID string, opts *TaskListOptions) Seq2[*resource.Task, error] {
+	if opts == nil {
+		opts = NewTaskListOptions()
+	}
+	return AutoPageIter[*TaskListOptions, *resource.Task](ctx, opts, func(opts *TaskListOptions) ([]*resource.Task, *Pager, error) {
 		return c.ListForApp(ctx, appGUID, opts)
 	})
 }
@@ -150,7 +171,7 @@ func (c *TaskClient) SingleForApp(ctx context.Context, appGUID string, opts *Tas
 // Update the specified attributes of the task
 func (c *TaskClient) Update(ctx context.Context, guid string, r *resource.TaskUpdate) (*resource.Task, error) {
 	var task resource.Task
-	_, err := c.client.patch(ctx, path.Format("/v3/tasks/%s", guid), r, &task)
+	_, err := c.client.patch(ctx, "Tasks.Update", path.Format("/v3/tasks/%s", guid), r, &task)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func (o UserListOptions) ToQueryString() (url.Values, error) {
 // Create a new user
 func (c *UserClient) Create(ctx context.Context, r *resource.UserCreate) (*resource.User, error) {
 	var user resource.User
-	_, err := c.client.post(ctx, "/v3/users", r, &user)
+	_, err := c.client.post(ctx, "Users.Create", "/v3/users", r, &user)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func (c *UserClient) Create(ctx context.Context, r *resource.UserCreate) (*resou
 
 // Delete the specified user
 func (c *UserClient) Delete(ctx context.Context, guid string) (string, error) {
-	return c.client.delete(ctx, path.Format("/v3/users/%s", guid))
+	return c.client.delete(ctx, "Users.Delete", path.Format("/v3/users/%s", guid))
 }
 
 // First returns the first user matching the options or an error when less than 1 match
@@ -66,7 +66,7 @@ func (c *UserClient) First(ctx context.Context, opts *UserListOptions) (*resourc
 // Get the specified user
 func (c *UserClient) Get(ctx context.Context, guid string) (*resource.User, error) {
 	var user resource.User
-	err := c.client.get(ctx, path.Format("/v3/users/%s", guid), &user)
+	err := c.client.get(ctx, "Users.Get", path.Format("/v3/users/%s", guid), &user)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +79,7 @@ func (c *UserClient) List(ctx context.Context, opts *UserListOptions) ([]*resour
 		opts = NewUserListOptions()
 	}
 	var res resource.UserList
-	err := c.client.list(ctx, "/v3/users", opts.ToQueryString, &res)
+	err := c.client.list(ctx, "Users.List", "/v3/users", opts.ToQueryString, &res)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,7 +92,17 @@ func (c *UserClient) ListAll(ctx context.Context, opts *UserListOptions) ([]*res
 	if opts == nil {
 		opts = NewUserListOptions()
 	}
-	return AutoPage[*UserListOptions, *resource.User](opts, func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
+	return AutoPageConcurrent[*UserListOptions, *resource.User](opts, c.client.PageConcurrency(), func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all users the user has access to
+func (c *UserClient) Iter(ctx context.Context, opts *UserListOptions) Seq2[*resource.User, error] {
+	if opts == nil {
+		opts = NewUserListOptions()
+	}
+	return AutoPageIter[*UserListOptions, *resource.User](ctx, opts, func(opts *UserListOptions) ([]*resource.User, *Pager, error) {
 		return c.List(ctx, opts)
 	})
 }
@@ -107,7 +117,7 @@ func (c *UserClient) Single(ctx context.Context, opts *UserListOptions) (*resour
 // Update the specified attributes of a user
 func (c *UserClient) Update(ctx context.Context, guid string, r *resource.UserUpdate) (*resource.User, error) {
 	var user resource.User
-	_, err := c.client.patch(ctx, path.Format("/v3/users/%s", guid), r, &user)
+	_, err := c.client.patch(ctx, "Users.Update", path.Format("/v3/users/%s", guid), r, &user)
 	if err != nil {
 		return nil, err
 	}
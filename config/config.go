@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
@@ -30,10 +31,25 @@ const (
 	DefaultUserAgent      = "Go-CF-Client/3.0"
 	DefaultClientID       = "cf"
 	DefaultSSHClientID    = "ssh-proxy"
+
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 30 * time.Second
 )
 
 var ErrConfigInvalid = errors.New("configuration is invalid")
 
+// RateLimitQuota is the API request quota as last reported by the Cloud Controller
+type RateLimitQuota struct {
+	// Limit is the total number of requests allowed until the quota is reset, 0 if unknown
+	Limit int
+
+	// Remaining is the number of requests left until the quota is reset
+	Remaining int
+
+	// Reset is the time when the quota is reset
+	Reset time.Time
+}
+
 // Config is used to configure the creation of a client
 type Config struct {
 	apiEndpointURL   string
@@ -54,6 +70,11 @@ type Config struct {
 	skipTLSValidation bool
 	requestTimeout    time.Duration
 	userAgent         string
+	pageConcurrency   int
+	retryPolicy       internal.RetryPolicy
+	rateLimiter       *internal.RateLimiter
+	interceptors      []Interceptor
+	logger            *slog.Logger
 
 	initialized bool
 }
@@ -115,6 +136,15 @@ func (c *Config) ApiURL(urlPath string) string {
 	return path.Join(c.apiEndpointURL, urlPath)
 }
 
+// apiHost returns the host of the CF API, which is the only host the rate limiter applies to
+func (c *Config) apiHost() string {
+	u, err := url.Parse(c.apiEndpointURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
 func (c *Config) AuthURL(urlPath string) string {
 	return path.Join(c.uaaEndpointURL, urlPath)
 }
@@ -186,6 +216,31 @@ func (c *Config) HTTPAuthClient() *http.Client {
 	return c.httpAuthClient
 }
 
+// Interceptors returns the interceptors invoked around each API call.
+func (c *Config) Interceptors() []Interceptor {
+	return c.interceptors
+}
+
+// PageConcurrency returns the maximum number of pages that are requested in parallel when retrieving all
+// resources of a collection.
+func (c *Config) PageConcurrency() int {
+	return c.pageConcurrency
+}
+
+// RateLimitQuota returns the API request quota last reported by the Cloud Controller. The quota is only
+// tracked when the RateLimit option is used.
+func (c *Config) RateLimitQuota() RateLimitQuota {
+	if c.rateLimiter == nil {
+		return RateLimitQuota{}
+	}
+	limit, remaining, reset := c.rateLimiter.Quota()
+	return RateLimitQuota{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     reset,
+	}
+}
+
 // SSHOAuthClientID returns the clientID used to request an SSH code, typically 'ssh-proxy'.
 func (c *Config) SSHOAuthClientID() string {
 	return c.sshOAuthClient
@@ -263,11 +318,15 @@ func setGrantType(c *Config) error {
 }
 
 // configureHTTPClient creates a default http.Client if one wasn't supplied in the config and then
-// configures the base http.Client from the config.
+// configures the base http.Client from the config. A supplied http.Client is copied so it's left unchanged
+// and can be reused for another config.
 func configureHTTPClient(c *Config) {
 	// Ensure there is a client and transport configured
 	if c.httpClient == nil {
 		c.httpClient = &http.Client{}
+	} else {
+		httpClient := *c.httpClient
+		c.httpClient = &httpClient
 	}
 	if c.httpClient.Transport == nil {
 		c.httpClient.Transport = http.DefaultTransport.(*http.Transport).Clone()
@@ -284,6 +343,21 @@ func configureHTTPClient(c *Config) {
 	// Use our configurable redirect function and the configured timeout
 	c.httpClient.CheckRedirect = internal.CheckRedirect
 	c.httpClient.Timeout = c.requestTimeout
+
+	// Log each request as it's sent over the wire, so each retry attempt is logged
+	if c.logger != nil {
+		c.httpClient.Transport = internal.NewLoggingTransport(c.httpClient.Transport, c.logger)
+	}
+
+	// Throttle CF API requests before they're sent, each retry attempt is also throttled
+	if c.rateLimiter != nil {
+		c.httpClient.Transport = internal.NewRateLimitTransport(c.httpClient.Transport, c.rateLimiter, c.apiHost())
+	}
+
+	// Retry transient failures, this is done last so the retry transport wraps the configured transport
+	if c.retryPolicy.MaxAttempts > 1 {
+		c.httpClient.Transport = internal.NewRetryTransport(c.httpClient.Transport, c.retryPolicy)
+	}
 }
 
 // createHTTPAuthClient creates the http.Client used for any API calls that require authentication.
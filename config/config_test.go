@@ -1,9 +1,16 @@
 package config
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
 
@@ -224,3 +231,203 @@ func TestNewConfigFromCFHomeDir(t *testing.T) {
 		require.Equal(t, GrantTypePassword, cfg.grantType)
 	})
 }
+
+func TestPageConcurrency(t *testing.T) {
+	t.Run("with default page concurrency", func(t *testing.T) {
+		c, err := New("https://api.example.com",
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com")) // skip service discovery
+		require.NoError(t, err)
+		require.Equal(t, 0, c.PageConcurrency())
+	})
+
+	t.Run("with page concurrency", func(t *testing.T) {
+		c, err := New("https://api.example.com",
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com"), // skip service discovery
+			PageConcurrency(8))
+		require.NoError(t, err)
+		require.Equal(t, 8, c.PageConcurrency())
+	})
+
+	t.Run("with invalid page concurrency", func(t *testing.T) {
+		_, err := New("https://api.example.com",
+			Token(accessToken, refreshToken),
+			PageConcurrency(0))
+		require.EqualError(t, err, "page concurrency must be at least 1, but got 0")
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("without retry", func(t *testing.T) {
+		c, err := New("https://api.example.com",
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com")) // skip service discovery
+		require.NoError(t, err)
+		require.IsType(t, &http.Transport{}, c.HTTPClient().Transport)
+	})
+
+	t.Run("with retry", func(t *testing.T) {
+		c, err := New("https://api.example.com",
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com"), // skip service discovery
+			Retry(5, 0, 0),
+			RetryNonIdempotent())
+		require.NoError(t, err)
+		require.Equal(t, 5, c.retryPolicy.MaxAttempts)
+		require.Equal(t, DefaultRetryInitialBackoff, c.retryPolicy.InitialBackoff)
+		require.Equal(t, DefaultRetryMaxBackoff, c.retryPolicy.MaxBackoff)
+		require.True(t, c.retryPolicy.RetryNonIdempotent)
+		require.NotEqual(t, "*http.Transport", fmt.Sprintf("%T", c.HTTPClient().Transport))
+	})
+
+	t.Run("with retry and a reused http.Client", func(t *testing.T) {
+		var attempts int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		transport := &http.Transport{}
+		httpClient := &http.Client{Transport: transport}
+		for i := 0; i < 2; i++ {
+			attempts = 0
+			c, err := New(server.URL,
+				Token(accessToken, refreshToken),
+				AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com"), // skip service discovery
+				Retry(2, time.Millisecond, time.Millisecond),
+				HttpClient(httpClient))
+			require.NoError(t, err)
+			require.NotSame(t, httpClient, c.HTTPClient())
+			resp, err := c.HTTPClient().Get(c.ApiURL("/v3"))
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+			require.Equal(t, 2, attempts)
+		}
+		require.Same(t, transport, httpClient.Transport)
+		require.Nil(t, httpClient.CheckRedirect)
+		require.Zero(t, httpClient.Timeout)
+	})
+
+	t.Run("with invalid retry options", func(t *testing.T) {
+		_, err := New("https://api.example.com", Token(accessToken, refreshToken), Retry(0, 0, 0))
+		require.EqualError(t, err, "retry max attempts must be at least 1, but got 0")
+
+		_, err = New("https://api.example.com", Token(accessToken, refreshToken), Retry(3, time.Minute, time.Second))
+		require.EqualError(t, err, "retry max backoff must be greater than or equal to the initial backoff")
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Run("without rate limit", func(t *testing.T) {
+		c, err := New("https://api.example.com",
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com")) // skip service discovery
+		require.NoError(t, err)
+		require.Equal(t, RateLimitQuota{}, c.RateLimitQuota())
+	})
+
+	t.Run("with rate limit", func(t *testing.T) {
+		reset := time.Now().Add(time.Hour).Truncate(time.Second)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Limit", "20000")
+			w.Header().Set("X-RateLimit-Remaining", "19999")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		c, err := New(server.URL,
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com"), // skip service discovery
+			RateLimit(100, 10))
+		require.NoError(t, err)
+		resp, err := c.HTTPClient().Get(c.ApiURL("/v3"))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, RateLimitQuota{
+			Limit:     20000,
+			Remaining: 19999,
+			Reset:     reset,
+		}, c.RateLimitQuota())
+
+		// requests to other hosts don't count towards the CF API quota
+		uaa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer uaa.Close()
+		resp, err = c.HTTPClient().Get(uaa.URL + "/oauth/token")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, 19999, c.RateLimitQuota().Remaining)
+	})
+
+	t.Run("with invalid rate limit", func(t *testing.T) {
+		_, err := New("https://api.example.com", Token(accessToken, refreshToken), RateLimit(-1, 1))
+		require.EqualError(t, err, "rate limit requests per second must not be negative, but got -1")
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	noop := func(ctx context.Context, call *Call, next Invoker) error {
+		return next(ctx, call)
+	}
+
+	t.Run("with middleware", func(t *testing.T) {
+		c, err := New("https://api.example.com",
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com"), // skip service discovery
+			Middleware(noop, noop),
+			Middleware(noop))
+		require.NoError(t, err)
+		require.Len(t, c.Interceptors(), 3)
+	})
+
+	t.Run("with nil middleware", func(t *testing.T) {
+		_, err := New("https://api.example.com", Token(accessToken, refreshToken), Middleware(noop, nil))
+		require.EqualError(t, err, "middleware interceptor must not be nil")
+	})
+}
+
+func TestTrace(t *testing.T) {
+	t.Run("with trace", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"guid":"1234","credentials":{"password":"hunter2"}}`))
+		}))
+		defer server.Close()
+
+		var out bytes.Buffer
+		c, err := New(server.URL,
+			Token(accessToken, refreshToken),
+			AuthTokenURL("https://login.cf.example.com", "https://token.cf.example.com"), // skip service discovery
+			Trace(&out))
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, c.ApiURL("/v3/service_credential_bindings/1234/details"), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "bearer "+accessToken)
+		resp, err := c.HTTPClient().Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"guid":"1234","credentials":{"password":"hunter2"}}`, string(body))
+
+		require.Contains(t, out.String(), "msg=\"HTTP request\" method=GET")
+		require.Contains(t, out.String(), "msg=\"HTTP response\" method=GET")
+		require.Contains(t, out.String(), "status=200")
+		require.Contains(t, out.String(), "bearer [PRIVATE DATA HIDDEN]")
+		require.NotContains(t, out.String(), accessToken)
+		require.NotContains(t, out.String(), "hunter2")
+	})
+
+	t.Run("with nil logger", func(t *testing.T) {
+		_, err := New("https://api.example.com", Token(accessToken, refreshToken), Logger(nil))
+		require.EqualError(t, err, "logger must not be nil")
+	})
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"context"
+	"net/http"
+)
+
+// Call describes a single Cloud Controller API call made by one of the client's sub-clients
+type Call struct {
+	// Method is the HTTP method of the call, e.g. GET
+	Method string
+
+	// Operation is the sub-client field and method that made the call, e.g. Applications.Start, each sub-client
+	// method passes its own name
+	Operation string
+
+	// ResourcePath is the relative API resource path including any query string, e.g. /v3/apps/guid
+	ResourcePath string
+
+	// Request is the outbound request, interceptors may modify it before calling next, for example to add headers
+	Request *http.Request
+
+	// Response is set once the response is received, the body has already been consumed when next returns
+	Response *http.Response
+
+	// Result is the value the response body is decoded into, nil if the caller doesn't expect a body
+	Result any
+
+	// JobGUID is set once the response is received if the API started an asynchronous job
+	JobGUID string
+}
+
+// Invoker executes the call, decoding any response into the call's Result
+type Invoker func(ctx context.Context, call *Call) error
+
+// Interceptor is invoked around every Cloud Controller API call made by the client's sub-clients. Interceptors
+// must call next to continue the chain and may inspect the call and error afterwards.
+type Interceptor func(ctx context.Context, call *Call, next Invoker) error
+
+// Chain wraps the invoker with the interceptors, the first interceptor being the outermost
+func Chain(invoker Invoker, interceptors ...Interceptor) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor := interceptors[i]
+		next := invoker
+		invoker = func(ctx context.Context, call *Call) error {
+			return interceptor(ctx, call, next)
+		}
+	}
+	return invoker
+}
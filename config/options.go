@@ -3,11 +3,14 @@ package config
 import (
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
+	internal "github.com/cloudfoundry-community/go-cfclient/v3/internal/http"
 	"github.com/cloudfoundry-community/go-cfclient/v3/internal/jwt"
 )
 
@@ -103,7 +106,8 @@ func AuthTokenURL(loginURL, tokenURL string) Option {
 	}
 }
 
-// HttpClient is a functional option to set the HTTP client.
+// HttpClient is a functional option to set the HTTP client. The client is copied before it's configured, so it's
+// left unchanged and can be reused for another config, but its transport is shared.
 func HttpClient(client *http.Client) Option {
 	return func(c *Config) error {
 		c.httpClient = client
@@ -123,6 +127,99 @@ func RequestTimeout(timeout time.Duration) Option {
 	}
 }
 
+// Logger is a functional option to log every HTTP request and response, including the method, URL, status,
+// timing and bodies, at debug level. Bearer tokens, client secrets, passwords, service credential binding
+// credentials and docker passwords are redacted.
+func Logger(logger *slog.Logger) Option {
+	return func(c *Config) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// Middleware is a functional option to add interceptors that are invoked around every API call made by the
+// client's sub-clients. Interceptors are invoked in the order they're added.
+func Middleware(interceptors ...Interceptor) Option {
+	return func(c *Config) error {
+		for _, interceptor := range interceptors {
+			if interceptor == nil {
+				return errors.New("middleware interceptor must not be nil")
+			}
+		}
+		c.interceptors = append(c.interceptors, interceptors...)
+		return nil
+	}
+}
+
+// PageConcurrency is a functional option to request the remaining pages of a collection in parallel once the
+// first page has been retrieved. This applies to all ListAll style functions, which by default page sequentially.
+func PageConcurrency(maxConcurrentRequests int) Option {
+	return func(c *Config) error {
+		if maxConcurrentRequests < 1 {
+			return fmt.Errorf("page concurrency must be at least 1, but got %d", maxConcurrentRequests)
+		}
+		c.pageConcurrency = maxConcurrentRequests
+		return nil
+	}
+}
+
+// RateLimit is a functional option to limit the rate of API requests using a token bucket that allows
+// requestsPerSecond sustained requests with bursts of up to burst requests. A requestsPerSecond of 0 only
+// enforces the server quota.
+//
+// The limiter also tracks the quota reported by the Cloud Controller X-RateLimit-* response headers. Once the
+// quota is exhausted requests block until it's reset or the request context is done. Only requests to the CF API
+// host are limited, requests to the UAA and other components don't count towards the Cloud Controller quota.
+// Note that time spent waiting counts towards the request timeout.
+func RateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Config) error {
+		if requestsPerSecond < 0 {
+			return fmt.Errorf("rate limit requests per second must not be negative, but got %v", requestsPerSecond)
+		}
+		c.rateLimiter = internal.NewRateLimiter(requestsPerSecond, burst)
+		return nil
+	}
+}
+
+// Retry is a functional option to retry requests that fail with a transient error, which includes connection
+// resets, timeouts and 429, 502, 503 or 504 responses. Requests are sent at most maxAttempts times using an
+// exponential backoff with jitter starting at initialBackoff and capped at maxBackoff. Any Retry-After or
+// X-RateLimit-Reset response header is honored unless it asks to wait longer than maxBackoff.
+//
+// Only idempotent requests are retried unless the RetryNonIdempotent option is also specified. Note that the
+// request timeout applies to all attempts of a request, not each individual attempt.
+func Retry(maxAttempts int, initialBackoff, maxBackoff time.Duration) Option {
+	return func(c *Config) error {
+		if maxAttempts < 1 {
+			return fmt.Errorf("retry max attempts must be at least 1, but got %d", maxAttempts)
+		}
+		if initialBackoff <= 0 {
+			initialBackoff = DefaultRetryInitialBackoff
+		}
+		if maxBackoff <= 0 {
+			maxBackoff = DefaultRetryMaxBackoff
+		}
+		if maxBackoff < initialBackoff {
+			return errors.New("retry max backoff must be greater than or equal to the initial backoff")
+		}
+		c.retryPolicy.MaxAttempts = maxAttempts
+		c.retryPolicy.InitialBackoff = initialBackoff
+		c.retryPolicy.MaxBackoff = maxBackoff
+		return nil
+	}
+}
+
+// RetryNonIdempotent is a functional option to also retry POST and PATCH requests when retries are enabled.
+func RetryNonIdempotent() Option {
+	return func(c *Config) error {
+		c.retryPolicy.RetryNonIdempotent = true
+		return nil
+	}
+}
+
 // SkipTLSValidation is a functional option to skip TLS validation.
 func SkipTLSValidation() Option {
 	return func(c *Config) error {
@@ -131,6 +228,18 @@ func SkipTLSValidation() Option {
 	}
 }
 
+// Trace is a functional option to write every HTTP request and response to w, similar to CF_TRACE in the
+// CF CLI. Secrets are redacted the same as the Logger option.
+func Trace(w io.Writer) Option {
+	return func(c *Config) error {
+		if w == nil {
+			return errors.New("trace writer must not be nil")
+		}
+		c.logger = slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		return nil
+	}
+}
+
 // SSHOAuthClient configures a clientID used to request an SSH code.
 func SSHOAuthClient(clientID string) Option {
 	return func(c *Config) error {
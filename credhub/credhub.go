@@ -0,0 +1,244 @@
+// Package credhub reads and writes CredHub credentials using the same OAuth token as the CF client, and resolves
+// the credhub-ref placeholders in service binding credentials.
+//
+//	ch := credhub.New(cf)
+//	details, err := cf.ServiceCredentialBindings.GetDetails(ctx, bindingGUID)
+//	if err != nil {
+//		return err
+//	}
+//	err = ch.InterpolateBindingDetails(ctx, details)
+package credhub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/ios"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// RefKey is the key of the credentials placeholder that references a CredHub credential by name
+const RefKey = "credhub-ref"
+
+// vcapServicesKey is the system environment variable holding the bound service credentials
+const vcapServicesKey = "VCAP_SERVICES"
+
+// ErrNoCredHub is returned when the API root doesn't link to CredHub
+var ErrNoCredHub = errors.New("the Cloud Foundry API root doesn't link to CredHub")
+
+// CredentialType is the type of CredHub credential, which determines the structure of its value
+type CredentialType string
+
+const (
+	CredentialTypeValue       CredentialType = "value"
+	CredentialTypeJSON        CredentialType = "json"
+	CredentialTypePassword    CredentialType = "password"
+	CredentialTypeUser        CredentialType = "user"
+	CredentialTypeCertificate CredentialType = "certificate"
+	CredentialTypeRSA         CredentialType = "rsa"
+	CredentialTypeSSH         CredentialType = "ssh"
+)
+
+// Credential is a single version of a CredHub credential
+type Credential struct {
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Type             CredentialType  `json:"type"`
+	Value            json.RawMessage `json:"value"`
+	VersionCreatedAt time.Time       `json:"version_created_at"`
+}
+
+// credentialSet is the request body used to set a credential
+type credentialSet struct {
+	Name  string         `json:"name"`
+	Type  CredentialType `json:"type"`
+	Value any            `json:"value"`
+}
+
+// credentialList is the response from getting a credential by name
+type credentialList struct {
+	Data []*Credential `json:"data"`
+}
+
+// Client for the CredHub API
+type Client struct {
+	cf *client.Client
+
+	mu      sync.Mutex
+	baseURL string
+}
+
+// Option configures the CredHub client
+type Option func(*Client)
+
+// WithURL overrides the CredHub URL discovered from the API root
+func WithURL(credHubURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(credHubURL, "/")
+	}
+}
+
+// New creates a new CredHub client that authenticates using the CF client
+func New(cf *client.Client, opts ...Option) *Client {
+	c := &Client{
+		cf: cf,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Get the current version of the named credential
+func (c *Client) Get(ctx context.Context, name string) (*Credential, error) {
+	values := url.Values{}
+	values.Set("name", name)
+	values.Set("current", "true")
+	var list credentialList
+	if err := c.do(ctx, http.MethodGet, "/api/v1/data?"+values.Encode(), nil, &list); err != nil {
+		return nil, err
+	}
+	if len(list.Data) == 0 {
+		return nil, fmt.Errorf("credential %s not found", name)
+	}
+	return list.Data[0], nil
+}
+
+// Set creates a new version of the named credential, the value must be JSON encodable in the structure
+// required by the credential type
+func (c *Client) Set(ctx context.Context, name string, credentialType CredentialType, value any) (*Credential, error) {
+	r := &credentialSet{
+		Name:  name,
+		Type:  credentialType,
+		Value: value,
+	}
+	var credential Credential
+	if err := c.do(ctx, http.MethodPut, "/api/v1/data", r, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// Delete all versions of the named credential
+func (c *Client) Delete(ctx context.Context, name string) error {
+	values := url.Values{}
+	values.Set("name", name)
+	return c.do(ctx, http.MethodDelete, "/api/v1/data?"+values.Encode(), nil, nil)
+}
+
+// InterpolateServices replaces the credhub-ref placeholders in the VCAP_SERVICES JSON with the referenced
+// credentials
+func (c *Client) InterpolateServices(ctx context.Context, vcapServices json.RawMessage) (json.RawMessage, error) {
+	var interpolated json.RawMessage
+	if err := c.do(ctx, http.MethodPost, "/api/v1/interpolate", vcapServices, &interpolated); err != nil {
+		return nil, err
+	}
+	return interpolated, nil
+}
+
+// InterpolateCredentials returns the referenced credential if the credentials are a credhub-ref placeholder,
+// otherwise the credentials are returned unchanged
+func (c *Client) InterpolateCredentials(ctx context.Context, credentials map[string]any) (map[string]any, error) {
+	ref, ok := credentials[RefKey].(string)
+	if !ok || len(credentials) != 1 {
+		return credentials, nil
+	}
+	credential, err := c.Get(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("error interpolating %s %s: %w", RefKey, ref, err)
+	}
+	var value map[string]any
+	if err = json.Unmarshal(credential.Value, &value); err != nil {
+		return nil, fmt.Errorf("error interpolating %s %s, expected a JSON object credential: %w", RefKey, ref, err)
+	}
+	return value, nil
+}
+
+// InterpolateBindingDetails replaces the credhub-ref placeholder in the service credential binding details
+// returned by ServiceCredentialBindings.GetDetails
+func (c *Client) InterpolateBindingDetails(ctx context.Context, details *resource.ServiceCredentialBindingDetails) error {
+	credentials, err := c.InterpolateCredentials(ctx, details.Credentials)
+	if err != nil {
+		return err
+	}
+	details.Credentials = credentials
+	return nil
+}
+
+// InterpolateAppEnvironment replaces the credhub-ref placeholders in the VCAP_SERVICES of the app environment
+// returned by Applications.GetEnvironment
+func (c *Client) InterpolateAppEnvironment(ctx context.Context, env *resource.AppEnvironment) error {
+	vcapServices, ok := env.SystemEnvVars[vcapServicesKey]
+	if !ok || !bytes.Contains(vcapServices, []byte(RefKey)) {
+		return nil
+	}
+	interpolated, err := c.InterpolateServices(ctx, vcapServices)
+	if err != nil {
+		return err
+	}
+	env.SystemEnvVars[vcapServicesKey] = interpolated
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, resourcePath string, params, result any) error {
+	baseURL, err := c.url(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if params != nil {
+		b, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("error marshalling CredHub request: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+resourcePath, body)
+	if err != nil {
+		return fmt.Errorf("error creating CredHub request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.cf.ExecuteAuthRequest(req)
+	if err != nil {
+		return fmt.Errorf("error executing %s request for %s: %w", method, req.URL.Path, err)
+	}
+	defer ios.Close(resp.Body)
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("error decoding CredHub response: %w", err)
+	}
+	return nil
+}
+
+// url returns the CredHub URL, the API root is only queried the first time
+func (c *Client) url(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.baseURL == "" {
+		root, err := c.cf.Root.Get(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error discovering the CredHub URL: %w", err)
+		}
+		if root.Links.Credhub.Href == "" {
+			return "", ErrNoCredHub
+		}
+		c.baseURL = strings.TrimSuffix(root.Links.Credhub.Href, "/")
+	}
+	return c.baseURL, nil
+}
@@ -0,0 +1,152 @@
+package credhub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestCredHub(t *testing.T) {
+	cf, credHubURL := setupCredHub(t)
+	ch := New(cf, WithURL(credHubURL))
+	ctx := context.Background()
+
+	_, err := ch.Get(ctx, "/c/broker/missing")
+	var httpErr resource.CloudFoundryHTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusNotFound, httpErr.StatusCode)
+
+	credential, err := ch.Set(ctx, "/c/broker/db", CredentialTypeJSON, map[string]any{"uri": "postgres://db", "port": 5432})
+	require.NoError(t, err)
+	require.Equal(t, "/c/broker/db", credential.Name)
+	require.Equal(t, CredentialTypeJSON, credential.Type)
+
+	credential, err = ch.Get(ctx, "/c/broker/db")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"uri":"postgres://db","port":5432}`, string(credential.Value))
+
+	details := &resource.ServiceCredentialBindingDetails{
+		Credentials: map[string]any{RefKey: "/c/broker/db"},
+	}
+	require.NoError(t, ch.InterpolateBindingDetails(ctx, details))
+	require.Equal(t, map[string]any{"uri": "postgres://db", "port": float64(5432)}, details.Credentials)
+
+	// credentials that aren't a reference are left unchanged
+	plain := map[string]any{"uri": "mysql://db"}
+	interpolated, err := ch.InterpolateCredentials(ctx, plain)
+	require.NoError(t, err)
+	require.Equal(t, plain, interpolated)
+
+	env := &resource.AppEnvironment{
+		SystemEnvVars: map[string]json.RawMessage{
+			"VCAP_SERVICES": json.RawMessage(`{"postgres":[{"name":"db","credentials":{"credhub-ref":"/c/broker/db"}}]}`),
+		},
+	}
+	require.NoError(t, ch.InterpolateAppEnvironment(ctx, env))
+	require.JSONEq(t, `{"postgres":[{"name":"db","credentials":{"uri":"postgres://db","port":5432}}]}`,
+		string(env.SystemEnvVars["VCAP_SERVICES"]))
+
+	require.NoError(t, ch.Delete(ctx, "/c/broker/db"))
+	_, err = ch.InterpolateCredentials(ctx, map[string]any{RefKey: "/c/broker/db"})
+	require.ErrorContains(t, err, "error interpolating credhub-ref /c/broker/db")
+}
+
+func TestCredHubDiscovery(t *testing.T) {
+	cf, _ := setupCredHub(t)
+
+	// the fake API root has an empty credhub link
+	_, err := New(cf).Get(context.Background(), "/c/broker/db")
+	require.ErrorIs(t, err, ErrNoCredHub)
+}
+
+// setupCredHub starts an in memory CredHub that requires the fake UAA token
+func setupCredHub(t *testing.T) (*client.Client, string) {
+	var mu sync.Mutex
+	credentials := map[string]*Credential{}
+	get := func(name string) *Credential {
+		mu.Lock()
+		defer mu.Unlock()
+		return credentials[name]
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/data", func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		switch r.Method {
+		case http.MethodGet:
+			credential := get(name)
+			if credential == nil {
+				http.Error(w, `{"error":"The request could not be completed because the credential does not exist"}`, http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(credentialList{Data: []*Credential{credential}})
+		case http.MethodPut:
+			var body struct {
+				Name  string          `json:"name"`
+				Type  CredentialType  `json:"type"`
+				Value json.RawMessage `json:"value"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			credential := &Credential{
+				ID:               testutil.RandomGUID(),
+				Name:             body.Name,
+				Type:             body.Type,
+				Value:            body.Value,
+				VersionCreatedAt: time.Now().UTC(),
+			}
+			mu.Lock()
+			credentials[body.Name] = credential
+			mu.Unlock()
+			_ = json.NewEncoder(w).Encode(credential)
+		case http.MethodDelete:
+			mu.Lock()
+			delete(credentials, name)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/api/v1/interpolate", func(w http.ResponseWriter, r *http.Request) {
+		var services map[string][]map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&services))
+		for _, instances := range services {
+			for _, instance := range instances {
+				creds := instance["credentials"].(map[string]any)
+				if ref, ok := creds[RefKey].(string); ok {
+					if credential := get(ref); credential != nil {
+						instance["credentials"] = credential.Value
+					}
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(services)
+	})
+	credHub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer foobar") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(credHub.Close)
+
+	serverURL := testutil.SetupFakeAPIServer()
+	testutil.SetupMultiple(nil, t)
+	t.Cleanup(testutil.Teardown)
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+	return cf, credHub.URL
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
@@ -43,7 +44,9 @@ func execute() error {
 		fmt.Printf("%s\n", details.Credentials)
 		params, err := cf.ServiceCredentialBindings.GetParameters(ctx, b.GUID)
 		if resource.IsServiceFetchBindingParametersNotSupportedError(err) {
-			fmt.Println(err.(resource.CloudFoundryError).Detail)
+			var cfErr resource.CloudFoundryError
+			errors.As(err, &cfErr)
+			fmt.Println(cfErr.Detail)
 		} else if err != nil {
 			return err
 		} else {
@@ -0,0 +1,290 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/ios"
+)
+
+const (
+	// RedactedValue replaces any secret in logged requests and responses
+	RedactedValue = "[PRIVATE DATA HIDDEN]"
+
+	// maxLoggedBodySize is the maximum number of body bytes logged, larger bodies are truncated
+	maxLoggedBodySize = 64 * 1024
+)
+
+// redactedHeaders are the headers whose values are never logged
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// redactedURLHeaders are the headers containing a URL whose secret query parameters are never logged, like the
+// one time code in the redirect location returned by the UAA /oauth/authorize endpoint
+var redactedURLHeaders = []string{"Location", "Content-Location"}
+
+// redactedFields are the JSON object keys and form fields whose values are never logged, this covers OAuth
+// token requests and responses, user passwords, service credential binding credentials and docker passwords
+var redactedFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"client_secret": true,
+	"password":      true,
+	"credentials":   true,
+}
+
+// credHubFields are the JSON object keys redacted in addition to redactedFields in the bodies of CredHub credential
+// requests and responses, they're too generic to redact from other bodies, e.g. a deployment's status value
+var credHubFields = map[string]bool{
+	"value":       true,
+	"private_key": true,
+	"certificate": true,
+	"ca":          true,
+}
+
+// credHubDataPath is the path of the CredHub API for getting and setting credentials
+const credHubDataPath = "/api/v1/data"
+
+// redactedFormFields are redacted in addition to redactedFields in form bodies and URL query strings, these are
+// the one time codes used to obtain an OAuth token
+var redactedFormFields = map[string]bool{
+	"code":     true,
+	"passcode": true,
+}
+
+// loggingTransport wraps a http.RoundTripper and logs each request and response with any secrets redacted
+type loggingTransport struct {
+	transport http.RoundTripper
+	logger    *slog.Logger
+}
+
+// NewLoggingTransport creates a new http.RoundTripper that logs each request and response at debug level
+func NewLoggingTransport(transport http.RoundTripper, logger *slog.Logger) http.RoundTripper {
+	return &loggingTransport{
+		transport: transport,
+		logger:    logger,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if !t.logger.Enabled(ctx, slog.LevelDebug) {
+		return t.transport.RoundTrip(req)
+	}
+
+	reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	reqURL := RedactURL(req.URL.String())
+	t.logger.DebugContext(ctx, "HTTP request",
+		slog.String("method", req.Method),
+		slog.String("url", reqURL),
+		slog.Any("headers", RedactHeader(req.Header)),
+		slog.String("body", RedactBodyForURL(req.URL, req.Header.Get("Content-Type"), reqBody)))
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.logger.DebugContext(ctx, "HTTP request failed",
+			slog.String("method", req.Method),
+			slog.String("url", reqURL),
+			slog.Duration("duration", elapsed),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	respBody, err := peekResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	t.logger.DebugContext(ctx, "HTTP response",
+		slog.String("method", req.Method),
+		slog.String("url", reqURL),
+		slog.Int("status", resp.StatusCode),
+		slog.Duration("duration", elapsed),
+		slog.Any("headers", RedactHeader(resp.Header)),
+		slog.String("body", RedactBodyForURL(req.URL, resp.Header.Get("Content-Type"), respBody)))
+	return resp, nil
+}
+
+// peekRequestBody returns up to maxLoggedBodySize bytes of the request body without consuming it
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody || !isTextual(req.Header.Get("Content-Type")) {
+		return nil, nil
+	}
+	if err := backupRequestBody(req); err != nil {
+		return nil, err
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(io.LimitReader(body, maxLoggedBodySize))
+}
+
+// peekResponseBody returns up to maxLoggedBodySize bytes of the response body, the bytes read are put back
+// so the caller can still read the complete body
+func peekResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || resp.Body == http.NoBody || !isTextual(resp.Header.Get("Content-Type")) {
+		return nil, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize))
+	if err != nil {
+		ios.Close(resp.Body)
+		return nil, err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	return body, nil
+}
+
+// isTextual returns true if the content type is JSON, form data or plain text, other bodies aren't logged
+func isTextual(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	// event streams are never fully read so can't be logged
+	if mediaType == "text/event-stream" {
+		return false
+	}
+	return mediaType == "application/x-www-form-urlencoded" ||
+		strings.HasPrefix(mediaType, "text/") ||
+		mediaType == "application/json" ||
+		strings.HasSuffix(mediaType, "+json")
+}
+
+// RedactHeader returns a copy of the header with any credentials replaced, the authorization scheme is kept
+func RedactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range redactedURLHeaders {
+		values := redacted.Values(name)
+		for i, v := range values {
+			values[i] = RedactURL(v)
+		}
+	}
+	for _, name := range redactedHeaders {
+		values := redacted.Values(name)
+		for i, v := range values {
+			if scheme, _, ok := strings.Cut(v, " "); ok && strings.HasSuffix(name, "Authorization") {
+				values[i] = scheme + " " + RedactedValue
+			} else {
+				values[i] = RedactedValue
+			}
+		}
+	}
+	return redacted
+}
+
+// RedactURL returns the URL with the values of any secret query or fragment parameters replaced, like OAuth
+// tokens and one time codes. The order of the parameters is kept.
+func RedactURL(rawURL string) string {
+	rest, fragment, hasFragment := strings.Cut(rawURL, "#")
+	base, query, hasQuery := strings.Cut(rest, "?")
+	redacted := base
+	if hasQuery {
+		redacted += "?" + redactQuery(query)
+	}
+	if hasFragment {
+		redacted += "#" + redactQuery(fragment)
+	}
+	return redacted
+}
+
+// redactQuery replaces the values of any secret parameters in the raw query string
+func redactQuery(query string) string {
+	params := strings.Split(query, "&")
+	for i, p := range params {
+		key, _, ok := strings.Cut(p, "=")
+		if !ok {
+			continue
+		}
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+		if name = strings.ToLower(name); redactedFields[name] || redactedFormFields[name] {
+			params[i] = key + "=" + url.QueryEscape(RedactedValue)
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// RedactBody returns the body as a string with the values of any secret JSON keys or form fields replaced
+func RedactBody(contentType string, body []byte) string {
+	return redactBody(contentType, body, nil)
+}
+
+// RedactBodyForURL is the same as RedactBody but also replaces the credential values, keys and certificates in the
+// body of a request to, or response from, the CredHub credentials API at the URL
+func RedactBodyForURL(u *url.URL, contentType string, body []byte) string {
+	var extraFields map[string]bool
+	if u != nil && (u.Path == credHubDataPath || strings.HasPrefix(u.Path, credHubDataPath+"/")) {
+		extraFields = credHubFields
+	}
+	return redactBody(contentType, body, extraFields)
+}
+
+func redactBody(contentType string, body []byte, extraFields map[string]bool) string {
+	if len(body) == 0 {
+		return ""
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return RedactedValue
+		}
+		for k := range values {
+			if name := strings.ToLower(k); redactedFields[name] || redactedFormFields[name] {
+				values[k] = []string{RedactedValue}
+			}
+		}
+		return values.Encode()
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var v any
+		if err := json.Unmarshal(body, &v); err != nil {
+			// likely truncated, don't risk leaking a secret
+			return RedactedValue
+		}
+		b, err := json.Marshal(redactJSON(v, extraFields))
+		if err != nil {
+			return RedactedValue
+		}
+		return string(b)
+	default:
+		return string(body)
+	}
+}
+
+// redactJSON replaces the values of any secret keys, and any of the extra keys, in the decoded JSON value in place
+func redactJSON(v any, extraFields map[string]bool) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			if name := strings.ToLower(k); redactedFields[name] || extraFields[name] {
+				t[k] = RedactedValue
+			} else {
+				t[k] = redactJSON(child, extraFields)
+			}
+		}
+	case []any:
+		for i, child := range t {
+			t[i] = redactJSON(child, extraFields)
+		}
+	}
+	return v
+}
@@ -0,0 +1,208 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	newServer := func(t *testing.T, contentType, body string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set("Set-Cookie", "session=abc")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	// logEntries decodes the JSON log lines written by the transport
+	logEntries := func(t *testing.T, out *bytes.Buffer) []map[string]any {
+		var entries []map[string]any
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	newClient := func(out *bytes.Buffer, level slog.Level) *http.Client {
+		logger := slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: level}))
+		return &http.Client{Transport: NewLoggingTransport(http.DefaultTransport, logger)}
+	}
+
+	t.Run("Test logs redacted JSON request and response", func(t *testing.T) {
+		server := newServer(t, "application/json", `{"guid":"1234","credentials":{"username":"admin","password":"s3cret"}}`)
+		var out bytes.Buffer
+		client := newClient(&out, slog.LevelDebug)
+
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/v3/apps",
+			strings.NewReader(`{"name":"app","lifecycle":{"type":"docker"},"docker":{"username":"me","password":"dockerpw"}}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "bearer my-access-token")
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"guid":"1234","credentials":{"username":"admin","password":"s3cret"}}`, string(body))
+
+		require.NotContains(t, out.String(), "my-access-token")
+		require.NotContains(t, out.String(), "dockerpw")
+		require.NotContains(t, out.String(), "s3cret")
+		require.NotContains(t, out.String(), "session=abc")
+
+		entries := logEntries(t, &out)
+		require.Len(t, entries, 2)
+		require.Equal(t, "HTTP request", entries[0]["msg"])
+		require.Equal(t, "POST", entries[0]["method"])
+		require.Equal(t, server.URL+"/v3/apps", entries[0]["url"])
+		require.Equal(t, []any{"bearer " + RedactedValue}, entries[0]["headers"].(map[string]any)["Authorization"])
+		require.JSONEq(t, `{"name":"app","lifecycle":{"type":"docker"},"docker":{"username":"me","password":"[PRIVATE DATA HIDDEN]"}}`,
+			entries[0]["body"].(string))
+
+		require.Equal(t, "HTTP response", entries[1]["msg"])
+		require.EqualValues(t, http.StatusCreated, entries[1]["status"])
+		require.Contains(t, entries[1], "duration")
+		require.JSONEq(t, `{"guid":"1234","credentials":"[PRIVATE DATA HIDDEN]"}`, entries[1]["body"].(string))
+	})
+
+	t.Run("Test logs redacted token request form", func(t *testing.T) {
+		server := newServer(t, "application/json;charset=UTF-8", `{"access_token":"at","refresh_token":"rt","token_type":"bearer"}`)
+		var out bytes.Buffer
+		client := newClient(&out, slog.LevelDebug)
+
+		resp, err := client.Post(server.URL+"/oauth/token", "application/x-www-form-urlencoded",
+			strings.NewReader("grant_type=password&username=admin&password=pw&client_secret=cs"))
+		require.NoError(t, err)
+		drainBody(resp)
+
+		entries := logEntries(t, &out)
+		require.Len(t, entries, 2)
+		require.Equal(t, "client_secret=%5BPRIVATE+DATA+HIDDEN%5D&grant_type=password&password=%5BPRIVATE+DATA+HIDDEN%5D&username=admin",
+			entries[0]["body"])
+		require.JSONEq(t, `{"access_token":"[PRIVATE DATA HIDDEN]","refresh_token":"[PRIVATE DATA HIDDEN]","token_type":"bearer"}`,
+			entries[1]["body"].(string))
+	})
+
+	t.Run("Test logs redacted CredHub credentials", func(t *testing.T) {
+		server := newServer(t, "application/json",
+			`{"data":[{"name":"/c/db","type":"value","value":"s3cr3t"},{"name":"/c/tls","type":"certificate","value":{"ca":"ca-pem","certificate":"cert-pem","private_key":"key-pem"}}]}`)
+		var out bytes.Buffer
+		client := newClient(&out, slog.LevelDebug)
+
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/api/v1/data",
+			strings.NewReader(`{"name":"/c/tls","type":"certificate","value":{"ca":"ca-pem","certificate":"cert-pem","private_key":"key-pem"}}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		drainBody(resp)
+
+		for _, secret := range []string{"s3cr3t", "ca-pem", "cert-pem", "key-pem"} {
+			require.NotContains(t, out.String(), secret)
+		}
+		entries := logEntries(t, &out)
+		require.Len(t, entries, 2)
+		require.JSONEq(t, `{"name":"/c/tls","type":"certificate","value":"[PRIVATE DATA HIDDEN]"}`, entries[0]["body"].(string))
+		require.JSONEq(t, `{"data":[{"name":"/c/db","type":"value","value":"[PRIVATE DATA HIDDEN]"},{"name":"/c/tls","type":"certificate","value":"[PRIVATE DATA HIDDEN]"}]}`,
+			entries[1]["body"].(string))
+		require.JSONEq(t, `{"ca":"[PRIVATE DATA HIDDEN]","certificate":"[PRIVATE DATA HIDDEN]","private_key":"[PRIVATE DATA HIDDEN]"}`,
+			RedactBodyForURL(req.URL, "application/json", []byte(`{"ca":"ca-pem","certificate":"cert-pem","private_key":"key-pem"}`)))
+	})
+
+	t.Run("Test logs values of other bodies", func(t *testing.T) {
+		deployment := `{"guid":"1234","status":{"value":"FINALIZED","reason":"DEPLOYED"}}`
+		server := newServer(t, "application/json", deployment)
+		var out bytes.Buffer
+		client := newClient(&out, slog.LevelDebug)
+
+		resp, err := client.Get(server.URL + "/v3/deployments/1234")
+		require.NoError(t, err)
+		drainBody(resp)
+
+		entries := logEntries(t, &out)
+		require.Len(t, entries, 2)
+		require.JSONEq(t, deployment, entries[1]["body"].(string))
+
+		pkg := `{"data":{"checksum":{"type":"sha256","value":"abc"}},"certificate":"public"}`
+		require.JSONEq(t, pkg, RedactBody("application/json", []byte(pkg)))
+		u, err := url.Parse(server.URL + "/v3/packages/1234")
+		require.NoError(t, err)
+		require.JSONEq(t, pkg, RedactBodyForURL(u, "application/json", []byte(pkg)))
+	})
+
+	t.Run("Test logs redacted URLs and redirect location", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "https://uaa.example.org/login?state=xyz&code=one-time-code#access_token=fragment-token")
+			w.WriteHeader(http.StatusFound)
+		}))
+		defer server.Close()
+		var out bytes.Buffer
+		client := newClient(&out, slog.LevelDebug)
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+
+		resp, err := client.Get(server.URL + "/oauth/authorize?response_type=code&client_id=ssh-proxy&access_token=query-token")
+		require.NoError(t, err)
+		drainBody(resp)
+		require.Equal(t, "https://uaa.example.org/login?state=xyz&code=one-time-code#access_token=fragment-token",
+			resp.Header.Get("Location"))
+
+		require.NotContains(t, out.String(), "one-time-code")
+		require.NotContains(t, out.String(), "query-token")
+		require.NotContains(t, out.String(), "fragment-token")
+		entries := logEntries(t, &out)
+		require.Len(t, entries, 2)
+		require.Equal(t, server.URL+"/oauth/authorize?response_type=code&client_id=ssh-proxy&access_token=%5BPRIVATE+DATA+HIDDEN%5D",
+			entries[0]["url"])
+		require.Equal(t, entries[0]["url"], entries[1]["url"])
+		require.Equal(t, []any{"https://uaa.example.org/login?state=xyz&code=%5BPRIVATE+DATA+HIDDEN%5D#access_token=%5BPRIVATE+DATA+HIDDEN%5D"},
+			entries[1]["headers"].(map[string]any)["Location"])
+	})
+
+	t.Run("Test does not log binary bodies", func(t *testing.T) {
+		server := newServer(t, "application/zip", "PK\x03\x04")
+		var out bytes.Buffer
+		client := newClient(&out, slog.LevelDebug)
+
+		resp, err := client.Get(server.URL + "/v3/packages/1234/download")
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, "PK\x03\x04", string(body))
+
+		entries := logEntries(t, &out)
+		require.Len(t, entries, 2)
+		require.Equal(t, "", entries[1]["body"])
+	})
+
+	t.Run("Test does not log when debug is disabled", func(t *testing.T) {
+		server := newServer(t, "application/json", `{}`)
+		var out bytes.Buffer
+		client := newClient(&out, slog.LevelInfo)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		drainBody(resp)
+		require.Empty(t, out.String())
+	})
+
+	t.Run("Test redacts unparseable JSON", func(t *testing.T) {
+		require.Equal(t, RedactedValue, RedactBody("application/json", []byte(`{"password":"trunc`)))
+		require.Equal(t, "plain", RedactBody("text/plain", []byte("plain")))
+	})
+}
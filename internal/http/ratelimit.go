@@ -0,0 +1,245 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+)
+
+// RateLimiter is a token bucket rate limiter that also tracks the request quota reported by the
+// Cloud Controller via the X-RateLimit-* response headers. Once the reported quota is exhausted
+// callers are blocked until the quota is reset. After the reset a single request is sent to learn the
+// new quota, the other blocked callers then take their share of it one at a time.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	rate   float64 // tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// quota as last reported by the server, limit is 0 until the first response is seen
+	limit     int
+	remaining int
+	reset     time.Time
+	updates   int  // number of quota updates, so a cancelled request only returns its slot to the same quota
+	probing   bool // a request has been sent after the quota reset and the new quota isn't known yet
+	changed   chan struct{}
+
+	now func() time.Time
+}
+
+// reservation is what a request took from the RateLimiter, it's returned if the request is never sent
+type reservation struct {
+	token   bool // a token was taken from the bucket
+	quota   bool // a slot was taken from the server quota
+	probe   bool // the request is sent to learn the quota after it was reset
+	updates int
+}
+
+// NewRateLimiter creates a RateLimiter allowing requestsPerSecond sustained requests with bursts of up to burst
+// requests. A requestsPerSecond of zero or less disables the token bucket so only the server quota is enforced.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:    requestsPerSecond,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		last:    time.Now(),
+		changed: make(chan struct{}),
+		now:     time.Now,
+	}
+}
+
+// Quota returns the last request quota reported by the server
+func (l *RateLimiter) Quota() (limit, remaining int, reset time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit, l.remaining, l.reset
+}
+
+// Update adjusts the tracked quota from the X-RateLimit-* response headers if present
+func (l *RateLimiter) Update(header http.Header) {
+	limit, err := strconv.Atoi(header.Get(RateLimitLimitHeader))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get(RateLimitRemainingHeader))
+	if err != nil {
+		return
+	}
+	resetEpoch, err := strconv.ParseInt(header.Get(RateLimitResetHeader), 10, 64)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit = limit
+	l.remaining = remaining
+	l.reset = time.Unix(resetEpoch, 0)
+	l.updates++
+	l.notify()
+}
+
+// wait blocks until a request can be sent or ctx is done, anything taken for a request that's never sent is
+// returned to the limiter
+func (l *RateLimiter) wait(ctx context.Context) (*reservation, error) {
+	for {
+		r, delay, changed := l.reserve()
+		if r != nil && delay <= 0 {
+			return r, nil
+		}
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if delay > 0 {
+			timer = time.NewTimer(delay)
+			timeout = timer.C
+		}
+		select {
+		case <-ctx.Done():
+			stopTimer(timer)
+			if r != nil {
+				l.cancel(r)
+			}
+			return nil, ctx.Err()
+		case <-timeout:
+			if r != nil {
+				return r, nil
+			}
+		case <-changed:
+			stopTimer(timer)
+		}
+	}
+}
+
+// reserve takes a token and quota slot and returns how long the caller must wait before sending the request.
+// If the quota is exhausted nothing is taken and the caller must try again once the delay has passed or the
+// returned channel is closed, whichever is first.
+func (l *RateLimiter) reserve() (*reservation, time.Duration, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	r := &reservation{updates: l.updates}
+
+	if l.limit > 0 {
+		switch {
+		case l.remaining > 0 && l.reset.After(now):
+			l.remaining--
+			r.quota = true
+		case l.remaining > 0:
+			// the quota no longer applies after it's been reset
+		case l.reset.After(now):
+			return nil, l.reset.Sub(now), l.changed
+		case l.probing:
+			return nil, 0, l.changed
+		default:
+			l.probing = true
+			r.probe = true
+		}
+	}
+
+	var delay time.Duration
+	if l.rate > 0 {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+		l.tokens--
+		r.token = true
+		if l.tokens < 0 {
+			delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+		}
+	}
+	return r, delay, nil
+}
+
+// cancel returns what was taken for a request that was never sent
+func (l *RateLimiter) cancel(r *reservation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if r.token && l.tokens < l.burst {
+		l.tokens++
+	}
+	if r.quota && r.updates == l.updates {
+		l.remaining++
+		l.notify()
+	}
+	if r.probe {
+		l.probing = false
+		l.notify()
+	}
+}
+
+// done updates the limiter from the response to a sent request
+func (l *RateLimiter) done(r *reservation, resp *http.Response) {
+	if resp != nil {
+		l.Update(resp.Header)
+	}
+	if !r.probe {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.probing = false
+	if !l.reset.After(l.now()) {
+		// no new quota was reported so assume it was reset
+		l.remaining = l.limit
+	}
+	l.notify()
+}
+
+// notify wakes any callers waiting for the quota to change, l.mu must be held
+func (l *RateLimiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// rateLimitTransport wraps a http.RoundTripper and blocks requests to the rate limited host until the
+// RateLimiter allows them
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	limiter   *RateLimiter
+	host      string
+}
+
+// NewRateLimitTransport creates a new http.RoundTripper that waits for the limiter before sending each request
+// to host and updates the limiter from each response. Requests to any other host, like the UAA, are sent
+// without waiting as they don't count towards the Cloud Controller quota.
+func NewRateLimitTransport(transport http.RoundTripper, limiter *RateLimiter, host string) http.RoundTripper {
+	return &rateLimitTransport{
+		transport: transport,
+		limiter:   limiter,
+		host:      host,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.EqualFold(req.URL.Host, t.host) {
+		return t.transport.RoundTrip(req)
+	}
+	r, err := t.limiter.wait(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport.RoundTrip(req)
+	t.limiter.done(r, resp)
+	return resp, err
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	newLimiter := func(requestsPerSecond float64, burst int) *RateLimiter {
+		l := NewRateLimiter(requestsPerSecond, burst)
+		l.now = func() time.Time { return now }
+		l.last = now
+		return l
+	}
+	quotaHeader := func(limit, remaining int, reset time.Time) http.Header {
+		h := http.Header{}
+		h.Set(RateLimitLimitHeader, strconv.Itoa(limit))
+		h.Set(RateLimitRemainingHeader, strconv.Itoa(remaining))
+		h.Set(RateLimitResetHeader, strconv.FormatInt(reset.Unix(), 10))
+		return h
+	}
+	reserve := func(l *RateLimiter) time.Duration {
+		r, delay, _ := l.reserve()
+		require.NotNil(t, r)
+		return delay
+	}
+
+	t.Run("Test token bucket allows burst then throttles", func(t *testing.T) {
+		l := newLimiter(10, 2)
+		require.Zero(t, reserve(l))
+		require.Zero(t, reserve(l))
+		require.Equal(t, 100*time.Millisecond, reserve(l))
+		require.Equal(t, 200*time.Millisecond, reserve(l))
+
+		// refills over time
+		now = now.Add(time.Second)
+		require.Zero(t, reserve(l))
+	})
+
+	t.Run("Test cancel returns the token", func(t *testing.T) {
+		l := newLimiter(1, 1)
+		require.Zero(t, reserve(l))
+		r, delay, _ := l.reserve()
+		require.Equal(t, time.Second, delay)
+		l.cancel(r)
+		require.Equal(t, time.Second, reserve(l))
+	})
+
+	t.Run("Test server quota", func(t *testing.T) {
+		l := newLimiter(0, 0)
+		limit, remaining, _ := l.Quota()
+		require.Zero(t, limit)
+		require.Zero(t, remaining)
+
+		// ignores responses without quota headers
+		l.Update(http.Header{})
+		limit, _, _ = l.Quota()
+		require.Zero(t, limit)
+
+		reset := now.Add(time.Minute)
+		l.Update(quotaHeader(100, 2, reset))
+		limit, remaining, r := l.Quota()
+		require.Equal(t, 100, limit)
+		require.Equal(t, 2, remaining)
+		require.Equal(t, reset, r)
+
+		require.Zero(t, reserve(l))
+		res, _, _ := l.reserve()
+		require.NotNil(t, res)
+
+		// a cancelled request returns its slot
+		l.cancel(res)
+		_, remaining, _ = l.Quota()
+		require.Equal(t, 1, remaining)
+		require.Zero(t, reserve(l))
+
+		// nothing is taken while waiting for the reset
+		res, delay, changed := l.reserve()
+		require.Nil(t, res)
+		require.Equal(t, time.Minute, delay)
+		require.NotNil(t, changed)
+
+		// once reset a single request is sent to learn the new quota while the others wait for it
+		now = reset.Add(time.Second)
+		probe, delay, _ := l.reserve()
+		require.NotNil(t, probe)
+		require.Zero(t, delay)
+		res, _, changed = l.reserve()
+		require.Nil(t, res)
+		l.done(probe, &http.Response{Header: quotaHeader(100, 1, now.Add(time.Hour))})
+		select {
+		case <-changed:
+		default:
+			require.Fail(t, "waiting callers weren't woken by the new quota")
+		}
+		require.Zero(t, reserve(l))
+		res, delay, _ = l.reserve()
+		require.Nil(t, res)
+		require.Equal(t, time.Hour, delay)
+	})
+
+	t.Run("Test quota is assumed reset when not reported", func(t *testing.T) {
+		l := newLimiter(0, 0)
+		reset := now.Add(time.Minute)
+		l.Update(quotaHeader(100, 0, reset))
+		now = reset
+		probe, _, _ := l.reserve()
+		require.True(t, probe.probe)
+		l.done(probe, &http.Response{Header: http.Header{}})
+		require.Zero(t, reserve(l))
+		require.Zero(t, reserve(l))
+	})
+
+	t.Run("Test transport waits and updates quota", func(t *testing.T) {
+		reset := time.Now().Add(time.Hour)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for k, v := range quotaHeader(50, 0, reset) {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		l := NewRateLimiter(0, 0)
+		client := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, l, server.Listener.Addr().String())}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		limit, remaining, _ := l.Quota()
+		require.Equal(t, 50, limit)
+		require.Equal(t, 0, remaining)
+
+		// quota exhausted so the next request blocks until the context is done
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		_, err = client.Do(req)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// requests to other hosts aren't limited
+		other := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, l, "api.example.org")}
+		resp, err = other.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
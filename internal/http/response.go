@@ -64,6 +64,8 @@ func DecodeBody(resp *http.Response, result any) error {
 	return nil
 }
 
+// DecodeError returns a resource.CloudFoundryAPIError for the unsuccessful response with the Cloud Foundry errors
+// from the response body, if any, and the request details
 func DecodeError(resp *http.Response) error {
 	if resp == nil || resp.Body == nil {
 		return errors.New("response has empty or invalid body")
@@ -71,16 +73,24 @@ func DecodeError(resp *http.Response) error {
 
 	defer ios.Close(resp.Body)
 
+	apiErr := resource.CloudFoundryAPIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RequestID:  resp.Header.Get(resource.RequestIDHeader),
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.URL = resp.Request.URL.String()
+		}
+	}
 	body, err := io.ReadAll(resp.Body)
+	apiErr.Body = body
 	if err == nil {
 		var cfErrs resource.CloudFoundryErrors
-		if err = json.Unmarshal(body, &cfErrs); err == nil && len(cfErrs.Errors) > 0 {
-			return cfErrs.Errors[0]
+		if err = json.Unmarshal(body, &cfErrs); err == nil {
+			apiErr.Errors = cfErrs.Errors
 		}
 	}
-	return resource.CloudFoundryHTTPError{
-		StatusCode: resp.StatusCode,
-		Status:     resp.Status,
-		Body:       body,
-	}
+	return apiErr
 }
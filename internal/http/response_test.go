@@ -87,12 +87,38 @@ func TestResponse(t *testing.T) {
 
 		resp := &http.Response{Body: io.NopCloser(strings.NewReader(`{"errors":[{"detail":"Unknown request","title":"CF-NotFound","code":10000}]}`))}
 		err = DecodeError(resp)
-		require.IsType(t, resource.CloudFoundryError{}, err)
+		require.IsType(t, resource.CloudFoundryAPIError{}, err)
 		require.EqualError(t, err, "cfclient error (CF-NotFound|10000): Unknown request")
+		var cfErr resource.CloudFoundryError
+		require.ErrorAs(t, err, &cfErr)
+		require.Equal(t, 10000, cfErr.Code)
 
 		resp = &http.Response{Body: io.NopCloser(strings.NewReader(`invalid request`)), StatusCode: 404, Status: "Not Found"}
 		err = DecodeError(resp)
-		require.IsType(t, resource.CloudFoundryHTTPError{}, err)
+		require.IsType(t, resource.CloudFoundryAPIError{}, err)
 		require.EqualError(t, err, "cfclient: HTTP error (404): Not Found")
+		var httpErr resource.CloudFoundryHTTPError
+		require.ErrorAs(t, err, &httpErr)
+		require.Equal(t, []byte("invalid request"), httpErr.Body)
+		require.ErrorIs(t, err, resource.ErrNotFound)
+
+		req, _ := http.NewRequest(http.MethodDelete, "https://api.example.org/v3/apps/guid", nil)
+		resp = &http.Response{
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"detail":"App is running","title":"CF-AppRunning","code":1},{"detail":"Try again","title":"CF-Other","code":2}]}`)),
+			StatusCode: 422,
+			Status:     "422 Unprocessable Entity",
+			Header:     http.Header{resource.RequestIDHeader: []string{"request-id"}},
+			Request:    req,
+		}
+		err = DecodeError(resp)
+		require.EqualError(t, err, "cfclient error (CF-AppRunning|1): App is running; cfclient error (CF-Other|2): Try again "+
+			"[DELETE https://api.example.org/v3/apps/guid, status 422, request ID request-id]")
+		var apiErr resource.CloudFoundryAPIError
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, "request-id", apiErr.RequestID)
+		require.Len(t, apiErr.Errors, 2)
+		require.ErrorIs(t, err, resource.ErrUnprocessable)
+		require.NotErrorIs(t, err, resource.ErrNotFound)
+		require.False(t, resource.IsRetryable(err))
 	})
 }
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+const (
+	RetryAfterHeader     = "Retry-After"
+	RateLimitResetHeader = "X-RateLimit-Reset"
+)
+
+// RetryPolicy configures how a retryTransport retries requests that failed with a transient error
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request is sent, including the first attempt
+	MaxAttempts int
+
+	// InitialBackoff is the base delay before the first retry, it doubles with each subsequent retry
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts. If the server asks the client to wait longer than
+	// this via the Retry-After or X-RateLimit-Reset headers then the request isn't retried.
+	MaxBackoff time.Duration
+
+	// RetryNonIdempotent allows POST and PATCH requests to be retried
+	RetryNonIdempotent bool
+}
+
+// retryTransport wraps a http.RoundTripper and retries requests that fail due to a transient
+// network error or a 429, 502, 503 or 504 response
+type retryTransport struct {
+	transport http.RoundTripper
+	policy    RetryPolicy
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewRetryTransport creates a new http.RoundTripper that retries transient failures according to the policy
+func NewRetryTransport(transport http.RoundTripper, policy RetryPolicy) http.RoundTripper {
+	return &retryTransport{
+		transport: transport,
+		policy:    policy,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.policy.MaxAttempts <= 1 || !t.isRetryableMethod(req.Method) {
+		return t.transport.RoundTrip(req)
+	}
+
+	// Clone the request body so it can be resent
+	if err := backupRequestBody(req); err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.transport.RoundTrip(req)
+		if attempt >= t.policy.MaxAttempts || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay, ok := t.delay(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+
+		// We're going to retry, consume any response to reuse the connection.
+		if resp != nil {
+			drainBody(resp)
+		}
+		if sleepErr := sleep(req.Context(), delay); sleepErr != nil {
+			return nil, sleepErr
+		}
+
+		// Rewind the request body
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (t *retryTransport) isRetryableMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return t.policy.RetryNonIdempotent
+	}
+}
+
+// delay returns how long to wait before the next attempt, or false if the server requested a wait
+// that is longer than the configured maximum backoff
+func (t *retryTransport) delay(attempt int, resp *http.Response) (time.Duration, bool) {
+	if d, ok := serverRequestedDelay(resp); ok {
+		return d, d <= t.policy.MaxBackoff
+	}
+
+	// exponential backoff with equal jitter
+	backoff := t.policy.InitialBackoff << (attempt - 1)
+	if backoff <= 0 || backoff > t.policy.MaxBackoff {
+		backoff = t.policy.MaxBackoff
+	}
+	half := int64(backoff / 2)
+	if half <= 0 {
+		return backoff, true
+	}
+	t.mu.Lock()
+	jitter := t.rand.Int63n(half + 1)
+	t.mu.Unlock()
+	return time.Duration(half + jitter), true
+}
+
+// serverRequestedDelay parses the Retry-After header or, for rate limited responses, the
+// X-RateLimit-Reset header which holds the epoch seconds when the rate limit quota is reset
+func serverRequestedDelay(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	if v := resp.Header.Get(RetryAfterHeader); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return nonNegative(time.Until(at)), true
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get(RateLimitResetHeader); v != "" {
+			if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return nonNegative(time.Until(time.Unix(epoch, 0))), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// shouldRetry returns true if the response or error indicates a transient failure
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return resource.IsRetryable(err)
+	}
+	return resource.IsRetryableStatus(resp.StatusCode)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransport(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}
+
+	// newServer responds with each of the statuses in turn then 200 once exhausted
+	newServer := func(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *int32, *[]string) {
+		var calls int32
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			i := int(atomic.AddInt32(&calls, 1)) - 1
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			if i < len(statuses) {
+				w.WriteHeader(statuses[i])
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		return server, &calls, &bodies
+	}
+
+	t.Run("Test retries transient status codes", func(t *testing.T) {
+		server, calls, _ := newServer(t, nil, http.StatusServiceUnavailable, http.StatusBadGateway)
+		client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, policy)}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.EqualValues(t, 3, atomic.LoadInt32(calls))
+	})
+
+	t.Run("Test returns last response after max attempts", func(t *testing.T) {
+		server, calls, _ := newServer(t, nil, 504, 504, 504, 504)
+		client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, policy)}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+		require.EqualValues(t, 3, atomic.LoadInt32(calls))
+	})
+
+	t.Run("Test does not retry non-transient status codes", func(t *testing.T) {
+		server, calls, _ := newServer(t, nil, http.StatusInternalServerError)
+		client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, policy)}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		require.EqualValues(t, 1, atomic.LoadInt32(calls))
+	})
+
+	t.Run("Test does not retry non-idempotent methods by default", func(t *testing.T) {
+		server, calls, _ := newServer(t, nil, http.StatusServiceUnavailable)
+		client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, policy)}
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"foo"}`))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		require.EqualValues(t, 1, atomic.LoadInt32(calls))
+	})
+
+	t.Run("Test retries non-idempotent methods and resends the body", func(t *testing.T) {
+		server, calls, bodies := newServer(t, nil, http.StatusServiceUnavailable)
+		p := policy
+		p.RetryNonIdempotent = true
+		client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, p)}
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"foo"}`))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.EqualValues(t, 2, atomic.LoadInt32(calls))
+		require.Equal(t, []string{`{"name":"foo"}`, `{"name":"foo"}`}, *bodies)
+	})
+
+	t.Run("Test does not retry when Retry-After exceeds max backoff", func(t *testing.T) {
+		server, calls, _ := newServer(t, http.Header{RetryAfterHeader: []string{"60"}}, http.StatusTooManyRequests)
+		client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, policy)}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.EqualValues(t, 1, atomic.LoadInt32(calls))
+	})
+
+	t.Run("Test stops waiting when the context is cancelled", func(t *testing.T) {
+		server, _, _ := newServer(t, http.Header{RetryAfterHeader: []string{"1"}}, http.StatusServiceUnavailable)
+		p := policy
+		p.MaxBackoff = time.Minute
+		client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, p)}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		_, err := client.Do(req)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Test server requested delay", func(t *testing.T) {
+		rateLimitReset := func(v string) http.Header {
+			h := http.Header{}
+			h.Set(RateLimitResetHeader, v)
+			return h
+		}
+
+		d, ok := serverRequestedDelay(&http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{RetryAfterHeader: []string{"5"}},
+		})
+		require.True(t, ok)
+		require.Equal(t, 5*time.Second, d)
+
+		d, ok = serverRequestedDelay(&http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     rateLimitReset(strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)),
+		})
+		require.True(t, ok)
+		require.InDelta(t, time.Hour, d, float64(2*time.Second))
+
+		_, ok = serverRequestedDelay(&http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     rateLimitReset("1"),
+		})
+		require.False(t, ok)
+	})
+
+	t.Run("Test exponential backoff is capped", func(t *testing.T) {
+		rt := NewRetryTransport(http.DefaultTransport, RetryPolicy{
+			MaxAttempts:    10,
+			InitialBackoff: time.Second,
+			MaxBackoff:     4 * time.Second,
+		}).(*retryTransport)
+		for attempt, upper := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+			d, ok := rt.delay(attempt+1, nil)
+			require.True(t, ok)
+			require.GreaterOrEqual(t, d, upper/2)
+			require.LessOrEqual(t, d, upper)
+		}
+	})
+}
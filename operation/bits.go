@@ -0,0 +1,336 @@
+package operation
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/ios"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// cfIgnoreFile contains gitignore style patterns of files to exclude from the app's bits
+const cfIgnoreFile = ".cfignore"
+
+// defaultIgnores are always excluded from the app's bits, the same as the CF CLI
+var defaultIgnores = []string{
+	".cfignore",
+	"/manifest.yml",
+	".gitignore",
+	".git",
+	".hg",
+	".svn",
+	"_darcs",
+	".DS_Store",
+}
+
+// appFile is a file or directory in an app's source directory
+type appFile struct {
+	// path is slash separated and relative to the app directory
+	path     string
+	dir      bool
+	mode     fs.FileMode
+	size     int64
+	checksum string
+	local    string
+}
+
+// resourceMatch returns the resource used to match the file against the CC's resource cache
+func (f *appFile) resourceMatch() resource.ResourceMatch {
+	return resource.ResourceMatch{
+		Checksum:    resource.ResourceMatchChecksum{Value: f.checksum},
+		SizeInBytes: int(f.size),
+		Path:        f.path,
+		Mode:        strconv.FormatUint(uint64(f.mode.Perm()), 8),
+	}
+}
+
+// uploadDirectory fingerprints the files in dir, matches them against the CC's resource cache and then uploads
+// only the unmatched files along with the matched resources
+func (p *AppPushOperation) uploadDirectory(ctx context.Context, pkg *resource.Package, dir string, progress *pushProgress) error {
+	files, err := gatherAppFiles(dir)
+	if err != nil {
+		return err
+	}
+	matched, err := p.matchResources(ctx, files)
+	if err != nil {
+		return err
+	}
+
+	unmatched := unmatchedFiles(files, matched)
+	var zipFile io.Reader
+	if len(unmatched) > 0 {
+		tmp, err := os.CreateTemp("", "cfclient-app-*.zip")
+		if err != nil {
+			return fmt.Errorf("error creating app zip file: %w", err)
+		}
+		defer func() {
+			ios.Close(tmp)
+			_ = os.Remove(tmp.Name())
+		}()
+		if err = zipAppFiles(tmp, unmatched); err != nil {
+			return err
+		}
+		if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("error reading app zip file: %w", err)
+		}
+		zipFile = newProgressReader(tmp, progress)
+	}
+
+	_, err = p.client.Packages.UploadWithResources(ctx, pkg.GUID, zipFile, matched)
+	return err
+}
+
+// matchResources returns the files that are already in the CC's resource cache
+func (p *AppPushOperation) matchResources(ctx context.Context, files []*appFile) ([]resource.ResourceMatch, error) {
+	toMatch := &resource.ResourceMatches{Resources: []resource.ResourceMatch{}}
+	for _, f := range files {
+		// empty files are always uploaded, there's nothing to gain from matching them
+		if !f.dir && f.size > 0 {
+			toMatch.Resources = append(toMatch.Resources, f.resourceMatch())
+		}
+	}
+	if len(toMatch.Resources) == 0 {
+		return nil, nil
+	}
+	matched, err := p.client.ResourceMatches.Create(ctx, toMatch)
+	if err != nil {
+		return nil, fmt.Errorf("error matching cached resources: %w", err)
+	}
+	return matched.Resources, nil
+}
+
+// unmatchedFiles returns the files, including directories, that weren't matched in the resource cache
+func unmatchedFiles(files []*appFile, matched []resource.ResourceMatch) []*appFile {
+	matchedPaths := make(map[string]bool, len(matched))
+	for _, m := range matched {
+		matchedPaths[m.Path] = true
+	}
+	var unmatched []*appFile
+	for _, f := range files {
+		if !matchedPaths[f.path] {
+			unmatched = append(unmatched, f)
+		}
+	}
+	return unmatched
+}
+
+// gatherAppFiles walks the app directory returning all the files and directories that aren't ignored by the
+// default ignores or the app's .cfignore
+func gatherAppFiles(dir string) ([]*appFile, error) {
+	ignores, err := loadIgnores(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*appFile
+	err = filepath.WalkDir(dir, func(local string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, local)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if ignores.ignored(rel, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// follow symlinks like the CF CLI
+		info, err := os.Stat(local)
+		if err != nil {
+			return err
+		}
+		f := &appFile{
+			path:  rel,
+			dir:   info.IsDir(),
+			mode:  info.Mode(),
+			local: local,
+		}
+		if !f.dir {
+			f.size = info.Size()
+			if f.checksum, err = sha1File(local); err != nil {
+				return err
+			}
+		}
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading app directory %s: %w", dir, err)
+	}
+	return files, nil
+}
+
+// zipAppFiles writes the files to a zip preserving their file modes
+func zipAppFiles(w io.Writer, files []*appFile) error {
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		header := &zip.FileHeader{
+			Name:   f.path,
+			Method: zip.Deflate,
+		}
+		if f.dir {
+			header.Name += "/"
+			header.Method = zip.Store
+		}
+		header.SetMode(f.mode)
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return fmt.Errorf("error adding %s to app zip file: %w", f.path, err)
+		}
+		if f.dir {
+			continue
+		}
+		if err = copyFile(fw, f.local); err != nil {
+			return fmt.Errorf("error adding %s to app zip file: %w", f.path, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("error writing app zip file: %w", err)
+	}
+	return nil
+}
+
+func sha1File(name string) (string, error) {
+	h := sha1.New()
+	if err := copyFile(h, name); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func copyFile(w io.Writer, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer ios.Close(f)
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// ignorePattern is a single gitignore style pattern
+type ignorePattern struct {
+	glob     string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// ignoreMatcher matches paths against the default ignores followed by the .cfignore patterns
+type ignoreMatcher struct {
+	patterns []ignorePattern
+}
+
+// loadIgnores reads the .cfignore file in the app directory, if any
+func loadIgnores(dir string) (*ignoreMatcher, error) {
+	lines := append([]string{}, defaultIgnores...)
+	f, err := os.Open(filepath.Join(dir, cfIgnoreFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return newIgnoreMatcher(lines), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", cfIgnoreFile, err)
+	}
+	defer ios.Close(f)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", cfIgnoreFile, err)
+	}
+	return newIgnoreMatcher(lines), nil
+}
+
+// newIgnoreMatcher parses the gitignore style pattern lines, blank lines and # comments are skipped
+func newIgnoreMatcher(lines []string) *ignoreMatcher {
+	m := &ignoreMatcher{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var p ignorePattern
+		if strings.HasPrefix(line, "!") {
+			p.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		// a pattern with a slash other than at the end is relative to the app directory
+		if strings.Contains(line, "/") {
+			p.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		p.glob = line
+		m.patterns = append(m.patterns, p)
+	}
+	return m
+}
+
+// ignored returns true if the slash separated path is ignored, the last matching pattern wins
+func (m *ignoreMatcher) ignored(name string, isDir bool) bool {
+	ignored := false
+	for _, p := range m.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		var matched bool
+		if p.anchored {
+			matched = matchGlob(strings.Split(p.glob, "/"), strings.Split(name, "/"))
+		} else {
+			matched, _ = path.Match(p.glob, path.Base(name))
+		}
+		if matched {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
+
+// matchGlob matches the path segments against the pattern segments, where ** matches zero or more segments
+func matchGlob(patterns, segments []string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+	if patterns[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchGlob(patterns[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(patterns[0], segments[0]); !ok {
+		return false
+	}
+	return matchGlob(patterns[1:], segments[1:])
+}
@@ -0,0 +1,93 @@
+package operation
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreMatcher(t *testing.T) {
+	m := newIgnoreMatcher(append(defaultIgnores,
+		"# comment",
+		"",
+		"*.log",
+		"!keep.log",
+		"/tmp",
+		"build/",
+		"docs/**/*.md",
+	))
+	tests := []struct {
+		path    string
+		dir     bool
+		ignored bool
+	}{
+		{path: ".git", dir: true, ignored: true},
+		{path: "src/.DS_Store", ignored: true},
+		{path: ".cfignore", ignored: true},
+		{path: "manifest.yml", ignored: true},
+		{path: "config/manifest.yml", ignored: false},
+		{path: "app.log", ignored: true},
+		{path: "logs/app.log", ignored: true},
+		{path: "logs/keep.log", ignored: false},
+		{path: "tmp", dir: true, ignored: true},
+		{path: "src/tmp", dir: true, ignored: false},
+		{path: "build", dir: true, ignored: true},
+		{path: "build", dir: false, ignored: false},
+		{path: "src/build", dir: true, ignored: true},
+		{path: "docs/README.md", ignored: true},
+		{path: "docs/api/v3/index.md", ignored: true},
+		{path: "docs/api/index.html", ignored: false},
+		{path: "main.go", ignored: false},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.ignored, m.ignored(tc.path, tc.dir), tc.path)
+	}
+}
+
+func TestGatherAndZipAppFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeAppFile(t, dir, ".cfignore", "*.log\n", 0644)
+	writeAppFile(t, dir, "manifest.yml", "applications: []", 0644)
+	writeAppFile(t, dir, "app.log", "log", 0644)
+	writeAppFile(t, dir, "bin/run.sh", "#!/bin/sh", 0755)
+	writeAppFile(t, dir, "index.html", "abc", 0600)
+	writeAppFile(t, dir, "empty", "", 0644)
+	writeAppFile(t, dir, ".git/HEAD", "ref: refs/heads/main", 0644)
+
+	files, err := gatherAppFiles(dir)
+	require.NoError(t, err)
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, f.path)
+	}
+	require.Equal(t, []string{"bin", "bin/run.sh", "empty", "index.html"}, paths)
+
+	index := files[3].resourceMatch()
+	require.Equal(t, "a9993e364706816aba3e25717850c26c9cd0d89d", index.Checksum.Value)
+	require.Equal(t, 3, index.SizeInBytes)
+	require.Equal(t, "600", index.Mode)
+	require.Equal(t, "755", files[1].resourceMatch().Mode)
+
+	var buf bytes.Buffer
+	require.NoError(t, zipAppFiles(&buf, files))
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 4)
+	require.Equal(t, "bin/", zr.File[0].Name)
+	require.True(t, zr.File[0].Mode().IsDir())
+	require.Equal(t, "bin/run.sh", zr.File[1].Name)
+	require.Equal(t, os.FileMode(0755), zr.File[1].Mode())
+	require.Equal(t, os.FileMode(0600), zr.File[3].Mode())
+}
+
+func writeAppFile(t *testing.T, dir, name, content string, mode os.FileMode) {
+	t.Helper()
+	name = filepath.Join(dir, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0755))
+	require.NoError(t, os.WriteFile(name, []byte(content), mode))
+	require.NoError(t, os.Chmod(name, mode))
+}
@@ -0,0 +1,214 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// PushPlan is a preview of the changes pushing a manifest would make
+type PushPlan struct {
+	Org   *resource.Organization
+	Space *resource.Space
+
+	// Diff is every change to the space's apps in the manifest
+	Diff []resource.ManifestDiffItem
+	Apps []*AppPushPlan
+}
+
+// AppPushPlan is a preview of the changes pushing one of the apps in the manifest would make
+type AppPushPlan struct {
+	Name string
+
+	// Create is true if the app doesn't exist yet
+	Create bool
+
+	// Diff are the changes to this app, the paths are relative to the app e.g. /processes/0/memory
+	Diff []resource.ManifestDiffItem
+
+	// Upload is true if the app's bits need uploading, which is false for docker apps or if every file is cached
+	Upload bool
+
+	// UploadFiles are the files that aren't in the resource cache and UploadBytes their total size, for a zip file
+	// path this is just the zip file
+	UploadFiles []string
+	UploadBytes int64
+
+	// CachedFiles is the number of files that are already in the resource cache so won't be uploaded
+	CachedFiles int
+
+	// Routes are the routes that would be mapped to the app and Services the service instances that would be bound
+	Routes   []string
+	Services []string
+}
+
+// DryRun previews pushing the manifest without changing anything. It resolves the org and space, diffs the manifest
+// against the space and matches each app's files against the resource cache to find what would be uploaded.
+func (p *AppPushOperation) DryRun(ctx context.Context, manifest *Manifest) (*PushPlan, error) {
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	org, err := p.findOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	space, err := p.findSpace(ctx, org.GUID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestYAML, err := marshalSpaceManifest(manifest.Applications...)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := p.client.Manifests.ManifestDiff(ctx, space.GUID, manifestYAML)
+	if err != nil {
+		return nil, fmt.Errorf("error diffing application manifest with space %s: %w", space.Name, err)
+	}
+
+	plan := &PushPlan{
+		Org:   org,
+		Space: space,
+		Diff:  diff.Diff,
+	}
+	for i, appManifest := range manifest.Applications {
+		appPlan, err := p.planApp(ctx, space, appManifest, appDiff(diff.Diff, i))
+		if err != nil {
+			return nil, fmt.Errorf("error planning push of app %s: %w", appManifest.Name, err)
+		}
+		plan.Apps = append(plan.Apps, appPlan)
+	}
+	return plan, nil
+}
+
+func (p *AppPushOperation) planApp(ctx context.Context, space *resource.Space, appManifest *AppManifest, diff []resource.ManifestDiffItem) (*AppPushPlan, error) {
+	plan := &AppPushPlan{
+		Name: appManifest.Name,
+		Diff: diff,
+	}
+	_, err := p.findApp(ctx, appManifest.Name, space)
+	if err == client.ErrExactlyOneResultNotReturned {
+		plan.Create = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	if plan.Create {
+		// everything in the manifest is new
+		if appManifest.Routes != nil {
+			for _, r := range *appManifest.Routes {
+				plan.Routes = append(plan.Routes, r.Route)
+			}
+		}
+		if appManifest.Services != nil {
+			for _, s := range *appManifest.Services {
+				plan.Services = append(plan.Services, s.Name)
+			}
+		}
+	} else {
+		plan.Routes, plan.Services = addedRoutesAndServices(diff)
+	}
+
+	if appManifest.Docker == nil {
+		if err = p.planUpload(ctx, appManifest, plan); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// planUpload finds the app's files that aren't in the resource cache
+func (p *AppPushOperation) planUpload(ctx context.Context, appManifest *AppManifest, plan *AppPushPlan) error {
+	dir := appManifest.Path
+	if dir == "" {
+		dir = "."
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("error reading app path: %w", err)
+	}
+	if !info.IsDir() {
+		plan.Upload = true
+		plan.UploadFiles = []string{dir}
+		plan.UploadBytes = info.Size()
+		return nil
+	}
+
+	files, err := gatherAppFiles(dir)
+	if err != nil {
+		return err
+	}
+	matched, err := p.matchResources(ctx, files)
+	if err != nil {
+		return err
+	}
+	plan.CachedFiles = len(matched)
+	for _, f := range unmatchedFiles(files, matched) {
+		if f.dir {
+			continue
+		}
+		plan.UploadFiles = append(plan.UploadFiles, f.path)
+		plan.UploadBytes += f.size
+	}
+	plan.Upload = len(plan.UploadFiles) > 0
+	return nil
+}
+
+// appDiff returns the diff items for the app at the index in the manifest with paths relative to the app
+func appDiff(diff []resource.ManifestDiffItem, index int) []resource.ManifestDiffItem {
+	prefix := "/applications/" + strconv.Itoa(index)
+	var items []resource.ManifestDiffItem
+	for _, item := range diff {
+		if item.Path != prefix && !strings.HasPrefix(item.Path, prefix+"/") {
+			continue
+		}
+		item.Path = strings.TrimPrefix(item.Path, prefix)
+		if item.Path == "" {
+			item.Path = "/"
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// addedRoutesAndServices returns the routes and services added by the app's diff
+func addedRoutesAndServices(diff []resource.ManifestDiffItem) (routes []string, services []string) {
+	for _, item := range diff {
+		if item.Op != "add" && item.Op != "replace" {
+			continue
+		}
+		segments := strings.Split(strings.TrimPrefix(item.Path, "/"), "/")
+		switch segments[0] {
+		case "routes":
+			routes = append(routes, diffValueNames(item.Value, "route")...)
+		case "services":
+			services = append(services, diffValueNames(item.Value, "name")...)
+		}
+	}
+	return routes, services
+}
+
+// diffValueNames returns the names in a diff value which can be a single name, an object with the name in the key
+// or a list of either
+func diffValueNames(value any, key string) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case map[string]any:
+		if name, ok := v[key].(string); ok {
+			return []string{name}
+		}
+	case []any:
+		var names []string
+		for _, item := range v {
+			names = append(names, diffValueNames(item, key)...)
+		}
+		return names
+	}
+	return nil
+}
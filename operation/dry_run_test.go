@@ -0,0 +1,106 @@
+package operation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestAppPushDryRun(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(19)
+	org := g.Organization()
+	space := g.Space()
+	app := g.Application()
+
+	dir := t.TempDir()
+	writeAppFile(t, dir, "cached.txt", "abc", 0644)
+	writeAppFile(t, dir, "index.html", "<html></html>", 0644)
+	cached := `{"checksum":{"value":"a9993e364706816aba3e25717850c26c9cd0d89d"},"size_in_bytes":3,"path":"cached.txt","mode":"644"}`
+
+	web := NewAppManifest(app.Name)
+	web.Path = dir
+	web.Routes = &AppManifestRoutes{{Route: "web.apps.example.org"}, {Route: "new.apps.example.org"}}
+	worker := NewAppManifest("worker")
+	worker.Docker = &AppManifestDocker{Image: "docker.io/music/worker"}
+	worker.NoRoute = true
+	worker.Services = &AppManifestServices{{Name: "queue"}}
+
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/organizations",
+			Output:   g.SinglePaged(org.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/spaces",
+			Output:   g.SinglePaged(space.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/v3/spaces/" + space.GUID + "/manifest_diff",
+			Output: []string{`{"diff":[
+				{"op":"replace","path":"/applications/0/processes/0/memory","was":"1G","value":"256M"},
+				{"op":"add","path":"/applications/0/routes/1","value":{"route":"new.apps.example.org"}},
+				{"op":"add","path":"/applications/0/services","value":["my-sql",{"name":"config"}]},
+				{"op":"add","path":"/applications/1/name","value":"worker"}
+			]}`},
+			Status: http.StatusCreated,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/apps",
+			Output:   append(g.SinglePaged(app.JSON), g.Paged([]string{})...),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/v3/resource_matches",
+			Output:   []string{`{"resources":[` + cached + `]}`},
+			Status:   http.StatusCreated,
+		},
+	}, t)
+	defer testutil.Teardown()
+
+	c, _ := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	cf, err := client.New(c)
+	require.NoError(t, err)
+
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	plan, err := pusher.DryRun(context.Background(), NewManifest(web, worker))
+	require.NoError(t, err)
+	require.Equal(t, space.GUID, plan.Space.GUID)
+	require.Len(t, plan.Diff, 4)
+	require.Len(t, plan.Apps, 2)
+
+	require.Equal(t, &AppPushPlan{
+		Name: app.Name,
+		Diff: []resource.ManifestDiffItem{
+			{Op: "replace", Path: "/processes/0/memory", Was: "1G", Value: "256M"},
+			{Op: "add", Path: "/routes/1", Value: map[string]any{"route": "new.apps.example.org"}},
+			{Op: "add", Path: "/services", Value: []any{"my-sql", map[string]any{"name": "config"}}},
+		},
+		Upload:      true,
+		UploadFiles: []string{"index.html"},
+		UploadBytes: 13,
+		CachedFiles: 1,
+		Routes:      []string{"new.apps.example.org"},
+		Services:    []string{"my-sql", "config"},
+	}, plan.Apps[0])
+
+	require.Equal(t, &AppPushPlan{
+		Name:     "worker",
+		Create:   true,
+		Diff:     []resource.ManifestDiffItem{{Op: "add", Path: "/name", Value: "worker"}},
+		Services: []string{"queue"},
+	}, plan.Apps[1])
+}
@@ -0,0 +1,194 @@
+package operation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// varPattern matches a ((var)) placeholder, nested values can be referenced using a dot e.g. ((db.host))
+var varPattern = regexp.MustCompile(`\(\(([-/.\w]+)\)\)`)
+
+// ReadVarsFile reads a YAML file of variables for manifest interpolation like the CF CLI's --vars-file
+func ReadVarsFile(filename string) (map[string]any, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading vars file: %w", err)
+	}
+	vars := map[string]any{}
+	if err = yaml.Unmarshal(b, &vars); err != nil {
+		return nil, fmt.Errorf("error parsing vars file %s: %w", filename, err)
+	}
+	return vars, nil
+}
+
+// ReadManifest reads, interpolates and validates the manifest file. Relative app paths are resolved from the
+// manifest's directory, the same as the CF CLI.
+//
+// The vars maps are merged in order with later maps taking precedence, so vars files followed by a map of inline
+// vars behave the same as the CF CLI's --vars-file and --var flags.
+func ReadManifest(filename string, vars ...map[string]any) (*Manifest, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+	m, err := ParseManifest(b, vars...)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifest %s: %w", filename, err)
+	}
+	dir := filepath.Dir(filename)
+	for _, app := range m.Applications {
+		if app.Path != "" && !filepath.IsAbs(app.Path) {
+			app.Path = filepath.Join(dir, app.Path)
+		}
+	}
+	return m, nil
+}
+
+// ParseManifest interpolates the ((var)) placeholders in the manifest YAML, then parses and validates it. All
+// placeholders must have a value or an error is returned listing the missing variables.
+func ParseManifest(b []byte, vars ...map[string]any) (*Manifest, error) {
+	merged := map[string]any{}
+	for _, v := range vars {
+		for k, val := range v {
+			merged[k] = val
+		}
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(b, &root); err != nil {
+		return nil, err
+	}
+	missing := map[string]bool{}
+	if err := interpolate(&root, merged, missing); err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("expected to find variables: %s", strings.Join(names, ", "))
+	}
+
+	var m Manifest
+	if err := root.Decode(&m); err != nil {
+		return nil, err
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Validate checks each application in the manifest has a unique name and a valid combination of properties
+func (m *Manifest) Validate() error {
+	if len(m.Applications) == 0 {
+		return errors.New("manifest must contain at least one application")
+	}
+	var errs []error
+	names := map[string]bool{}
+	for i, app := range m.Applications {
+		if app == nil || app.Name == "" {
+			errs = append(errs, fmt.Errorf("application %d must have a name", i))
+			continue
+		}
+		if names[app.Name] {
+			errs = append(errs, fmt.Errorf("application %s is in the manifest more than once", app.Name))
+		}
+		names[app.Name] = true
+		if app.Docker != nil {
+			if app.Docker.Image == "" {
+				errs = append(errs, fmt.Errorf("application %s must have a docker image", app.Name))
+			}
+			if app.Path != "" {
+				errs = append(errs, fmt.Errorf("application %s cannot have both a docker image and a path", app.Name))
+			}
+			if len(app.Buildpacks) > 0 {
+				errs = append(errs, fmt.Errorf("application %s cannot have both a docker image and buildpacks", app.Name))
+			}
+		}
+		if app.NoRoute && app.Routes != nil && len(*app.Routes) > 0 {
+			errs = append(errs, fmt.Errorf("application %s cannot have both no-route and routes", app.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// UnmarshalYAML allows a service to be specified by just its name, the same as the CF CLI
+func (s *AppManifestService) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		s.Name = value.Value
+		return nil
+	}
+	type service AppManifestService
+	return value.Decode((*service)(s))
+}
+
+// interpolate replaces the ((var)) placeholders in the node tree. A scalar that's entirely a placeholder is
+// replaced with the variable's value, which may be any YAML type, otherwise the value is substituted as a string.
+func interpolate(node *yaml.Node, vars map[string]any, missing map[string]bool) error {
+	if node.Kind != yaml.ScalarNode {
+		for _, n := range node.Content {
+			if err := interpolate(n, vars, missing); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if m := varPattern.FindStringSubmatch(node.Value); m != nil && m[0] == node.Value {
+		v, ok := lookupVar(vars, m[1])
+		if !ok {
+			missing[m[1]] = true
+			return nil
+		}
+		var replacement yaml.Node
+		if err := replacement.Encode(v); err != nil {
+			return fmt.Errorf("error interpolating variable %s: %w", m[1], err)
+		}
+		*node = replacement
+		return nil
+	}
+
+	node.Value = varPattern.ReplaceAllStringFunc(node.Value, func(placeholder string) string {
+		name := varPattern.FindStringSubmatch(placeholder)[1]
+		v, ok := lookupVar(vars, name)
+		if !ok {
+			missing[name] = true
+			return placeholder
+		}
+		return fmt.Sprint(v)
+	})
+	if node.Style == 0 {
+		// let the substituted plain value be resolved again, e.g. as an int
+		node.Tag = ""
+	}
+	return nil
+}
+
+// lookupVar finds the variable by name, if there's no exact match then a dotted name is used as a path into nested
+// maps
+func lookupVar(vars map[string]any, name string) (any, bool) {
+	if v, ok := vars[name]; ok {
+		return v, true
+	}
+	var current any = vars
+	for _, key := range strings.Split(name, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
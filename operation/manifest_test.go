@@ -1,6 +1,8 @@
 package operation
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -55,6 +57,93 @@ func TestManifestMarshalling(t *testing.T) {
 	require.Equal(t, minimalSpringMusicYaml, string(b))
 }
 
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest([]byte(interpolatedManifestYaml),
+		map[string]any{
+			"instances": 1,
+			"env":       map[string]any{"SPRING_CLOUD_PROFILE": "dev"},
+			"db":        map[string]any{"name": "my-sql"},
+		},
+		map[string]any{
+			"instances": 2,
+			"domain":    "apps.example.org",
+		})
+	require.NoError(t, err)
+	require.Len(t, m.Applications, 2)
+
+	app := m.Applications[0]
+	require.Equal(t, "spring-music", app.Name)
+	require.Equal(t, uint(2), app.Instances)
+	require.Equal(t, map[string]string{"SPRING_CLOUD_PROFILE": "dev"}, app.Env)
+	require.Equal(t, &AppManifestRoutes{{Route: "spring-music.apps.example.org"}}, app.Routes)
+	require.Equal(t, &AppManifestServices{{Name: "my-sql"}, {Name: "config", BindingName: "cfg"}}, app.Services)
+	require.Equal(t, "music-worker", m.Applications[1].Name)
+	require.Equal(t, "docker.io/music/worker:2", m.Applications[1].Docker.Image)
+
+	_, err = ParseManifest([]byte(interpolatedManifestYaml), map[string]any{"instances": 1})
+	require.EqualError(t, err, "expected to find variables: db.name, domain, env")
+}
+
+func TestReadManifest(t *testing.T) {
+	dir := t.TempDir()
+	manifestFile := filepath.Join(dir, "manifest.yml")
+	varsFile := filepath.Join(dir, "vars.yml")
+	require.NoError(t, os.WriteFile(manifestFile, []byte(`applications:
+- name: ((name))
+  path: target/app.jar
+- name: absolute
+  path: /srv/app
+`), 0644))
+	require.NoError(t, os.WriteFile(varsFile, []byte("name: from-file\n"), 0644))
+
+	vars, err := ReadVarsFile(varsFile)
+	require.NoError(t, err)
+	m, err := ReadManifest(manifestFile, vars)
+	require.NoError(t, err)
+	require.Equal(t, "from-file", m.Applications[0].Name)
+	require.Equal(t, filepath.Join(dir, "target", "app.jar"), m.Applications[0].Path)
+	require.Equal(t, "/srv/app", m.Applications[1].Path)
+
+	m, err = ReadManifest(manifestFile, vars, map[string]any{"name": "inline"})
+	require.NoError(t, err)
+	require.Equal(t, "inline", m.Applications[0].Name)
+}
+
+func TestManifestValidate(t *testing.T) {
+	require.EqualError(t, (&Manifest{}).Validate(), "manifest must contain at least one application")
+
+	docker := NewAppManifest("docker")
+	docker.Docker = &AppManifestDocker{}
+	docker.Path = "."
+	noRoute := NewAppManifest("no-route")
+	noRoute.NoRoute = true
+	noRoute.Routes = &AppManifestRoutes{{Route: "no-route.apps.example.org"}}
+	err := NewManifest(NewAppManifest("app"), NewAppManifest("app"), NewAppManifest(""), docker, noRoute).Validate()
+	require.EqualError(t, err, `application app is in the manifest more than once
+application 2 must have a name
+application docker must have a docker image
+application docker cannot have both a docker image and a path
+application no-route cannot have both no-route and routes`)
+
+	require.NoError(t, NewManifest(NewAppManifest("app"), NewAppManifest("worker")).Validate())
+}
+
+const interpolatedManifestYaml = `applications:
+- name: spring-music
+  instances: ((instances))
+  env: ((env))
+  routes:
+  - route: spring-music.((domain))
+  services:
+  - ((db.name))
+  - name: config
+    binding_name: cfg
+- name: music-worker
+  docker:
+    image: docker.io/music/worker:((instances))
+  no-route: true
+`
+
 const fullSpringMusicYaml = `applications:
 - name: spring-music
   buildpacks:
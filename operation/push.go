@@ -2,14 +2,17 @@ package operation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sync"
 	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/ios"
 	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
 )
 
@@ -19,14 +22,36 @@ const (
 	StrategyNone StrategyMode = iota
 	StrategyBlueGreen
 	StrategyRolling
+	StrategyCanary
 )
 
+// appBits are the source files of a buildpack app, either already zipped or a directory to zip
+type appBits struct {
+	zipFile io.Reader
+	dir     string
+}
+
+// AppPushResult is the outcome of pushing one of the apps in a manifest
+type AppPushResult struct {
+	Name string
+	App  *resource.App
+	Err  error
+
+	// Result has the details of the push, it's nil if the push failed
+	Result *PushResult
+}
+
 // AppPushOperation can be used to push buildpack apps
 type AppPushOperation struct {
-	orgName   string
-	spaceName string
-	client    *client.Client
-	strategy  StrategyMode
+	orgName     string
+	spaceName   string
+	client      *client.Client
+	strategy    StrategyMode
+	stagingLogs func(envelope *resource.Envelope)
+	progress    func(event PushEvent)
+	parallelism int
+	maxInFlight int
+	canarySteps []int
 }
 
 // NewAppPushOperation creates a new AppPushOperation
@@ -41,15 +66,54 @@ func NewAppPushOperation(client *client.Client, orgName, spaceName string) *AppP
 }
 func (p *AppPushOperation) WithStrategy(s StrategyMode) {
 	switch s {
-	case StrategyBlueGreen, StrategyRolling:
+	case StrategyBlueGreen, StrategyRolling, StrategyCanary:
 		p.strategy = s
 	default:
 		p.strategy = StrategyNone
 	}
 }
 
+// WithStagingLogs streams the staging output of each build to the handler while waiting for the app to stage.
+// Staging logs are best effort, if the log stream isn't available the push continues without them.
+func (p *AppPushOperation) WithStagingLogs(handler func(envelope *resource.Envelope)) {
+	p.stagingLogs = handler
+}
+
+// WithProgress sends the progress of each push to the handler as it happens. When pushing a manifest in parallel
+// the handler is called concurrently for different apps.
+func (p *AppPushOperation) WithProgress(handler func(event PushEvent)) {
+	p.progress = handler
+}
+
+// WithMaxInFlight sets how many instances the rolling and canary strategies start at the same time, by default
+// the CC starts one at a time
+func (p *AppPushOperation) WithMaxInFlight(n int) {
+	p.maxInFlight = n
+}
+
+// WithCanarySteps sets the percentage of instances running the new droplet at each step of the canary strategy.
+// The deployment pauses after each step until it's continued, by default there's a single step of one instance.
+func (p *AppPushOperation) WithCanarySteps(instanceWeights ...int) {
+	p.canarySteps = instanceWeights
+}
+
+// WithParallelism sets how many apps PushManifest pushes at the same time, by default apps are pushed one at a time
+func (p *AppPushOperation) WithParallelism(n int) {
+	p.parallelism = n
+}
+
 // Push creates or updates an application using the specified manifest and zipped source files
 func (p *AppPushOperation) Push(ctx context.Context, appManifest *AppManifest, zipFile io.Reader) (*resource.App, error) {
+	result, err := p.PushWithResult(ctx, appManifest, zipFile)
+	if err != nil {
+		return nil, err
+	}
+	return result.App, nil
+}
+
+// PushWithResult is the same as Push but returns the GUIDs of the resources created by the push and how long
+// each stage took
+func (p *AppPushOperation) PushWithResult(ctx context.Context, appManifest *AppManifest, zipFile io.Reader) (*PushResult, error) {
 	org, err := p.findOrg(ctx)
 	if err != nil {
 		return nil, err
@@ -58,26 +122,160 @@ func (p *AppPushOperation) Push(ctx context.Context, appManifest *AppManifest, z
 	if err != nil {
 		return nil, err
 	}
-	return p.pushWithStrategyApp(ctx, space, appManifest, zipFile)
+	return p.push(ctx, space, appManifest, appBits{zipFile: zipFile})
 }
-func (p *AppPushOperation) pushWithStrategyApp(ctx context.Context, space *resource.Space, manifest *AppManifest, zipFile io.Reader) (*resource.App, error) {
+
+// PushDirectory creates or updates an application using the specified manifest and the source files in dir. If dir
+// is empty the manifest's path is used, defaulting to the current directory. Files matching the CF CLI's default
+// ignores or the app's .cfignore are excluded and only files not already in the resource cache are uploaded.
+//
+// If the path is a file rather than a directory it's uploaded as is, so it must be a zip, jar or war file.
+func (p *AppPushOperation) PushDirectory(ctx context.Context, appManifest *AppManifest, dir string) (*resource.App, error) {
+	result, err := p.PushDirectoryWithResult(ctx, appManifest, dir)
+	if err != nil {
+		return nil, err
+	}
+	return result.App, nil
+}
+
+// PushDirectoryWithResult is the same as PushDirectory but returns the GUIDs of the resources created by the push
+// and how long each stage took
+func (p *AppPushOperation) PushDirectoryWithResult(ctx context.Context, appManifest *AppManifest, dir string) (*PushResult, error) {
+	bits, closeBits, err := openAppBits(appManifest, dir)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBits()
+
+	org, err := p.findOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	space, err := p.findSpace(ctx, org.GUID)
+	if err != nil {
+		return nil, err
+	}
+	return p.push(ctx, space, appManifest, bits)
+}
+
+// PushManifest validates the manifest and then pushes each of its applications from their manifest path, the
+// same as PushDirectory. An app failing to push doesn't stop the others, the result of every app is returned in
+// manifest order along with an error joining any failures.
+func (p *AppPushOperation) PushManifest(ctx context.Context, manifest *Manifest) ([]*AppPushResult, error) {
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	org, err := p.findOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	space, err := p.findSpace(ctx, org.GUID)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := p.parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	results := make([]*AppPushResult, len(manifest.Applications))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, appManifest := range manifest.Applications {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, appManifest *AppManifest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result, err := p.pushManifestApp(ctx, space, appManifest)
+			results[i] = &AppPushResult{
+				Name:   appManifest.Name,
+				Err:    err,
+				Result: result,
+			}
+			if result != nil {
+				results[i].App = result.App
+			}
+		}(i, appManifest)
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("error pushing app %s: %w", r.Name, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func (p *AppPushOperation) pushManifestApp(ctx context.Context, space *resource.Space, appManifest *AppManifest) (*PushResult, error) {
+	bits, closeBits, err := openAppBits(appManifest, "")
+	if err != nil {
+		return nil, err
+	}
+	defer closeBits()
+	return p.push(ctx, space, appManifest, bits)
+}
+
+// openAppBits returns the source files to push for the app, docker apps don't have any. The returned func must be
+// called once the bits have been uploaded.
+func openAppBits(appManifest *AppManifest, dir string) (appBits, func(), error) {
+	if appManifest.Docker != nil {
+		return appBits{}, func() {}, nil
+	}
+	if dir == "" {
+		dir = appManifest.Path
+	}
+	if dir == "" {
+		dir = "."
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return appBits{}, nil, fmt.Errorf("error reading app path: %w", err)
+	}
+	if info.IsDir() {
+		return appBits{dir: dir}, func() {}, nil
+	}
+	zipFile, err := os.Open(dir)
+	if err != nil {
+		return appBits{}, nil, fmt.Errorf("error reading app path: %w", err)
+	}
+	return appBits{zipFile: zipFile}, func() { ios.Close(zipFile) }, nil
+}
+
+// push pushes the app using the strategy and returns the result of the push
+func (p *AppPushOperation) push(ctx context.Context, space *resource.Space, manifest *AppManifest, bits appBits) (*PushResult, error) {
+	progress := newPushProgress(manifest.Name, p.progress)
+	app, err := p.pushWithStrategyApp(ctx, space, manifest, bits, progress)
+	if err != nil {
+		return nil, err
+	}
+	return progress.finish(app), nil
+}
+
+func (p *AppPushOperation) pushWithStrategyApp(ctx context.Context, space *resource.Space, manifest *AppManifest, bits appBits, progress *pushProgress) (*resource.App, error) {
 	switch p.strategy {
 	case StrategyBlueGreen:
-		return p.pushBlueGreenApp(ctx, space, manifest, zipFile)
+		return p.pushBlueGreenApp(ctx, space, manifest, bits, progress)
 	case StrategyRolling:
-		return p.pushRollingApp(ctx, space, manifest, zipFile)
+		return p.pushDeploymentApp(ctx, space, manifest, bits, progress, resource.DeploymentStrategyRolling)
+	case StrategyCanary:
+		return p.pushDeploymentApp(ctx, space, manifest, bits, progress, resource.DeploymentStrategyCanary)
 	default:
-		return p.pushApp(ctx, space, manifest, zipFile)
+		return p.pushApp(ctx, space, manifest, bits, progress)
 	}
 }
 
-func (p *AppPushOperation) pushBlueGreenApp(ctx context.Context, space *resource.Space, manifest *AppManifest, zipFile io.Reader) (*resource.App, error) {
+func (p *AppPushOperation) pushBlueGreenApp(ctx context.Context, space *resource.Space, manifest *AppManifest, bits appBits, progress *pushProgress) (*resource.App, error) {
 	originalApp, err := p.findApp(ctx, manifest.Name, space)
 	if err != nil && err != client.ErrExactlyOneResultNotReturned {
 		return nil, err
 	}
 	if err == client.ErrExactlyOneResultNotReturned || originalApp.State != "STARTED" {
-		return p.pushApp(ctx, space, manifest, zipFile)
+		return p.pushApp(ctx, space, manifest, bits, progress)
 	}
 
 	tempAppName := originalApp.Name + "-venerable"
@@ -96,135 +294,226 @@ func (p *AppPushOperation) pushBlueGreenApp(ctx context.Context, space *resource
 		Name: tempAppName,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update app name failed with: %s", err.Error())
+		return nil, fmt.Errorf("failed to update app name failed with: %w", err)
 	}
 
 	// Apply the manifest
-	newApp, err := p.pushApp(ctx, space, manifest, zipFile)
+	newApp, pushErr := p.startApp(ctx, space, manifest, bits, progress)
+	if pushErr != nil {
+		// applying the manifest creates the new app, so it may exist even though the push failed
+		newApp, err = p.findApp(ctx, manifest.Name, space)
+		if err != nil && err != client.ErrExactlyOneResultNotReturned {
+			return nil, fmt.Errorf("blue green deployment failed with: %w\nfailed to find new app: failed with %w", pushErr, err)
+		}
+		return nil, p.rollBackBlueGreen(ctx, originalApp, newApp, pushErr)
+	}
+
+	// Only delete the original app once the new app's instances are healthy
+	err = p.waitForRunning(ctx, newApp, manifest, progress)
 	if err != nil {
-		// If push fails change back original app name
-		_, err = p.client.Applications.Update(ctx, originalApp.GUID, &resource.AppUpdate{
-			Name: originalApp.Name,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to update app name back to original name: failed with %s", err.Error())
+		return nil, p.rollBackBlueGreen(ctx, originalApp, newApp, fmt.Errorf("failed to verify application start: %w", err))
+	}
+	err = p.gracefulDeletion(ctx, originalApp)
+	return newApp, err
+}
+
+// rollBackBlueGreen deletes the new app, if it was pushed, and changes the original app's name back
+func (p *AppPushOperation) rollBackBlueGreen(ctx context.Context, originalApp, newApp *resource.App, pushErr error) error {
+	if newApp != nil {
+		if err := p.gracefulDeletion(ctx, newApp); err != nil {
+			return fmt.Errorf("blue green deployment failed with: %w\nfailed to delete new app: failed with %w", pushErr, err)
 		}
-		return nil, fmt.Errorf("blue green deployment failed with: %s", err.Error())
 	}
-	if newApp.State == "STARTED" {
-		err = p.gracefulDeletion(ctx, originalApp)
-		return newApp, err
+	_, err := p.client.Applications.Update(ctx, originalApp.GUID, &resource.AppUpdate{
+		Name: originalApp.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("blue green deployment failed with: %w\nfailed to update app name back to original name: failed with %w", pushErr, err)
 	}
-	return newApp, fmt.Errorf("failed to verify application start: %s", err.Error())
+	return fmt.Errorf("blue green deployment failed with: %w", pushErr)
 }
 
-func (p *AppPushOperation) pushRollingApp(ctx context.Context, space *resource.Space, manifest *AppManifest, zipFile io.Reader) (*resource.App, error) {
+// pushDeploymentApp pushes a new droplet to the started app using a CC deployment with the rolling or canary
+// strategy, so the app has zero downtime. If the deployment fails it's canceled which rolls the app back to its
+// previous droplet.
+//
+// A canary deployment is left paused at its first step once the canary instances are running, use ContinueCanary to
+// deploy the next step or CancelDeployment to roll back.
+func (p *AppPushOperation) pushDeploymentApp(ctx context.Context, space *resource.Space, manifest *AppManifest, bits appBits, progress *pushProgress, strategy resource.DeploymentStrategyType) (*resource.App, error) {
 	originalApp, err := p.findApp(ctx, manifest.Name, space)
 	if err != nil && err != client.ErrExactlyOneResultNotReturned {
 		return nil, err
 	}
 	if err == client.ErrExactlyOneResultNotReturned || originalApp.State != "STARTED" {
-		return p.pushApp(ctx, space, manifest, zipFile)
+		return p.pushApp(ctx, space, manifest, bits, progress)
 	}
-	// Get the fallback revision in case of rollback
-	fallbackRevision, _ := p.client.Revisions.SingleForAppDeployed(ctx, originalApp.GUID, nil)
 
-	err = p.applySpaceManifest(ctx, space, manifest)
+	err = p.applySpaceManifest(ctx, space, manifest, progress)
 	if err != nil {
 		return nil, err
 	}
 
 	var pkg *resource.Package
 	if manifest.Docker != nil {
-		pkg, err = p.uploadDockerPackage(ctx, originalApp, manifest.Docker)
+		pkg, err = p.uploadDockerPackage(ctx, originalApp, manifest.Docker, progress)
 	} else {
-		pkg, err = p.uploadBitsPackage(ctx, originalApp, zipFile)
+		pkg, err = p.uploadBitsPackage(ctx, originalApp, bits, progress)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	droplet, err := p.buildDroplet(ctx, pkg, manifest)
+	droplet, err := p.buildDroplet(ctx, originalApp, pkg, manifest, progress)
 	if err != nil {
 		return nil, err
 	}
 
-	deployment, err := p.createNewDeployment(ctx, originalApp, droplet)
+	deployment, err := p.client.Deployments.Create(ctx, p.newDeployment(originalApp, droplet, strategy))
 	if err != nil {
-		return nil, fmt.Errorf("failed to deploy with: %s", err.Error())
+		return nil, fmt.Errorf("failed to deploy with: %w", err)
 	}
+	progress.deployment(deployment.GUID)
 	// In case application crashed due to new deployment, deployment will be stuck with value "ACTIVE" and reason "DEPLOYING"
 	// This will be considered as deployment failed after timeout
-	depPollErr := p.waitForDeployment(ctx, deployment.GUID, manifest.Instances)
+	pollOptions := deploymentPollingOptions(manifest.Instances)
+	if strategy == resource.DeploymentStrategyCanary {
+		_, err = p.client.Deployments.PollPaused(ctx, deployment.GUID, pollOptions)
+	} else {
+		_, err = p.client.Deployments.PollFinalized(ctx, deployment.GUID, pollOptions)
+	}
+	if err != nil {
+		return nil, p.rollBackDeployment(ctx, deployment.GUID, manifest.Instances, err)
+	}
 
-	// Check the app state if app not started or deployment failed rollback the deployment
-	originalApp, err = p.findApp(ctx, manifest.Name, space)
+	// Check the app state if app not started rollback the deployment
+	app, err := p.findApp(ctx, manifest.Name, space)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify application status with: %w", err)
+	}
+	if app.State != "STARTED" {
+		return nil, p.rollBackDeployment(ctx, deployment.GUID, manifest.Instances, fmt.Errorf("app %s is %s", app.Name, app.State))
+	}
+	progress.event(PushEventInstancesRunning, "")
+	return app, nil
+}
+
+// ContinueCanary deploys the next step of the app's paused canary deployment and waits for it to pause again or, after
+// the last step, to finish deploying
+func (p *AppPushOperation) ContinueCanary(ctx context.Context, appName string) (*resource.Deployment, error) {
+	deployment, instances, err := p.findActiveDeployment(ctx, appName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify application status with: %s", err.Error())
+		return nil, err
+	}
+	if deployment.Status.Reason != resource.DeploymentStatusReasonPaused.String() {
+		return nil, fmt.Errorf("deployment %s for app %s is %s, not paused", deployment.GUID, appName, deployment.Status.Reason)
+	}
+	if err = p.client.Deployments.Continue(ctx, deployment.GUID); err != nil {
+		return nil, fmt.Errorf("failed to continue deployment %s with: %w", deployment.GUID, err)
+	}
+	return p.client.Deployments.PollPaused(ctx, deployment.GUID, deploymentPollingOptions(instances))
+}
+
+// CancelDeployment cancels the app's active deployment, rolling the app back to its previous droplet, and waits for
+// the cancellation to finish
+func (p *AppPushOperation) CancelDeployment(ctx context.Context, appName string) error {
+	deployment, instances, err := p.findActiveDeployment(ctx, appName)
+	if err != nil {
+		return err
+	}
+	return p.cancelDeployment(ctx, deployment.GUID, instances)
+}
+
+// WaitForDeployment waits for the app's active deployment to finish deploying. If the deployment is canceled or
+// superseded the error wraps client.ErrDeploymentCanceled and includes the status reason.
+func (p *AppPushOperation) WaitForDeployment(ctx context.Context, appName string) (*resource.Deployment, error) {
+	deployment, instances, err := p.findActiveDeployment(ctx, appName)
+	if err != nil {
+		return nil, err
+	}
+	return p.client.Deployments.PollFinalized(ctx, deployment.GUID, deploymentPollingOptions(instances))
+}
+
+func (p *AppPushOperation) newDeployment(app *resource.App, droplet *resource.Droplet, strategy resource.DeploymentStrategyType) *resource.DeploymentCreate {
+	d := resource.NewDeploymentCreateWithStrategy(app.GUID, droplet.GUID, strategy)
+	if p.maxInFlight > 0 {
+		d.Options = &resource.DeploymentOptions{MaxInFlight: p.maxInFlight}
 	}
-	if originalApp.State != "STARTED" || depPollErr != nil {
-		rollBackDeployment, rollBackErr := p.rollBackDeployment(ctx, originalApp, fallbackRevision)
-		if rollBackErr != nil {
-			return nil, fmt.Errorf("failed to confirm rollback deployment with: %s", rollBackErr.Error())
+	if strategy == resource.DeploymentStrategyCanary && len(p.canarySteps) > 0 {
+		if d.Options == nil {
+			d.Options = &resource.DeploymentOptions{}
 		}
-		depRollPollErr := p.waitForDeployment(ctx, rollBackDeployment.GUID, manifest.Instances)
-		if depRollPollErr != nil {
-			return nil, fmt.Errorf("failed to deploy with: %s \nfailed to confirm roll back to last deployment with: %s", depPollErr.Error(), depRollPollErr.Error())
+		d.Options.Canary = &resource.DeploymentCanaryOptions{}
+		for _, weight := range p.canarySteps {
+			d.Options.Canary.Steps = append(d.Options.Canary.Steps, resource.DeploymentCanaryStep{InstanceWeight: weight})
 		}
-		return nil, fmt.Errorf("failed to deploy with: %s \nrolled back to last deployment", depPollErr.Error())
 	}
+	return d
+}
 
-	return originalApp, nil
+// rollBackDeployment cancels the failed deployment, unless it's already finalized, and returns the deployment error
+func (p *AppPushOperation) rollBackDeployment(ctx context.Context, deploymentGUID string, instances uint, deployErr error) error {
+	if errors.Is(deployErr, client.ErrDeploymentCanceled) {
+		return fmt.Errorf("failed to deploy with: %w", deployErr)
+	}
+	if err := p.cancelDeployment(ctx, deploymentGUID, instances); err != nil {
+		return fmt.Errorf("failed to deploy with: %w\nfailed to roll back to last deployment with: %w", deployErr, err)
+	}
+	return fmt.Errorf("failed to deploy with: %w\nrolled back to last deployment", deployErr)
 }
 
-// Poll for deployment status and wait for the deployment to be in the final state
-// Timeout is calculated based on the number of instances
-func (p *AppPushOperation) waitForDeployment(ctx context.Context, deploymentGUID string, instances uint) error {
-	// If instances is not set default to 1
-	if instances == 0 {
-		instances = 1
+func (p *AppPushOperation) cancelDeployment(ctx context.Context, deploymentGUID string, instances uint) error {
+	if err := p.client.Deployments.Cancel(ctx, deploymentGUID); err != nil {
+		return fmt.Errorf("failed to cancel deployment %s with: %w", deploymentGUID, err)
+	}
+	_, err := p.client.Deployments.PollFinalized(ctx, deploymentGUID, deploymentPollingOptions(instances))
+	if err != nil && !errors.Is(err, client.ErrDeploymentCanceled) {
+		return fmt.Errorf("failed waiting for deployment %s to cancel with: %w", deploymentGUID, err)
+	}
+	return nil
+}
+
+// findActiveDeployment returns the app's active deployment and the number of instances being deployed
+func (p *AppPushOperation) findActiveDeployment(ctx context.Context, appName string) (*resource.Deployment, uint, error) {
+	org, err := p.findOrg(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	space, err := p.findSpace(ctx, org.GUID)
+	if err != nil {
+		return nil, 0, err
+	}
+	app, err := p.findApp(ctx, appName, space)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not find app %s: %w", appName, err)
+	}
+	opts := client.NewDeploymentListOptions()
+	opts.AppGUIDs.EqualTo(app.GUID)
+	opts.StatusValues.EqualTo(resource.DeploymentStatusValueActive.String())
+	deployment, err := p.client.Deployments.Single(ctx, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not find an active deployment for app %s: %w", appName, err)
 	}
-	pollOptions := client.NewPollingOptions()
-	pollOptions.Timeout = time.Duration(instances) * time.Minute
 
-	depPollErr := client.PollForStateOrTimeout(func() (string, error) {
-		deployment, err := p.client.Deployments.Get(ctx, deploymentGUID)
+	var instances uint
+	for _, np := range deployment.NewProcesses {
+		process, err := p.client.Processes.Get(ctx, np.GUID)
 		if err != nil {
-			return "", err
+			return nil, 0, err
 		}
-		return deployment.Status.Value, nil
-	}, "FINALIZED", pollOptions)
-	return depPollErr
-}
-
-func (p *AppPushOperation) createNewDeployment(ctx context.Context, originalApp *resource.App, droplet *resource.Droplet) (*resource.Deployment, error) {
-	return p.client.Deployments.Create(ctx, &resource.DeploymentCreate{
-		Relationships: resource.AppRelationship{
-			App: resource.ToOneRelationship{
-				Data: &resource.Relationship{
-					GUID: originalApp.GUID,
-				},
-			},
-		},
-		Droplet: &resource.Relationship{
-			GUID: droplet.GUID,
-		},
-	})
+		instances += uint(process.Instances)
+	}
+	return deployment, instances, nil
 }
 
-func (p *AppPushOperation) rollBackDeployment(ctx context.Context, originalApp *resource.App, fallbackRevision *resource.Revision) (*resource.Deployment, error) {
-	return p.client.Deployments.Create(ctx, &resource.DeploymentCreate{
-		Relationships: resource.AppRelationship{
-			App: resource.ToOneRelationship{
-				Data: &resource.Relationship{
-					GUID: originalApp.GUID,
-				},
-			},
-		},
-		Revision: &resource.DeploymentRevision{
-			GUID: fallbackRevision.GUID,
-		},
-	})
+// deploymentPollingOptions allows a minute per instance for the deployment to finish or the instances to start
+func deploymentPollingOptions(instances uint) *client.PollingOptions {
+	// If instances is not set default to 1
+	if instances == 0 {
+		instances = 1
+	}
+	pollOptions := client.NewPollingOptions()
+	pollOptions.Timeout = time.Duration(instances) * time.Minute
+	return pollOptions
 }
 
 // Stop the application and delete it
@@ -232,7 +521,7 @@ func (p *AppPushOperation) rollBackDeployment(ctx context.Context, originalApp *
 func (p *AppPushOperation) gracefulDeletion(ctx context.Context, app *resource.App) error {
 	app, err := p.client.Applications.Stop(ctx, app.GUID)
 	if err != nil {
-		return fmt.Errorf("failed to stop the application with: %s", err.Error())
+		return fmt.Errorf("failed to stop the application with: %w", err)
 	}
 	jobId, err := p.client.Applications.Delete(ctx, app.GUID)
 	if err != nil {
@@ -249,14 +538,26 @@ func (p *AppPushOperation) gracefulDeletion(ctx context.Context, app *resource.A
 	return nil
 }
 
-// pushApp pushes an application
+// pushApp pushes an application and waits for its instances to be running
+func (p *AppPushOperation) pushApp(ctx context.Context, space *resource.Space, manifest *AppManifest, bits appBits, progress *pushProgress) (*resource.App, error) {
+	app, err := p.startApp(ctx, space, manifest, bits, progress)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.waitForRunning(ctx, app, manifest, progress); err != nil {
+		return nil, fmt.Errorf("error waiting for app %s to start: %w", manifest.Name, err)
+	}
+	return app, nil
+}
+
+// startApp pushes an application and starts it
 //
 // After an application is created and packages are uploaded, a droplet must be created via a build in order for
 // an application to be deployed or tasks to be run. The current droplet must be assigned to an application before
 // it may be started. When tasks are created, they either use a specific droplet guid, or use the current droplet
 // assigned to an application.
-func (p *AppPushOperation) pushApp(ctx context.Context, space *resource.Space, manifest *AppManifest, zipFile io.Reader) (*resource.App, error) {
-	err := p.applySpaceManifest(ctx, space, manifest)
+func (p *AppPushOperation) startApp(ctx context.Context, space *resource.Space, manifest *AppManifest, bits appBits, progress *pushProgress) (*resource.App, error) {
+	err := p.applySpaceManifest(ctx, space, manifest, progress)
 	if err != nil {
 		return nil, err
 	}
@@ -268,38 +569,50 @@ func (p *AppPushOperation) pushApp(ctx context.Context, space *resource.Space, m
 
 	var pkg *resource.Package
 	if app.Lifecycle.Type == resource.LifecycleDocker.String() {
-		pkg, err = p.uploadDockerPackage(ctx, app, manifest.Docker)
+		pkg, err = p.uploadDockerPackage(ctx, app, manifest.Docker, progress)
 	} else {
-		pkg, err = p.uploadBitsPackage(ctx, app, zipFile)
+		pkg, err = p.uploadBitsPackage(ctx, app, bits, progress)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	droplet, err := p.buildDroplet(ctx, pkg, manifest)
+	droplet, err := p.buildDroplet(ctx, app, pkg, manifest, progress)
 	if err != nil {
 		return nil, err
 	}
 
 	_, err = p.client.Droplets.SetCurrentAssociationForApp(ctx, app.GUID, droplet.GUID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error setting the current droplet for app %s: %w", app.Name, err)
+	}
+	progress.event(PushEventDropletSet, droplet.GUID)
+
+	app, err = p.client.Applications.Start(ctx, app.GUID)
+	if err != nil {
+		return nil, fmt.Errorf("error starting app %s: %w", manifest.Name, err)
 	}
+	return app, nil
+}
 
-	return p.client.Applications.Start(ctx, app.GUID)
+// waitForRunning waits for the started app's instances to be running
+func (p *AppPushOperation) waitForRunning(ctx context.Context, app *resource.App, manifest *AppManifest, progress *pushProgress) error {
+	err := p.client.Applications.WaitForRunning(ctx, app.GUID, deploymentPollingOptions(manifest.Instances))
+	if err != nil {
+		return err
+	}
+	progress.event(PushEventInstancesRunning, "")
+	return nil
 }
 
-func (p *AppPushOperation) applySpaceManifest(ctx context.Context, space *resource.Space, manifest *AppManifest) error {
+func (p *AppPushOperation) applySpaceManifest(ctx context.Context, space *resource.Space, manifest *AppManifest, progress *pushProgress) error {
 	// wrap it in a manifest that has an applications array as required by the API
-	multiAppsManifest := &Manifest{
-		Applications: []*AppManifest{manifest},
-	}
-	manifestBytes, err := yaml.Marshal(&multiAppsManifest)
+	manifestYAML, err := marshalSpaceManifest(manifest)
 	if err != nil {
-		return fmt.Errorf("error marshalling application manifest: %w", err)
+		return err
 	}
 
-	jobGUID, err := p.client.Manifests.ApplyManifest(ctx, space.GUID, string(manifestBytes))
+	jobGUID, err := p.client.Manifests.ApplyManifest(ctx, space.GUID, manifestYAML)
 	if err != nil {
 		return fmt.Errorf("error applying application manifest to space %s: %w", space.Name, err)
 	}
@@ -307,9 +620,26 @@ func (p *AppPushOperation) applySpaceManifest(ctx context.Context, space *resour
 	if err != nil {
 		return fmt.Errorf("error waiting for application manifest to finish applying to space %s: %w", space.Name, err)
 	}
+	progress.event(PushEventManifestApplied, "")
 	return nil
 }
 
+// marshalSpaceManifest marshals the apps into a manifest to send to the CC, the paths are local to this machine so
+// they're not sent
+func marshalSpaceManifest(apps ...*AppManifest) (string, error) {
+	m := &Manifest{}
+	for _, app := range apps {
+		a := *app
+		a.Path = ""
+		m.Applications = append(m.Applications, &a)
+	}
+	b, err := yaml.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling application manifest: %w", err)
+	}
+	return string(b), nil
+}
+
 func (p *AppPushOperation) findApp(ctx context.Context, appName string, space *resource.Space) (*resource.App, error) {
 	appOpts := client.NewAppListOptions()
 	appOpts.Names.EqualTo(appName)
@@ -321,22 +651,27 @@ func (p *AppPushOperation) findApp(ctx context.Context, appName string, space *r
 	return app, nil
 }
 
-func (p *AppPushOperation) uploadDockerPackage(ctx context.Context, app *resource.App, docker *AppManifestDocker) (*resource.Package, error) {
+func (p *AppPushOperation) uploadDockerPackage(ctx context.Context, app *resource.App, docker *AppManifestDocker, progress *pushProgress) (*resource.Package, error) {
 	newPkg := resource.NewDockerPackageCreate(app.GUID, docker.Image, docker.Username, os.Getenv("CF_DOCKER_PASSWORD"))
 	pkg, err := p.client.Packages.Create(ctx, newPkg)
 	if err != nil {
 		return nil, fmt.Errorf("error creating docker package for app %s: %w", app.Name, err)
 	}
+	progress.event(PushEventPackageUploaded, pkg.GUID)
 	return pkg, nil
 }
 
-func (p *AppPushOperation) uploadBitsPackage(ctx context.Context, app *resource.App, zipFile io.Reader) (*resource.Package, error) {
+func (p *AppPushOperation) uploadBitsPackage(ctx context.Context, app *resource.App, bits appBits, progress *pushProgress) (*resource.Package, error) {
 	newPkg := resource.NewPackageCreate(app.GUID)
 	pkg, err := p.client.Packages.Create(ctx, newPkg)
 	if err != nil {
 		return nil, fmt.Errorf("error creating package bits for app %s: %w", app.Name, err)
 	}
-	_, err = p.client.Packages.Upload(ctx, pkg.GUID, zipFile)
+	if bits.dir != "" {
+		err = p.uploadDirectory(ctx, pkg, bits.dir, progress)
+	} else {
+		_, err = p.client.Packages.Upload(ctx, pkg.GUID, newProgressReader(bits.zipFile, progress))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error uploading package bits for app %s: %w", app.Name, err)
 	}
@@ -344,10 +679,15 @@ func (p *AppPushOperation) uploadBitsPackage(ctx context.Context, app *resource.
 	if err != nil {
 		return nil, fmt.Errorf("error while waiting for package to process for app %s: %w", app.Name, err)
 	}
+	progress.event(PushEventPackageUploaded, pkg.GUID)
 	return pkg, nil
 }
 
-func (p *AppPushOperation) buildDroplet(ctx context.Context, pkg *resource.Package, manifest *AppManifest) (*resource.Droplet, error) {
+func (p *AppPushOperation) buildDroplet(ctx context.Context, app *resource.App, pkg *resource.Package, manifest *AppManifest, progress *pushProgress) (*resource.Droplet, error) {
+	// start streaming before the build is created so no staging output is missed
+	stopStagingLogs := p.streamStagingLogs(ctx, app)
+	defer stopStagingLogs()
+
 	newBuild := resource.NewBuildCreate(pkg.GUID)
 	if pkg.Type == resource.LifecycleDocker.String() {
 		newBuild.Lifecycle = &resource.Lifecycle{Type: pkg.Type}
@@ -364,6 +704,7 @@ func (p *AppPushOperation) buildDroplet(ctx context.Context, pkg *resource.Packa
 	if err != nil {
 		return nil, fmt.Errorf("error creating build from package for app %s: %w", manifest.Name, err)
 	}
+	progress.event(PushEventStagingStarted, build.GUID)
 	err = p.client.Builds.PollStaged(ctx, build.GUID, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error while waiting for app %s package to build: %w", manifest.Name, err)
@@ -375,9 +716,36 @@ func (p *AppPushOperation) buildDroplet(ctx context.Context, pkg *resource.Packa
 	if err != nil {
 		return nil, fmt.Errorf("error finding droplet for app %s: %w", manifest.Name, err)
 	}
+	progress.event(PushEventStagingFinished, droplet.GUID)
 	return droplet, nil
 }
 
+// streamStagingLogs sends the app's staging logs to the staging logs handler, if any, until the returned func is called
+func (p *AppPushOperation) streamStagingLogs(ctx context.Context, app *resource.App) func() {
+	if p.stagingLogs == nil {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	envelopes, err := p.client.Logs.Stream(ctx, app.GUID, nil)
+	if err != nil {
+		cancel()
+		return func() {}
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range envelopes {
+			if e.Tags["source_type"] == "STG" {
+				p.stagingLogs(e)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
 func (p *AppPushOperation) findOrg(ctx context.Context) (*resource.Organization, error) {
 	opts := client.NewOrganizationListOptions()
 	opts.Names.EqualTo(p.orgName)
@@ -0,0 +1,182 @@
+package operation
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+type PushEventType string
+
+const (
+	// PushEventManifestApplied is sent once the app manifest has been applied to the space
+	PushEventManifestApplied PushEventType = "manifest_applied"
+
+	// PushEventPackageUploading is sent as the app's zip file is read during the upload with the bytes read so far
+	PushEventPackageUploading PushEventType = "package_uploading"
+
+	// PushEventPackageUploaded is sent once the package has been uploaded and processed
+	PushEventPackageUploaded PushEventType = "package_uploaded"
+
+	// PushEventStagingStarted is sent once the build has been created
+	PushEventStagingStarted PushEventType = "staging_started"
+
+	// PushEventStagingFinished is sent once the build has staged a droplet
+	PushEventStagingFinished PushEventType = "staging_finished"
+
+	// PushEventDropletSet is sent once the droplet is the app's current droplet, or a deployment of it is created
+	PushEventDropletSet PushEventType = "droplet_set"
+
+	// PushEventInstancesRunning is sent once the app's instances are running, for a rolling deployment once it has
+	// finished and for a canary deployment once it has paused with the canary instances running
+	PushEventInstancesRunning PushEventType = "instances_running"
+)
+
+// PushEvent reports the progress of an app push
+type PushEvent struct {
+	Type    PushEventType
+	AppName string
+	Time    time.Time
+
+	// GUID is the GUID of the package, build, droplet or deployment the event is about, if any
+	GUID string
+
+	// BytesUploaded and TotalBytes are set for PushEventPackageUploading, TotalBytes is 0 when the size of the zip
+	// file isn't known
+	BytesUploaded int64
+	TotalBytes    int64
+}
+
+// PushTimings are how long each stage of an app push took
+type PushTimings struct {
+	ApplyManifest time.Duration
+	Upload        time.Duration
+	Staging       time.Duration
+
+	// Start is the time taken for the app's instances to be running, or for it to be deployed, once the droplet
+	// was staged
+	Start time.Duration
+	Total time.Duration
+}
+
+// PushResult is the outcome of pushing an app
+type PushResult struct {
+	App *resource.App
+
+	PackageGUID    string
+	BuildGUID      string
+	DropletGUID    string
+	DeploymentGUID string
+
+	Timings PushTimings
+}
+
+// pushProgress builds the result of an app push from its events and sends them to the progress handler, if any
+type pushProgress struct {
+	result    *PushResult
+	appName   string
+	handler   func(event PushEvent)
+	started   time.Time
+	lastStage time.Time
+}
+
+func newPushProgress(appName string, handler func(event PushEvent)) *pushProgress {
+	now := time.Now()
+	return &pushProgress{
+		result:    &PushResult{},
+		appName:   appName,
+		handler:   handler,
+		started:   now,
+		lastStage: now,
+	}
+}
+
+// event records the GUID and timing of the event in the result and sends it to the progress handler
+func (p *pushProgress) event(t PushEventType, guid string) {
+	e := PushEvent{
+		Type:    t,
+		AppName: p.appName,
+		Time:    time.Now(),
+		GUID:    guid,
+	}
+	stage := func(d *time.Duration) {
+		*d = e.Time.Sub(p.lastStage)
+		p.lastStage = e.Time
+	}
+	switch t {
+	case PushEventManifestApplied:
+		stage(&p.result.Timings.ApplyManifest)
+	case PushEventPackageUploaded:
+		p.result.PackageGUID = guid
+		stage(&p.result.Timings.Upload)
+	case PushEventStagingStarted:
+		p.result.BuildGUID = guid
+	case PushEventStagingFinished:
+		p.result.DropletGUID = guid
+		stage(&p.result.Timings.Staging)
+	case PushEventInstancesRunning:
+		stage(&p.result.Timings.Start)
+	}
+	p.send(e)
+}
+
+// deployment records the deployment created for the droplet
+func (p *pushProgress) deployment(guid string) {
+	p.result.DeploymentGUID = guid
+	p.event(PushEventDropletSet, guid)
+}
+
+func (p *pushProgress) send(e PushEvent) {
+	if p.handler != nil {
+		p.handler(e)
+	}
+}
+
+// finish sets the pushed app and the total time taken
+func (p *pushProgress) finish(app *resource.App) *PushResult {
+	p.result.App = app
+	p.result.Timings.Total = time.Since(p.started)
+	return p.result
+}
+
+// progressReader reports the bytes read from the zip file as upload progress
+type progressReader struct {
+	r        io.Reader
+	progress *pushProgress
+	total    int64
+	read     int64
+}
+
+// newProgressReader wraps the zip file to report upload progress, the total size is known for files and buffers
+func newProgressReader(r io.Reader, progress *pushProgress) io.Reader {
+	if progress.handler == nil || r == nil {
+		return r
+	}
+	var total int64
+	switch f := r.(type) {
+	case interface{ Stat() (os.FileInfo, error) }:
+		if info, err := f.Stat(); err == nil {
+			total = info.Size()
+		}
+	case interface{ Len() int }:
+		total = int64(f.Len())
+	}
+	return &progressReader{r: r, progress: progress, total: total}
+}
+
+func (pr *progressReader) Read(b []byte) (int, error) {
+	n, err := pr.r.Read(b)
+	if n > 0 {
+		pr.read += int64(n)
+		pr.progress.send(PushEvent{
+			Type:          PushEventPackageUploading,
+			AppName:       pr.progress.appName,
+			Time:          time.Now(),
+			BytesUploaded: pr.read,
+			TotalBytes:    pr.total,
+		})
+	}
+	return n, err
+}
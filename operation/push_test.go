@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cloudfoundry-community/go-cfclient/v3/client"
 	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
 	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
 )
 
@@ -18,6 +20,506 @@ func TestAppPush(t *testing.T) {
 	serverURL := testutil.SetupFakeAPIServer()
 	defer testutil.Teardown()
 
+	cf, org, space, manifest := setupAppPush(t, serverURL)
+	fakeAppZipReader := strings.NewReader("blah zip zip")
+
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	// Invalid strategy
+	strategy := StrategyMode(10)
+	pusher.WithStrategy(strategy)
+	_, err := pusher.Push(context.Background(), manifest, fakeAppZipReader)
+	require.NoError(t, err)
+}
+
+func TestAppPushWithStagingLogs(t *testing.T) {
+	serverURL := testutil.SetupFakeAPIServer()
+	defer testutil.Teardown()
+
+	cf, org, space, manifest := setupAppPush(t, serverURL, testutil.MockRoute{
+		Method:   http.MethodGet,
+		Endpoint: "/v2/read",
+		Output: []string{
+			`data: {"batch":[` +
+				`{"timestamp":"1","source_id":"app","tags":{"source_type":"APP/PROC/WEB"},"log":{"payload":"YXBw","type":"OUT"}},` +
+				`{"timestamp":"2","source_id":"app","tags":{"source_type":"STG"},"log":{"payload":"c3RhZ2luZw==","type":"OUT"}}` +
+				`]}` + "\n\n",
+		},
+		Status: http.StatusOK,
+	})
+
+	var stagingLogs []string
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	pusher.WithStagingLogs(func(envelope *resource.Envelope) {
+		stagingLogs = append(stagingLogs, string(envelope.Log.Payload))
+	})
+	_, err := pusher.Push(context.Background(), manifest, strings.NewReader("blah zip zip"))
+	require.NoError(t, err)
+
+	// the fake build is staged immediately so the stream may be stopped before it's read, however only
+	// staging logs are ever passed to the handler
+	for _, line := range stagingLogs {
+		require.Equal(t, "staging", line)
+	}
+}
+
+func TestAppPushWithProgress(t *testing.T) {
+	serverURL := testutil.SetupFakeAPIServer()
+	defer testutil.Teardown()
+
+	cf, org, space, manifest := setupAppPush(t, serverURL)
+
+	var events []PushEvent
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	pusher.WithProgress(func(event PushEvent) {
+		events = append(events, event)
+	})
+	result, err := pusher.PushWithResult(context.Background(), manifest, strings.NewReader("blah zip zip"))
+	require.NoError(t, err)
+	require.Equal(t, manifest.Name, result.App.Name)
+	require.NotEmpty(t, result.PackageGUID)
+	require.NotEmpty(t, result.BuildGUID)
+	require.NotEmpty(t, result.DropletGUID)
+	require.Empty(t, result.DeploymentGUID)
+	require.Positive(t, result.Timings.Total)
+
+	var types []PushEventType
+	for _, e := range events {
+		require.Equal(t, manifest.Name, e.AppName)
+		if e.Type == PushEventPackageUploading {
+			require.Equal(t, int64(12), e.TotalBytes)
+			require.LessOrEqual(t, e.BytesUploaded, e.TotalBytes)
+		}
+		if len(types) == 0 || types[len(types)-1] != e.Type {
+			types = append(types, e.Type)
+		}
+	}
+	require.Equal(t, []PushEventType{
+		PushEventManifestApplied,
+		PushEventPackageUploading,
+		PushEventPackageUploaded,
+		PushEventStagingStarted,
+		PushEventStagingFinished,
+		PushEventDropletSet,
+		PushEventInstancesRunning,
+	}, types)
+	require.Equal(t, result.PackageGUID, events[len(events)-5].GUID)
+	require.Equal(t, result.DropletGUID, events[len(events)-2].GUID)
+}
+
+func TestAppPushWaitsForInstances(t *testing.T) {
+	stats := func(states ...string) string {
+		var instances []string
+		for i, state := range states {
+			instances = append(instances, fmt.Sprintf(`{"type":"web","index":%d,"state":"%s"}`, i, state))
+		}
+		return `{"resources":[` + strings.Join(instances, ",") + `]}`
+	}
+	push := func(t *testing.T, stats ...string) ([]PushEventType, error) {
+		serverURL := testutil.SetupFakeAPIServer()
+		t.Cleanup(testutil.Teardown)
+		cf, org, space, manifest := setupAppPush(t, serverURL, testutil.MockRoute{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/apps/:guid/processes/web/stats",
+			Output:   stats,
+			Status:   http.StatusOK,
+		})
+
+		var types []PushEventType
+		pusher := NewAppPushOperation(cf, org.Name, space.Name)
+		pusher.WithProgress(func(event PushEvent) {
+			types = append(types, event.Type)
+		})
+		_, err := pusher.Push(context.Background(), manifest, strings.NewReader("blah zip zip"))
+		return types, err
+	}
+
+	t.Run("instances still starting", func(t *testing.T) {
+		types, err := push(t, stats("STARTING", "STARTING"), stats("RUNNING", "STARTING"), stats("RUNNING", "RUNNING"))
+		require.NoError(t, err)
+		require.Equal(t, PushEventInstancesRunning, types[len(types)-1])
+	})
+
+	t.Run("instance crashes while starting", func(t *testing.T) {
+		types, err := push(t, stats("STARTING", "STARTING"), stats("RUNNING", "CRASHED"))
+		require.ErrorIs(t, err, client.ErrAppInstanceCrashed)
+		require.NotContains(t, types, PushEventInstancesRunning)
+		require.Equal(t, PushEventDropletSet, types[len(types)-1])
+	})
+}
+
+func TestAppPushDirectory(t *testing.T) {
+	serverURL := testutil.SetupFakeAPIServer()
+	defer testutil.Teardown()
+
+	dir := t.TempDir()
+	writeAppFile(t, dir, "cached.txt", "abc", 0644)
+	writeAppFile(t, dir, "index.html", "<html></html>", 0644)
+	writeAppFile(t, dir, "debug.log", "log", 0644)
+	writeAppFile(t, dir, ".cfignore", "*.log", 0644)
+
+	g := testutil.NewObjectJSONGenerator(8723)
+	cached := `{"checksum":{"value":"a9993e364706816aba3e25717850c26c9cd0d89d"},"size_in_bytes":3,"path":"cached.txt","mode":"644"}`
+	cf, org, space, manifest := setupAppPush(t, serverURL,
+		testutil.MockRoute{
+			Method:   http.MethodPost,
+			Endpoint: "/v3/resource_matches",
+			Output:   []string{`{"resources":[` + cached + `]}`},
+			Status:   http.StatusCreated,
+			PostForm: `{"resources":[` + cached + `,` +
+				`{"checksum":{"value":"941efb7368e46b27b937d34b07fc4d41da01b002"},"size_in_bytes":13,"path":"index.html","mode":"644"}]}`,
+		},
+		testutil.MockRoute{
+			Method:   "POST-FILE",
+			Endpoint: "/v3/packages/:guid/upload",
+			Output:   g.Single(g.Package("READY").JSON),
+			Status:   http.StatusOK,
+			PostForm: `[` + cached + `]`,
+		})
+	manifest.Path = dir
+
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	_, err := pusher.PushDirectory(context.Background(), manifest, "")
+	require.NoError(t, err)
+}
+
+func TestAppPushManifest(t *testing.T) {
+	serverURL := testutil.SetupFakeAPIServer()
+	defer testutil.Teardown()
+
+	// empty files aren't resource matched so are always uploaded
+	dir := t.TempDir()
+	writeAppFile(t, dir, "index.html", "", 0644)
+	g := testutil.NewObjectJSONGenerator(1)
+	cf, org, space, appManifest := setupAppPush(t, serverURL, testutil.MockRoute{
+		Method:   "POST-FILE",
+		Endpoint: "/v3/packages/:guid/upload",
+		Output:   g.Single(g.Package("READY").JSON),
+		Status:   http.StatusOK,
+		PostForm: `name="resources"`,
+	})
+	appManifest.Path = dir
+	missing := NewAppManifest("missing")
+	missing.Path = filepath.Join(dir, "missing")
+
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	pusher.WithParallelism(2)
+	results, err := pusher.PushManifest(context.Background(), NewManifest(appManifest, missing))
+	require.ErrorContains(t, err, "error pushing app missing: error reading app path")
+	require.Len(t, results, 2)
+	require.Equal(t, appManifest.Name, results[0].Name)
+	require.NoError(t, results[0].Err)
+	require.NotNil(t, results[0].App)
+	require.Equal(t, results[0].App, results[0].Result.App)
+	require.Equal(t, "missing", results[1].Name)
+	require.Nil(t, results[1].App)
+	require.Nil(t, results[1].Result)
+	require.Error(t, results[1].Err)
+
+	_, err = pusher.PushManifest(context.Background(), NewManifest(appManifest, appManifest))
+	require.ErrorContains(t, err, "invalid manifest")
+}
+
+func TestAppPushCanary(t *testing.T) {
+	serverURL := testutil.SetupFakeAPIServer()
+	defer testutil.Teardown()
+
+	g := testutil.NewObjectJSONGenerator(17)
+	app := g.Application()
+	deployment := g.Deployment()
+	paused := strings.Replace(deployment.JSON, `"reason": "DEPLOYING"`, `"reason": "PAUSED"`, 1)
+	cf, org, space, manifest := setupAppPush(t, serverURL,
+		testutil.MockRoute{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/apps",
+			Output:   append(g.SinglePaged(app.JSON), g.SinglePaged(app.JSON)...),
+			Status:   http.StatusOK,
+		},
+		testutil.MockRoute{
+			Method:   http.MethodPost,
+			Endpoint: "/v3/deployments",
+			Output:   g.Single(deployment.JSON),
+			Status:   http.StatusCreated,
+		},
+		testutil.MockRoute{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/deployments/" + deployment.GUID,
+			Output:   []string{deployment.JSON, paused},
+			Status:   http.StatusOK,
+		})
+
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	pusher.WithStrategy(StrategyCanary)
+	_, err := pusher.Push(context.Background(), manifest, strings.NewReader("blah zip zip"))
+	require.NoError(t, err)
+}
+
+func TestAppPushRollingRollback(t *testing.T) {
+	serverURL := testutil.SetupFakeAPIServer()
+	defer testutil.Teardown()
+
+	g := testutil.NewObjectJSONGenerator(17)
+	app := g.Application()
+	crashed := strings.Replace(app.JSON, `"state": "STARTED"`, `"state": "STOPPED"`, 1)
+	deployment := g.Deployment()
+	finalized := strings.Replace(deployment.JSON, `"ACTIVE"`, `"FINALIZED"`, 1)
+	cf, org, space, manifest := setupAppPush(t, serverURL,
+		testutil.MockRoute{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/apps",
+			Output:   append(g.SinglePaged(app.JSON), g.SinglePaged(crashed)...),
+			Status:   http.StatusOK,
+		},
+		testutil.MockRoute{
+			Method:   http.MethodPost,
+			Endpoint: "/v3/deployments",
+			Output:   g.Single(deployment.JSON),
+			Status:   http.StatusCreated,
+		},
+		testutil.MockRoute{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/deployments/" + deployment.GUID,
+			Output: []string{
+				strings.Replace(finalized, `"DEPLOYING"`, `"DEPLOYED"`, 1),
+				strings.Replace(finalized, `"DEPLOYING"`, `"CANCELED"`, 1),
+			},
+			Status: http.StatusOK,
+		},
+		testutil.MockRoute{
+			Method:   http.MethodPost,
+			Endpoint: "/v3/deployments/" + deployment.GUID + "/actions/cancel",
+			Status:   http.StatusOK,
+		})
+
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	pusher.WithStrategy(StrategyRolling)
+	_, err := pusher.Push(context.Background(), manifest, strings.NewReader("blah zip zip"))
+	require.ErrorContains(t, err, "is STOPPED")
+	require.ErrorContains(t, err, "rolled back to last deployment")
+}
+
+func TestAppPushBlueGreen(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(20)
+	original := g.Application()
+	newApp := g.Application()
+	job := g.Job("COMPLETE")
+	process := strings.Replace(g.Process().JSON, `"instances": 5`, `"instances": 2`, 1)
+	stats := func(states ...string) string {
+		var instances []string
+		for i, state := range states {
+			instances = append(instances, fmt.Sprintf(`{"type":"web","index":%d,"state":"%s","details":"instance %d %s"}`, i, state, i, state))
+		}
+		return `{"resources":[` + strings.Join(instances, ",") + `]}`
+	}
+
+	push := func(t *testing.T, serverURL string, deletedApp *testutil.JSONResource, renamed []string, stats []string, extraRoutes ...testutil.MockRoute) error {
+		cf, org, space, manifest := setupAppPush(t, serverURL, append(extraRoutes,
+			testutil.MockRoute{
+				Method:   http.MethodGet,
+				Endpoint: "/v3/apps",
+				Output:   append(append(g.SinglePaged(original.JSON), g.Paged([]string{})...), g.SinglePaged(newApp.JSON)...),
+				Status:   http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:   http.MethodPatch,
+				Endpoint: "/v3/apps/" + original.GUID,
+				Output:   renamed,
+				Status:   http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:   http.MethodPatch,
+				Endpoint: fmt.Sprintf("/v3/apps/%s/relationships/current_droplet", newApp.GUID),
+				Output:   g.Single(g.DropletAssociation().JSON),
+				Status:   http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:   http.MethodPost,
+				Endpoint: fmt.Sprintf("/v3/apps/%s/actions/start", newApp.GUID),
+				Output:   g.Single(newApp.JSON),
+				Status:   http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:   http.MethodGet,
+				Endpoint: fmt.Sprintf("/v3/apps/%s/processes", newApp.GUID),
+				Output:   g.SinglePaged(process),
+				Status:   http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:   http.MethodGet,
+				Endpoint: fmt.Sprintf("/v3/apps/%s/processes/web/stats", newApp.GUID),
+				Output:   stats,
+				Status:   http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:   http.MethodPost,
+				Endpoint: fmt.Sprintf("/v3/apps/%s/actions/stop", deletedApp.GUID),
+				Output:   g.Single(deletedApp.JSON),
+				Status:   http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:           http.MethodDelete,
+				Endpoint:         "/v3/apps/" + deletedApp.GUID,
+				Status:           http.StatusAccepted,
+				RedirectLocation: fmt.Sprintf("%s/v3/jobs/%s", serverURL, job.GUID),
+			},
+			testutil.MockRoute{
+				Method:   http.MethodGet,
+				Endpoint: "/v3/jobs/" + job.GUID,
+				Output:   g.Single(job.JSON),
+				Status:   http.StatusOK,
+			})...)
+
+		pusher := NewAppPushOperation(cf, org.Name, space.Name)
+		pusher.WithStrategy(StrategyBlueGreen)
+		_, err := pusher.Push(context.Background(), manifest, strings.NewReader("blah zip zip"))
+		return err
+	}
+
+	t.Run("deletes original once running", func(t *testing.T) {
+		serverURL := testutil.SetupFakeAPIServer()
+		defer testutil.Teardown()
+		err := push(t, serverURL, original, g.Single(original.JSON), []string{stats("STARTING", "STARTING"), stats("RUNNING", "RUNNING")})
+		require.NoError(t, err)
+	})
+
+	t.Run("rolls back crashed app", func(t *testing.T) {
+		serverURL := testutil.SetupFakeAPIServer()
+		defer testutil.Teardown()
+		err := push(t, serverURL, newApp, []string{original.JSON, original.JSON}, []string{stats("RUNNING", "CRASHED")})
+		require.ErrorIs(t, err, client.ErrAppInstanceCrashed)
+		require.ErrorContains(t, err, "instance 1 CRASHED")
+	})
+
+	// the push fails after the manifest created the new app, the app is found by name and deleted
+	pushFailsAfterManifest := func(t *testing.T, extraRoutes ...testutil.MockRoute) error {
+		serverURL := testutil.SetupFakeAPIServer()
+		defer testutil.Teardown()
+		return push(t, serverURL, newApp, []string{original.JSON, original.JSON}, nil, append(extraRoutes,
+			testutil.MockRoute{
+				Method:   http.MethodGet,
+				Endpoint: "/v3/apps",
+				Output: append(append(append(g.SinglePaged(original.JSON), g.Paged([]string{})...),
+					g.SinglePaged(newApp.JSON)...), g.SinglePaged(newApp.JSON)...),
+				Status: http.StatusOK,
+			},
+			testutil.MockRoute{
+				Method:   http.MethodPatch,
+				Endpoint: fmt.Sprintf("/v3/apps/%s/relationships/current_droplet", newApp.GUID),
+				Output:   []string{`{"errors":[{"detail":"Droplet not staged","title":"CF-UnprocessableEntity","code":10008}]}`},
+				Status:   http.StatusUnprocessableEntity,
+			})...)
+	}
+
+	t.Run("deletes new app created by the manifest when the push fails", func(t *testing.T) {
+		err := pushFailsAfterManifest(t)
+		require.ErrorContains(t, err, "blue green deployment failed with: error setting the current droplet")
+		require.NotContains(t, err.Error(), "failed to delete new app")
+		require.NotContains(t, err.Error(), "failed to update app name back")
+	})
+
+	t.Run("reports failing to delete new app created by the manifest", func(t *testing.T) {
+		err := pushFailsAfterManifest(t, testutil.MockRoute{
+			Method:   http.MethodPost,
+			Endpoint: fmt.Sprintf("/v3/apps/%s/actions/stop", newApp.GUID),
+			Output:   []string{`{"errors":[{"detail":"Server error","title":"CF-ServerError","code":10001}]}`},
+			Status:   http.StatusInternalServerError,
+		})
+		require.ErrorContains(t, err, "error setting the current droplet")
+		require.ErrorContains(t, err, "failed to delete new app")
+	})
+}
+
+func TestContinueCanary(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(17)
+	org := g.Organization()
+	space := g.Space()
+	app := g.Application()
+	process := g.Process()
+	d := g.Deployment()
+	paused := strings.Replace(d.JSON, `"reason": "DEPLOYING"`, `"reason": "PAUSED"`, 1)
+	paused = strings.Replace(paused, "fd5d3e60-f88c-4c37-b1ae-667cfc65a856", process.GUID, 1)
+	deployed := strings.Replace(strings.Replace(paused, `"ACTIVE"`, `"FINALIZED"`, 1), `"PAUSED"`, `"DEPLOYED"`, 1)
+
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/organizations",
+			Output:   g.SinglePaged(org.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/spaces",
+			Output:   g.SinglePaged(space.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/apps",
+			Output:   g.SinglePaged(app.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:      http.MethodGet,
+			Endpoint:    "/v3/deployments",
+			Output:      g.SinglePaged(paused),
+			Status:      http.StatusOK,
+			QueryString: "app_guids=" + app.GUID + "&page=1&per_page=50&status_values=ACTIVE",
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/processes/" + process.GUID,
+			Output:   g.Single(process.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodPost,
+			Endpoint: "/v3/deployments/" + d.GUID + "/actions/continue",
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: "/v3/deployments/" + d.GUID,
+			Output:   []string{deployed},
+			Status:   http.StatusOK,
+		},
+	}, t)
+	defer testutil.Teardown()
+
+	c, _ := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	cf, err := client.New(c)
+	require.NoError(t, err)
+	pusher := NewAppPushOperation(cf, org.Name, space.Name)
+	deployment, err := pusher.ContinueCanary(context.Background(), app.Name)
+	require.NoError(t, err)
+	require.Equal(t, resource.DeploymentStatusReasonDeployed.String(), deployment.Status.Reason)
+}
+
+func TestNewDeployment(t *testing.T) {
+	app := &resource.App{Resource: resource.Resource{GUID: "305cea31-5a44-45ca-b51b-e89c7a8ef8b2"}}
+	droplet := &resource.Droplet{Resource: resource.Resource{GUID: "c2941033-4575-486d-bf2c-3ae49e8b4ca1"}}
+
+	pusher := NewAppPushOperation(nil, "org", "space")
+	d := pusher.newDeployment(app, droplet, resource.DeploymentStrategyRolling)
+	require.Equal(t, "rolling", d.Strategy)
+	require.Nil(t, d.Options)
+
+	pusher.WithMaxInFlight(3)
+	pusher.WithCanarySteps(10, 50)
+	d = pusher.newDeployment(app, droplet, resource.DeploymentStrategyRolling)
+	require.Equal(t, &resource.DeploymentOptions{MaxInFlight: 3}, d.Options)
+	d = pusher.newDeployment(app, droplet, resource.DeploymentStrategyCanary)
+	require.Equal(t, "canary", d.Strategy)
+	require.Equal(t, "c2941033-4575-486d-bf2c-3ae49e8b4ca1", d.Droplet.GUID)
+	require.Equal(t, &resource.DeploymentOptions{
+		MaxInFlight: 3,
+		Canary: &resource.DeploymentCanaryOptions{
+			Steps: []resource.DeploymentCanaryStep{{InstanceWeight: 10}, {InstanceWeight: 50}},
+		},
+	}, d.Options)
+}
+
+// setupAppPush adds the routes needed to push an app along with any extra routes
+func setupAppPush(t *testing.T, serverURL string, extraRoutes ...testutil.MockRoute) (*client.Client, *testutil.JSONResource, *testutil.JSONResource, *AppManifest) {
 	g := testutil.NewObjectJSONGenerator(8723)
 	org := g.Organization()
 	space := g.Space()
@@ -28,7 +530,6 @@ func TestAppPush(t *testing.T) {
 	droplet := g.Droplet()
 	dropletAssoc := g.DropletAssociation()
 
-	fakeAppZipReader := strings.NewReader("blah zip zip")
 	manifest := &AppManifest{
 		Name:       app.Name,
 		Buildpacks: []string{"java-buildpack-offline"},
@@ -48,7 +549,7 @@ func TestAppPush(t *testing.T) {
 		Stack:    "cflinuxfs3",
 	}
 
-	testutil.SetupMultiple([]testutil.MockRoute{
+	routes := []testutil.MockRoute{
 		{
 			Method:   http.MethodGet,
 			Endpoint: "/v3/organizations",
@@ -128,16 +629,24 @@ func TestAppPush(t *testing.T) {
 			Output:   g.Single(app.JSON),
 			Status:   http.StatusOK,
 		},
-	}, t)
+		{
+			Method:   http.MethodGet,
+			Endpoint: fmt.Sprintf("/v3/apps/%s/processes", app.GUID),
+			Output:   g.SinglePaged(strings.Replace(g.Process().JSON, `"instances": 5`, `"instances": 2`, 1)),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:   http.MethodGet,
+			Endpoint: fmt.Sprintf("/v3/apps/%s/processes/web/stats", app.GUID),
+			Output:   []string{`{"resources":[{"type":"web","index":0,"state":"RUNNING"},{"type":"web","index":1,"state":"RUNNING"}]}`},
+			Status:   http.StatusOK,
+		},
+	}
+	// extra routes are added first so they take precedence
+	testutil.SetupMultiple(append(extraRoutes, routes...), t)
 
 	c, _ := config.New(serverURL, config.Token("", "fake-refresh-token"), config.SkipTLSValidation())
 	cf, err := client.New(c)
 	require.NoError(t, err)
-
-	pusher := NewAppPushOperation(cf, org.Name, space.Name)
-	// Invalid strategy
-	strategy := StrategyMode(10)
-	pusher.WithStrategy(strategy)
-	_, err = pusher.Push(context.Background(), manifest, fakeAppZipReader)
-	require.NoError(t, err)
+	return cf, org, space, manifest
 }
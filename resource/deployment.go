@@ -1,8 +1,52 @@
 package resource
 
+type DeploymentStrategyType string
+
+func (d DeploymentStrategyType) String() string {
+	return string(d)
+}
+
+const (
+	// DeploymentStrategyRolling replaces the app's instances a batch at a time, the default
+	DeploymentStrategyRolling DeploymentStrategyType = "rolling"
+
+	// DeploymentStrategyCanary pauses after each canary step until the deployment is continued
+	DeploymentStrategyCanary DeploymentStrategyType = "canary"
+)
+
+type DeploymentStatusValue string
+
+func (d DeploymentStatusValue) String() string {
+	return string(d)
+}
+
+const (
+	DeploymentStatusValueActive    DeploymentStatusValue = "ACTIVE"
+	DeploymentStatusValueFinalized DeploymentStatusValue = "FINALIZED"
+)
+
+type DeploymentStatusReason string
+
+func (d DeploymentStatusReason) String() string {
+	return string(d)
+}
+
+const (
+	// Reasons for an active deployment
+	DeploymentStatusReasonDeploying DeploymentStatusReason = "DEPLOYING"
+	DeploymentStatusReasonPaused    DeploymentStatusReason = "PAUSED"
+	DeploymentStatusReasonCanceling DeploymentStatusReason = "CANCELING"
+
+	// Reasons for a finalized deployment
+	DeploymentStatusReasonDeployed   DeploymentStatusReason = "DEPLOYED"
+	DeploymentStatusReasonCanceled   DeploymentStatusReason = "CANCELED"
+	DeploymentStatusReasonSuperseded DeploymentStatusReason = "SUPERSEDED"
+)
+
 type Deployment struct {
 	Status          DeploymentStatus   `json:"status"`
 	Strategy        string             `json:"strategy"`
+	Options         *DeploymentOptions `json:"options,omitempty"`
 	Droplet         Relationship       `json:"droplet"`
 	PreviousDroplet Relationship       `json:"previous_droplet"`
 	NewProcesses    []ProcessReference `json:"new_processes"`
@@ -17,9 +61,25 @@ type DeploymentCreate struct {
 	Droplet       *Relationship       `json:"droplet,omitempty"`
 	Revision      *DeploymentRevision `json:"revision,omitempty"`
 	Strategy      string              `json:"strategy,omitempty"`
+	Options       *DeploymentOptions  `json:"options,omitempty"`
 	Metadata      *Metadata           `json:"metadata,omitempty"`
 }
 
+type DeploymentOptions struct {
+	// MaxInFlight is the number of instances started at the same time, defaults to 1
+	MaxInFlight int                      `json:"max_in_flight,omitempty"`
+	Canary      *DeploymentCanaryOptions `json:"canary,omitempty"`
+}
+
+type DeploymentCanaryOptions struct {
+	Steps []DeploymentCanaryStep `json:"steps,omitempty"`
+}
+
+type DeploymentCanaryStep struct {
+	// InstanceWeight is the percentage of instances running the new droplet at this step
+	InstanceWeight int `json:"instance_weight"`
+}
+
 type DeploymentUpdate struct {
 	Metadata *Metadata `json:"metadata"`
 }
@@ -40,9 +100,19 @@ type ProcessReference struct {
 }
 
 type DeploymentStatus struct {
-	Value   string            `json:"value"`
-	Reason  string            `json:"reason"`
-	Details map[string]string `json:"details"`
+	Value   string                  `json:"value"`
+	Reason  string                  `json:"reason"`
+	Details map[string]string       `json:"details"`
+	Canary  *DeploymentCanaryStatus `json:"canary,omitempty"`
+}
+
+type DeploymentCanaryStatus struct {
+	Steps DeploymentCanaryStepStatus `json:"steps"`
+}
+
+type DeploymentCanaryStepStatus struct {
+	Current int `json:"current"`
+	Total   int `json:"total"`
 }
 
 func NewDeploymentCreate(appGUID string) *DeploymentCreate {
@@ -56,3 +126,13 @@ func NewDeploymentCreate(appGUID string) *DeploymentCreate {
 		},
 	}
 }
+
+// NewDeploymentCreateWithStrategy creates a deployment of the droplet to the app using the strategy
+func NewDeploymentCreateWithStrategy(appGUID, dropletGUID string, strategy DeploymentStrategyType) *DeploymentCreate {
+	d := NewDeploymentCreate(appGUID)
+	d.Droplet = &Relationship{
+		GUID: dropletGUID,
+	}
+	d.Strategy = strategy.String()
+	return d
+}
@@ -3,10 +3,32 @@
 package resource
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"strings"
+	"syscall"
 )
 
+// Error categories matched with errors.Is by the CloudFoundryAPIError returned for an unsuccessful API response,
+// for example errors.Is(err, resource.ErrNotFound)
+var (
+	ErrNotFound      = errors.New("cfclient: not found")
+	ErrUnauthorized  = errors.New("cfclient: unauthorized")
+	ErrForbidden     = errors.New("cfclient: forbidden")
+	ErrConflict      = errors.New("cfclient: conflict")
+	ErrUnprocessable = errors.New("cfclient: unprocessable entity")
+	ErrRateLimited   = errors.New("cfclient: rate limited")
+	ErrServerError   = errors.New("cfclient: server error")
+)
+
+// RequestIDHeader is the response header holding the Cloud Controller request ID used to correlate a request
+// with the CC logs
+const RequestIDHeader = "X-Vcap-Request-Id"
+
 type CloudFoundryHTTPError struct {
 	StatusCode int
 	Status     string
@@ -38,3 +60,160 @@ type CloudFoundryError struct {
 func (e CloudFoundryError) Error() string {
 	return fmt.Sprintf("cfclient error (%s|%d): %s", e.Title, e.Code, e.Detail)
 }
+
+// HTTPStatus returns the HTTP status code the Cloud Controller responds with for the error, or 0 if the error code
+// isn't one of the known errors
+func (e CloudFoundryError) HTTPStatus() int {
+	return knownErrors[e.Code].httpStatus
+}
+
+// knownError is the definition of a Cloud Foundry error that's generated into error_cf.go
+type knownError struct {
+	title      string
+	httpStatus int
+	detail     string
+}
+
+// LookupError returns a new CloudFoundryError for the Cloud Foundry error code, the same error the NewXxxError
+// function returns, or false if the code isn't one of the known errors
+func LookupError(code int) (CloudFoundryError, bool) {
+	known, ok := knownErrors[code]
+	if !ok {
+		return CloudFoundryError{}, false
+	}
+	return CloudFoundryError{
+		Code:   code,
+		Title:  known.title,
+		Detail: known.detail,
+	}, true
+}
+
+// CloudFoundryAPIError is returned for an unsuccessful API response, it keeps the request and response details
+// that are useful when reporting the error.
+//
+// It unwraps to each of the CloudFoundryError in the response body and a CloudFoundryHTTPError, so the generated
+// IsXxxError functions and errors.As work as they do for those errors. It matches the ErrNotFound etc. categories
+// with errors.Is based on the HTTP status code.
+//
+// Before it was introduced a CloudFoundryError or CloudFoundryHTTPError was returned directly, code that type
+// asserts err.(CloudFoundryError) must use errors.As instead.
+type CloudFoundryAPIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	URL        string
+	RequestID  string
+	Errors     []CloudFoundryError
+	Body       []byte
+}
+
+func (e CloudFoundryAPIError) Error() string {
+	var sb strings.Builder
+	if len(e.Errors) > 0 {
+		for i, err := range e.Errors {
+			if i > 0 {
+				sb.WriteString("; ")
+			}
+			sb.WriteString(err.Error())
+		}
+	} else {
+		sb.WriteString(e.httpError().Error())
+	}
+
+	var details []string
+	if e.Method != "" || e.URL != "" {
+		details = append(details, strings.TrimSpace(e.Method+" "+e.URL))
+	}
+	if e.StatusCode != 0 && len(e.Errors) > 0 {
+		details = append(details, fmt.Sprintf("status %d", e.StatusCode))
+	}
+	if e.RequestID != "" {
+		details = append(details, "request ID "+e.RequestID)
+	}
+	if len(details) > 0 {
+		sb.WriteString(" [" + strings.Join(details, ", ") + "]")
+	}
+	return sb.String()
+}
+
+// Unwrap returns the Cloud Foundry errors from the response body and the HTTP error
+func (e CloudFoundryAPIError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors)+1)
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return append(errs, e.httpError())
+}
+
+// As sets target to the first Cloud Foundry error from the response body if it's a *CloudFoundryError, or to the
+// HTTP error if it's a *CloudFoundryHTTPError, so errors.As matches the errors that used to be returned
+func (e CloudFoundryAPIError) As(target any) bool {
+	switch t := target.(type) {
+	case *CloudFoundryError:
+		if len(e.Errors) == 0 {
+			return false
+		}
+		*t = e.Errors[0]
+		return true
+	case *CloudFoundryHTTPError:
+		*t = e.httpError()
+		return true
+	}
+	return false
+}
+
+// Is returns true if the target is the error category for the HTTP status code
+func (e CloudFoundryAPIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrUnprocessable:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+func (e CloudFoundryAPIError) httpError() CloudFoundryHTTPError {
+	return CloudFoundryHTTPError{
+		StatusCode: e.StatusCode,
+		Status:     e.Status,
+		Body:       e.Body,
+	}
+}
+
+// IsRetryable returns true if the error is transient so the request may succeed if it's sent again, that is a
+// rate limited or 502, 503 or 504 response or a network timeout, reset or refused connection
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var httpErr CloudFoundryHTTPError
+	if errors.As(err, &httpErr) {
+		return IsRetryableStatus(httpErr.StatusCode)
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// IsRetryableStatus returns true if a response with the HTTP status code is transient
+func IsRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -1,8 +1,7 @@
-package resource
-
 // Code generated by go generate. DO NOT EDIT.
-// This file was generated by robots at
-// 2022-11-22 14:45:22.089321 -0800 PST m=+0.202457591
+// This file was generated by robots from tools/errors_v2.yml, cloud_controller_ng commit unknown
+
+package resource
 
 import (
 	"errors"
@@ -6195,6 +6194,279 @@ func IsEiriniLRPError(err error) bool {
 	return cferr.Code == 410001
 }
 
+// knownErrors are the known Cloud Foundry errors by code
+var knownErrors = map[int]knownError{
+	1000:   {title: "CF-InvalidAuthToken", httpStatus: 401, detail: "Invalid Auth Token"},
+	1001:   {title: "CF-MessageParseError", httpStatus: 400, detail: "Request invalid due to parse error: %s"},
+	1002:   {title: "CF-InvalidRelation", httpStatus: 400, detail: "%s"},
+	1003:   {title: "CF-InvalidContentType", httpStatus: 400, detail: "Invalid content type, expected: %s"},
+	1004:   {title: "CF-BadRequest", httpStatus: 400, detail: "Bad request: %s"},
+	10000:  {title: "CF-NotFound", httpStatus: 404, detail: "Unknown request"},
+	10001:  {title: "CF-ServerError", httpStatus: 500, detail: "Server error"},
+	10002:  {title: "CF-NotAuthenticated", httpStatus: 401, detail: "Authentication error"},
+	10003:  {title: "CF-NotAuthorized", httpStatus: 403, detail: "You are not authorized to perform the requested action"},
+	10004:  {title: "CF-InvalidRequest", httpStatus: 400, detail: "The request is invalid"},
+	10005:  {title: "CF-BadQueryParameter", httpStatus: 400, detail: "The query parameter is invalid: %s"},
+	10006:  {title: "CF-AssociationNotEmpty", httpStatus: 400, detail: "Please delete the %s associations for your %s."},
+	10007:  {title: "CF-InsufficientScope", httpStatus: 403, detail: "Your token lacks the necessary scopes to access this resource."},
+	10008:  {title: "CF-UnprocessableEntity", httpStatus: 422, detail: "%s"},
+	10009:  {title: "CF-UnableToPerform", httpStatus: 400, detail: "%s could not be completed: %s"},
+	10010:  {title: "CF-ResourceNotFound", httpStatus: 404, detail: "%s"},
+	10011:  {title: "CF-DatabaseError", httpStatus: 500, detail: "Database error"},
+	10012:  {title: "CF-OrderByParameterInvalid", httpStatus: 500, detail: "Cannot order by: %s"},
+	10013:  {title: "CF-RateLimitExceeded", httpStatus: 429, detail: "Rate Limit Exceeded"},
+	10014:  {title: "CF-IPBasedRateLimitExceeded", httpStatus: 429, detail: "Rate Limit Exceeded: Unauthenticated requests from this IP address have exceeded the limit. Please log in."},
+	10015:  {title: "CF-ServiceUnavailable", httpStatus: 503, detail: "%s"},
+	10016:  {title: "CF-ServiceBrokerRateLimitExceeded", httpStatus: 429, detail: "Service broker concurrent request limit exceeded"},
+	10017:  {title: "CF-OrgSuspended", httpStatus: 403, detail: "The organization is suspended"},
+	10018:  {title: "CF-RateLimitV2APIExceeded", httpStatus: 429, detail: "Rate Limit of V2 API Exceeded. Please consider using the V3 API"},
+	20001:  {title: "CF-UserInvalid", httpStatus: 400, detail: "The user info is invalid: %s"},
+	20002:  {title: "CF-UaaIdTaken", httpStatus: 400, detail: "The UAA ID is taken: %s"},
+	20003:  {title: "CF-UserNotFound", httpStatus: 404, detail: "The user could not be found: %s"},
+	20004:  {title: "CF-UaaUnavailable", httpStatus: 503, detail: "The UAA service is currently unavailable"},
+	20005:  {title: "CF-UaaEndpointDisabled", httpStatus: 501, detail: "The UAA endpoint needed is disabled"},
+	20006:  {title: "CF-UserIsInMultipleOrigins", httpStatus: 400, detail: "The user exists in multiple origins. Specify an origin for the requested user from: %s"},
+	20007:  {title: "CF-UserWithOriginNotFound", httpStatus: 404, detail: "The user could not be found, %s"},
+	21008:  {title: "CF-OutOfRouterGroupPorts", httpStatus: 403, detail: "There are no more ports available for router group: %s. Please contact your administrator for more information."},
+	30001:  {title: "CF-OrganizationInvalid", httpStatus: 400, detail: "The organization info is invalid: %s"},
+	30002:  {title: "CF-OrganizationNameTaken", httpStatus: 400, detail: "The organization name is taken: %s"},
+	30003:  {title: "CF-OrganizationNotFound", httpStatus: 404, detail: "The organization could not be found: %s"},
+	30004:  {title: "CF-LastManagerInOrg", httpStatus: 403, detail: "Cannot remove last Org Manager in org"},
+	30005:  {title: "CF-LastBillingManagerInOrg", httpStatus: 403, detail: "Cannot remove last Billing Manager in org"},
+	30006:  {title: "CF-LastUserInOrg", httpStatus: 403, detail: "Cannot remove last User in org"},
+	30007:  {title: "CF-OrganizationAlreadySet", httpStatus: 400, detail: "Cannot change organization"},
+	40001:  {title: "CF-SpaceInvalid", httpStatus: 400, detail: "The app space info is invalid: %s"},
+	40002:  {title: "CF-SpaceNameTaken", httpStatus: 400, detail: "The app space name is taken: %s"},
+	40003:  {title: "CF-SpaceUserNotInOrg", httpStatus: 400, detail: "The app space and the user are not in the same org: %s"},
+	40004:  {title: "CF-SpaceNotFound", httpStatus: 404, detail: "The app space could not be found: %s"},
+	60001:  {title: "CF-ServiceInstanceNameEmpty", httpStatus: 400, detail: "Service instance name is required."},
+	60002:  {title: "CF-ServiceInstanceNameTaken", httpStatus: 400, detail: "The service instance name is taken: %s"},
+	60003:  {title: "CF-ServiceInstanceInvalid", httpStatus: 400, detail: "The service instance is invalid: %s"},
+	60004:  {title: "CF-ServiceInstanceNotFound", httpStatus: 404, detail: "The service instance could not be found: %s"},
+	60005:  {title: "CF-ServiceInstanceQuotaExceeded", httpStatus: 400, detail: "You have exceeded your organization's services limit."},
+	60006:  {title: "CF-PreviouslyUsedAs_ServiceInstancePaidQuotaExceeded", httpStatus: 400, detail: "You have exceeded your organization's services limit."},
+	60007:  {title: "CF-ServiceInstanceServicePlanNotAllowed", httpStatus: 400, detail: "The service instance cannot be created because paid service plans are not allowed."},
+	60008:  {title: "CF-ServiceInstanceDuplicateNotAllowed", httpStatus: 400, detail: "An instance of this service is already present in this space. Some services only support one instance per space."},
+	60009:  {title: "CF-ServiceInstanceNameTooLong", httpStatus: 400, detail: "You have requested an invalid service instance name. Names are limited to 255 characters."},
+	60010:  {title: "CF-ServiceInstanceOrganizationNotAuthorized", httpStatus: 403, detail: "A service instance for the selected plan cannot be created in this organization. The plan is visible because another organization you belong to has access to it."},
+	60011:  {title: "CF-ServiceInstanceDeprovisionFailed", httpStatus: 409, detail: "The service broker reported an error during deprovisioning: %s"},
+	60012:  {title: "CF-ServiceInstanceSpaceQuotaExceeded", httpStatus: 400, detail: "You have exceeded your space's services limit."},
+	60013:  {title: "CF-ServiceInstanceServicePlanNotAllowedBySpaceQuota", httpStatus: 400, detail: "The service instance cannot be created because paid service plans are not allowed for your space."},
+	60014:  {title: "CF-ServiceInstanceSpaceChangeNotAllowed", httpStatus: 400, detail: "Cannot update space for service instance."},
+	60015:  {title: "CF-ServiceInstanceTagsTooLong", httpStatus: 400, detail: "Combined length of tags for service %s must be 2048 characters or less."},
+	60016:  {title: "CF-AsyncServiceInstanceOperationInProgress", httpStatus: 409, detail: "An operation for service instance %s is in progress."},
+	60017:  {title: "CF-ServiceInstanceRouteBindingSpaceMismatch", httpStatus: 400, detail: "The service instance and the route are in different spaces."},
+	60018:  {title: "CF-ServiceInstanceSpaceNotAuthorized", httpStatus: 403, detail: "A service instance for the selected plan cannot be created in this space."},
+	60019:  {title: "CF-ServiceInstanceRouteServiceURLInvalid", httpStatus: 400, detail: "The route service URL is invalid: %s"},
+	60020:  {title: "CF-ServiceInstanceRouteServiceRequiresDiego", httpStatus: 400, detail: "Route services are only supported for apps on Diego. Unbind the service instance from the route or enable Diego for the app."},
+	60021:  {title: "CF-ServiceInstanceRouteServiceDisabled", httpStatus: 403, detail: "Support for route services is disabled"},
+	60022:  {title: "CF-AppPortMappingRequiresDiego", httpStatus: 400, detail: "App ports are supported for Diego apps only."},
+	60023:  {title: "CF-RoutePortNotEnabledOnApp", httpStatus: 400, detail: "Routes can only be mapped to ports already enabled for the application."},
+	60024:  {title: "CF-MultipleAppPortsMappedDiegoToDea", httpStatus: 400, detail: "The app has routes mapped to multiple ports. Multiple ports are supported for Diego only. Please unmap routes from all but one app port. Multiple routes can be mapped to the same port if desired."},
+	60025:  {title: "CF-VolumeMountServiceDisabled", httpStatus: 403, detail: "Support for volume mount services is disabled"},
+	60026:  {title: "CF-DockerAppToDea", httpStatus: 400, detail: "Docker apps cannot run on DEAs"},
+	60027:  {title: "CF-ServiceInstanceRecursiveDeleteFailed", httpStatus: 502, detail: "Deletion of service instance %s failed because one or more associated resources could not be deleted.%s"},
+	60028:  {title: "CF-ManagedServiceInstanceNotFound", httpStatus: 404, detail: "The service instance could not be found: %s"},
+	60029:  {title: "CF-ServiceInstanceWithInaccessiblePlanNotUpdateable", httpStatus: 403, detail: "Cannot update %s of a service instance that belongs to inaccessible plan"},
+	60030:  {title: "CF-ServiceInstanceProvisionFailed", httpStatus: 400, detail: "The service broker reported an error during provisioning: %s"},
+	70001:  {title: "CF-RuntimeInvalid", httpStatus: 400, detail: "The runtime is invalid: %s"},
+	70002:  {title: "CF-RuntimeNameTaken", httpStatus: 400, detail: "The runtime name is taken: %s"},
+	70003:  {title: "CF-RuntimeNotFound", httpStatus: 404, detail: "The runtime could not be found: %s"},
+	80001:  {title: "CF-FrameworkInvalid", httpStatus: 400, detail: "The framework is invalid: %s"},
+	80002:  {title: "CF-FrameworkNameTaken", httpStatus: 400, detail: "The framework name is taken: %s"},
+	80003:  {title: "CF-FrameworkNotFound", httpStatus: 404, detail: "The framework could not be found: %s"},
+	90001:  {title: "CF-ServiceBindingInvalid", httpStatus: 400, detail: "The service binding is invalid: %s"},
+	90002:  {title: "CF-ServiceBindingDifferentSpaces", httpStatus: 400, detail: "The app and the service are not in the same app space: %s"},
+	90003:  {title: "CF-ServiceBindingAppServiceTaken", httpStatus: 400, detail: "%s"},
+	90004:  {title: "CF-ServiceBindingNotFound", httpStatus: 404, detail: "The service binding could not be found: %s"},
+	90005:  {title: "CF-UnbindableService", httpStatus: 400, detail: "The service instance doesn't support binding."},
+	90006:  {title: "CF-InvalidLoggingServiceBinding", httpStatus: 502, detail: "The service is attempting to stream logs from your application, but is not registered as a logging service. Please contact the service provider."},
+	90007:  {title: "CF-ServiceFetchBindingParametersNotSupported", httpStatus: 400, detail: "This service does not support fetching service binding parameters."},
+	90008:  {title: "CF-AsyncServiceBindingOperationInProgress", httpStatus: 409, detail: "An operation for the service binding between app %s and service instance %s is in progress."},
+	100001: {title: "CF-AppInvalid", httpStatus: 400, detail: "The app is invalid: %s"},
+	100002: {title: "CF-AppNameTaken", httpStatus: 400, detail: "The app name is taken: %s"},
+	100004: {title: "CF-AppNotFound", httpStatus: 404, detail: "The app could not be found: %s"},
+	100005: {title: "CF-AppMemoryQuotaExceeded", httpStatus: 400, detail: "You have exceeded your organization's memory limit: %s"},
+	100006: {title: "CF-AppMemoryInvalid", httpStatus: 400, detail: "You have specified an invalid amount of memory for your application."},
+	100007: {title: "CF-QuotaInstanceMemoryLimitExceeded", httpStatus: 400, detail: "You have exceeded the instance memory limit for your organization's quota."},
+	100008: {title: "CF-QuotaInstanceLimitExceeded", httpStatus: 400, detail: "You have exceeded the instance limit for your organization's quota."},
+	100009: {title: "CF-AppMemoryInsufficientForSidecars", httpStatus: 400, detail: "The requested memory allocation is not large enough to run all of your sidecar processes."},
+	100010: {title: "CF-OrgQuotaLogRateLimitExceeded", httpStatus: 400, detail: "You have exceeded your organization's log rate limit: %s"},
+	110001: {title: "CF-ServicePlanInvalid", httpStatus: 400, detail: "The service plan is invalid: %s"},
+	110002: {title: "CF-ServicePlanNameTaken", httpStatus: 400, detail: "The service plan name is taken: %s"},
+	110003: {title: "CF-ServicePlanNotFound", httpStatus: 404, detail: "The service plan could not be found: %s"},
+	110004: {title: "CF-ServicePlanNotUpdateable", httpStatus: 400, detail: "The service does not support changing plans."},
+	120001: {title: "CF-ServiceInvalid", httpStatus: 400, detail: "The service is invalid: %s"},
+	120002: {title: "CF-ServiceLabelTaken", httpStatus: 400, detail: "The service label is taken: %s"},
+	120003: {title: "CF-ServiceNotFound", httpStatus: 404, detail: "The service could not be found: %s"},
+	120004: {title: "CF-ServiceFetchInstanceParametersNotSupported", httpStatus: 400, detail: "This service does not support fetching service instance parameters."},
+	130001: {title: "CF-DomainInvalid", httpStatus: 400, detail: "The domain is invalid: %s"},
+	130002: {title: "CF-DomainNotFound", httpStatus: 404, detail: "The domain could not be found: %s"},
+	130003: {title: "CF-DomainNameTaken", httpStatus: 400, detail: "The domain name is taken: %s"},
+	130004: {title: "CF-PathInvalid", httpStatus: 400, detail: "The path is invalid: %s"},
+	130005: {title: "CF-TotalPrivateDomainsExceeded", httpStatus: 400, detail: "The number of private domains exceeds the quota for organization: %s"},
+	130006: {title: "CF-ServiceDoesNotSupportRoutes", httpStatus: 400, detail: "This service does not support route binding."},
+	130007: {title: "CF-RouteAlreadyBoundToServiceInstance", httpStatus: 400, detail: "A route may only be bound to a single service instance"},
+	130008: {title: "CF-ServiceInstanceAlreadyBoundToSameRoute", httpStatus: 400, detail: "The route and service instance are already bound."},
+	130009: {title: "CF-InternalDomainCannotBeDeleted", httpStatus: 422, detail: "The domain '%s' cannot be deleted. It is reserved by the platform."},
+	130010: {title: "CF-RouteServiceCannotBeBoundToInternalRoute", httpStatus: 400, detail: "Route services cannot be bound to internal routes."},
+	140001: {title: "CF-LegacyApiWithoutDefaultSpace", httpStatus: 400, detail: "A legacy api call requiring a default app space was called, but no default app space is set for the user."},
+	150001: {title: "CF-AppPackageInvalid", httpStatus: 400, detail: "The app package is invalid: %s"},
+	150002: {title: "CF-AppPackageNotFound", httpStatus: 404, detail: "The app package could not be found: %s"},
+	150003: {title: "CF-InsufficientRunningResourcesAvailable", httpStatus: 503, detail: "One or more instances could not be started because of insufficient running resources."},
+	150004: {title: "CF-PackageBitsAlreadyUploaded", httpStatus: 400, detail: "Bits may be uploaded only once. Create a new package to upload different bits."},
+	150005: {title: "CF-BlobstoreNotLocal", httpStatus: 400, detail: "Downloading blobs can only be done directly to the blobstore."},
+	150006: {title: "CF-BlobstoreUnavailable", httpStatus: 502, detail: "Failed to perform operation due to blobstore unavailability."},
+	150007: {title: "CF-BlobstoreError", httpStatus: 500, detail: "Failed to perform blobstore operation after three retries."},
+	150008: {title: "CF-DockerImageMissing", httpStatus: 400, detail: "Docker credentials can only be supplied for apps with a 'docker_image'"},
+	150009: {title: "CF-AppRecursiveDeleteFailed", httpStatus: 502, detail: "Deletion of app %s failed because one or more associated resources could not be deleted.%s"},
+	160001: {title: "CF-AppBitsUploadInvalid", httpStatus: 400, detail: "The app upload is invalid: %s"},
+	160002: {title: "CF-AppBitsCopyInvalid", httpStatus: 400, detail: "The app copy is invalid: %s"},
+	160003: {title: "CF-AppResourcesFileModeInvalid", httpStatus: 400, detail: "The resource file mode is invalid: %s"},
+	160004: {title: "CF-AppResourcesFilePathInvalid", httpStatus: 400, detail: "The resource file path is invalid: %s"},
+	170001: {title: "CF-StagingError", httpStatus: 400, detail: "Staging error: %s"},
+	170002: {title: "CF-NotStaged", httpStatus: 400, detail: "App has not finished staging"},
+	170003: {title: "CF-NoAppDetectedError", httpStatus: 400, detail: "An app was not successfully detected by any available buildpack"},
+	170004: {title: "CF-BuildpackCompileFailed", httpStatus: 400, detail: "App staging failed in the buildpack compile phase"},
+	170005: {title: "CF-BuildpackReleaseFailed", httpStatus: 400, detail: "App staging failed in the buildpack release phase"},
+	170006: {title: "CF-NoBuildpacksFound", httpStatus: 400, detail: "There are no buildpacks available"},
+	170007: {title: "CF-StagingTimeExpired", httpStatus: 504, detail: "Staging time expired: %s"},
+	170008: {title: "CF-InsufficientResources", httpStatus: 400, detail: "Insufficient resources"},
+	170009: {title: "CF-NoCompatibleCell", httpStatus: 400, detail: "Found no compatible cell"},
+	170010: {title: "CF-StagerUnavailable", httpStatus: 503, detail: "Stager is unavailable: %s"},
+	170011: {title: "CF-StagerError", httpStatus: 500, detail: "Stager error: %s"},
+	170014: {title: "CF-RunnerInvalidRequest", httpStatus: 500, detail: "Runner invalid request: %s"},
+	170015: {title: "CF-RunnerUnavailable", httpStatus: 503, detail: "Runner is unavailable: %s"},
+	170016: {title: "CF-RunnerError", httpStatus: 500, detail: "Runner error: %s"},
+	170017: {title: "CF-StagingInProgress", httpStatus: 422, detail: "Only one build can be STAGING at a time per application."},
+	170018: {title: "CF-InvalidTaskAddress", httpStatus: 500, detail: "Invalid config: %s"},
+	170019: {title: "CF-TaskError", httpStatus: 500, detail: "Task failed: %s"},
+	170020: {title: "CF-TaskWorkersUnavailable", httpStatus: 503, detail: "Task workers are unavailable: %s"},
+	170021: {title: "CF-InvalidTaskRequest", httpStatus: 422, detail: "The task request is invalid: %s"},
+	180002: {title: "CF-ServiceGatewayError", httpStatus: 503, detail: "Service gateway internal error: %s"},
+	180003: {title: "CF-ServiceNotImplemented", httpStatus: 501, detail: "Operation not supported for service"},
+	180004: {title: "CF-SDSNotAvailable", httpStatus: 501, detail: "No serialization service backends available"},
+	190001: {title: "CF-FileError", httpStatus: 400, detail: "File error: %s"},
+	200001: {title: "CF-StatsError", httpStatus: 400, detail: "Stats error: %s"},
+	200002: {title: "CF-StatsUnavailable", httpStatus: 503, detail: "Stats unavailable: %s"},
+	200003: {title: "CF-AppStoppedStatsError", httpStatus: 400, detail: "Could not fetch stats for stopped app: %s"},
+	210001: {title: "CF-RouteInvalid", httpStatus: 400, detail: "The route is invalid: %s"},
+	210002: {title: "CF-RouteNotFound", httpStatus: 404, detail: "The route could not be found: %s"},
+	210003: {title: "CF-RouteHostTaken", httpStatus: 400, detail: "The host is taken: %s"},
+	210004: {title: "CF-RoutePathTaken", httpStatus: 400, detail: "The path is taken: %s"},
+	210005: {title: "CF-RoutePortTaken", httpStatus: 400, detail: "The port is taken: %s"},
+	210006: {title: "CF-RouteMappingTaken", httpStatus: 400, detail: "The route mapping is taken: %s"},
+	210007: {title: "CF-RouteMappingNotFound", httpStatus: 404, detail: "The route mapping could not be found: %s"},
+	210009: {title: "CF-RouterGroupNotFound", httpStatus: 404, detail: "The router group could not be found: %s"},
+	220001: {title: "CF-InstancesError", httpStatus: 400, detail: "Instances error: %s"},
+	220002: {title: "CF-InstancesUnavailable", httpStatus: 503, detail: "Instances information unavailable: %s"},
+	230002: {title: "CF-EventNotFound", httpStatus: 404, detail: "Event could not be found: %s"},
+	240001: {title: "CF-QuotaDefinitionNotFound", httpStatus: 404, detail: "Quota Definition could not be found: %s"},
+	240002: {title: "CF-QuotaDefinitionNameTaken", httpStatus: 400, detail: "Quota Definition is taken: %s"},
+	240003: {title: "CF-QuotaDefinitionInvalid", httpStatus: 400, detail: "Quota Definition is invalid: %s"},
+	240004: {title: "CF-QuotaDefinitionMemoryLimitInvalid", httpStatus: 400, detail: "Quota Definition memory limit cannot be less than -1"},
+	250001: {title: "CF-StackInvalid", httpStatus: 400, detail: "The stack is invalid: %s"},
+	250002: {title: "CF-StackNameTaken", httpStatus: 400, detail: "The stack name is taken: %s"},
+	250003: {title: "CF-StackNotFound", httpStatus: 404, detail: "The stack could not be found: %s"},
+	260001: {title: "CF-ServicePlanVisibilityInvalid", httpStatus: 400, detail: "Service Plan Visibility is invalid: %s"},
+	260002: {title: "CF-ServicePlanVisibilityAlreadyExists", httpStatus: 400, detail: "This combination of ServicePlan and Organization is already taken: %s"},
+	260003: {title: "CF-ServicePlanVisibilityNotFound", httpStatus: 404, detail: "The service plan visibility could not be found: %s"},
+	270001: {title: "CF-ServiceBrokerInvalid", httpStatus: 400, detail: "Service broker is invalid: %s"},
+	270002: {title: "CF-ServiceBrokerNameTaken", httpStatus: 400, detail: "The service broker name is taken"},
+	270003: {title: "CF-ServiceBrokerUrlTaken", httpStatus: 400, detail: "The service broker url is taken: %s"},
+	270004: {title: "CF-ServiceBrokerNotFound", httpStatus: 404, detail: "The service broker was not found: %s"},
+	270010: {title: "CF-ServiceBrokerNotRemovable", httpStatus: 400, detail: "Can not remove brokers that have associated service instances: %s"},
+	270011: {title: "CF-ServiceBrokerUrlInvalid", httpStatus: 400, detail: "%s is not a valid URL"},
+	270012: {title: "CF-ServiceBrokerCatalogInvalid", httpStatus: 502, detail: "Service broker catalog is invalid: %s"},
+	270013: {title: "CF-ServiceBrokerDashboardClientFailure", httpStatus: 502, detail: "Service broker dashboard clients could not be modified: %s"},
+	270014: {title: "CF-ServiceBrokerAsyncRequired", httpStatus: 400, detail: "This service plan requires client support for asynchronous service operations."},
+	270015: {title: "CF-ServiceDashboardClientMissingUrl", httpStatus: 502, detail: "Service broker returned dashboard client configuration without a dashboard URL"},
+	270016: {title: "CF-ServiceBrokerUrlBasicAuthNotSupported", httpStatus: 400, detail: "User name and password fields in the broker URI are not supported"},
+	270017: {title: "CF-ServiceBrokerRespondedAsyncWhenNotAllowed", httpStatus: 502, detail: "The service broker responded asynchronously to a request, but the accepts_incomplete query parameter was false or not given."},
+	270018: {title: "CF-ServiceBrokerConcurrencyError", httpStatus: 422, detail: "The service broker could not perform this operation in parallel with other running operations"},
+	270019: {title: "CF-ServiceBrokerCatalogIncompatible", httpStatus: 502, detail: "Service broker catalog is incompatible: %s"},
+	270020: {title: "CF-ServiceBrokerRequestRejected", httpStatus: 502, detail: "The service broker rejected the request. Status Code: %s. Please check that the URL points to a valid service broker."},
+	270021: {title: "CF-ServiceBrokerRequestMalformed", httpStatus: 502, detail: "The service broker returned an invalid response: expected valid JSON object in body. Please check that the URL points to a valid service broker."},
+	270022: {title: "CF-ServiceBrokerSyncFailed", httpStatus: 502, detail: "Encountered an error while attempting to sync cloud controller with the service broker's catalog: %s"},
+	290000: {title: "CF-BuildpackNameStackTaken", httpStatus: 422, detail: "The buildpack name %s is already in use for the stack %s"},
+	290001: {title: "CF-BuildpackNameTaken", httpStatus: 400, detail: "The buildpack name is already in use: %s"},
+	290002: {title: "CF-BuildpackBitsUploadInvalid", httpStatus: 400, detail: "The buildpack upload is invalid: %s"},
+	290003: {title: "CF-BuildpackInvalid", httpStatus: 400, detail: "Buildpack is invalid: %s"},
+	290004: {title: "CF-CustomBuildpacksDisabled", httpStatus: 400, detail: "Custom buildpacks are disabled"},
+	290005: {title: "CF-BuildpackLocked", httpStatus: 409, detail: "The buildpack is locked"},
+	290006: {title: "CF-JobTimeout", httpStatus: 524, detail: "The job execution has timed out."},
+	290007: {title: "CF-SpaceDeleteTimeout", httpStatus: 524, detail: "Deletion of space %s timed out before all resources within could be deleted"},
+	290008: {title: "CF-SpaceDeletionFailed", httpStatus: 502, detail: "Deletion of space %s failed because one or more resources within could not be deleted.%s"},
+	290009: {title: "CF-OrganizationDeleteTimeout", httpStatus: 524, detail: "Delete of organization %s timed out before all resources within could be deleted"},
+	290010: {title: "CF-OrganizationDeletionFailed", httpStatus: 502, detail: "Deletion of organization %s failed because one or more resources within could not be deleted.%s"},
+	290011: {title: "CF-NonrecursiveSpaceDeletionFailed", httpStatus: 400, detail: "Resource inside space %s must first be deleted, or specify recursive delete."},
+	290013: {title: "CF-SpaceRolesDeletionTimeout", httpStatus: 524, detail: "Deletion of roles for space %s timed out before all roles could be deleted"},
+	290014: {title: "CF-OrganizationRolesDeletionFailed", httpStatus: 502, detail: "Failed to delete one or more roles for organization %s"},
+	290016: {title: "CF-SpaceRolesDeletionFailed", httpStatus: 502, detail: "Failed to delete one or more roles for space %s"},
+	300001: {title: "CF-SecurityGroupInvalid", httpStatus: 400, detail: "The security group is invalid: %s"},
+	300002: {title: "CF-SecurityGroupNotFound", httpStatus: 404, detail: "The security group could not be found: %s"},
+	300003: {title: "CF-SecurityGroupStagingDefaultInvalid", httpStatus: 400, detail: "The security group could not be found: %s"},
+	300004: {title: "CF-SecurityGroupRunningDefaultInvalid", httpStatus: 400, detail: "The security group could not be found: %s"},
+	300005: {title: "CF-SecurityGroupNameTaken", httpStatus: 400, detail: "The security group name is taken: %s"},
+	310001: {title: "CF-SpaceQuotaDefinitionInvalid", httpStatus: 400, detail: "Space Quota Definition is invalid: %s"},
+	310002: {title: "CF-SpaceQuotaDefinitionNameTaken", httpStatus: 400, detail: "The space quota definition name is taken: %s"},
+	310003: {title: "CF-SpaceQuotaMemoryLimitExceeded", httpStatus: 400, detail: "You have exceeded your space's memory limit: %s"},
+	310004: {title: "CF-SpaceQuotaInstanceMemoryLimitExceeded", httpStatus: 400, detail: "You have exceeded the instance memory limit for your space's quota."},
+	310005: {title: "CF-SpaceQuotaTotalRoutesExceeded", httpStatus: 400, detail: "You have exceeded the total routes for your space's quota."},
+	310006: {title: "CF-OrgQuotaTotalRoutesExceeded", httpStatus: 400, detail: "You have exceeded the total routes for your organization's quota."},
+	310007: {title: "CF-SpaceQuotaDefinitionNotFound", httpStatus: 404, detail: "Space Quota Definition could not be found: %s"},
+	310008: {title: "CF-SpaceQuotaInstanceLimitExceeded", httpStatus: 400, detail: "You have exceeded the instance limit for your space's quota."},
+	310009: {title: "CF-OrgQuotaTotalReservedRoutePortsExceeded", httpStatus: 400, detail: "You have exceeded the total reserved route ports for your organization's quota."},
+	310010: {title: "CF-SpaceQuotaTotalReservedRoutePortsExceeded", httpStatus: 400, detail: "You have exceeded the total reserved route ports for your space's quota."},
+	310011: {title: "CF-SpaceQuotaLogRateLimitExceeded", httpStatus: 400, detail: "You have exceeded your space's log rate limit: %s"},
+	320001: {title: "CF-DiegoDisabled", httpStatus: 400, detail: "Diego has not been enabled."},
+	320002: {title: "CF-DiegoDockerBuildpackConflict", httpStatus: 400, detail: "You cannot specify a custom buildpack and a docker image at the same time."},
+	320003: {title: "CF-DockerDisabled", httpStatus: 400, detail: "Docker support has not been enabled."},
+	320004: {title: "CF-StagingBackendInvalid", httpStatus: 403, detail: "The request staging completion endpoint only handles apps desired to stage on the Diego backend."},
+	320005: {title: "CF-BackendSelectionNotAuthorized", httpStatus: 403, detail: "You cannot select the backend on which to run this application"},
+	320006: {title: "CF-RevisionsEnabled", httpStatus: 400, detail: "V2 restaging is disabled when your app has revisions enabled"},
+	330000: {title: "CF-FeatureFlagNotFound", httpStatus: 404, detail: "The feature flag could not be found: %s"},
+	330001: {title: "CF-FeatureFlagInvalid", httpStatus: 400, detail: "The feature flag is invalid: %s"},
+	330002: {title: "CF-FeatureDisabled", httpStatus: 403, detail: "Feature Disabled: %s"},
+	340001: {title: "CF-UserProvidedServiceInstanceNotFound", httpStatus: 404, detail: "The service instance could not be found: %s"},
+	340002: {title: "CF-UserProvidedServiceInstanceHandlerNeeded", httpStatus: 400, detail: "Please use the User Provided Services API to manage this resource."},
+	350001: {title: "CF-ProcessInvalid", httpStatus: 400, detail: "The process is invalid: %s"},
+	350002: {title: "CF-UnableToDelete", httpStatus: 400, detail: "Unable to perform delete action: %s"},
+	350003: {title: "CF-ProcessNotFound", httpStatus: 404, detail: "The process could not be found: %s"},
+	360001: {title: "CF-ServiceKeyNameTaken", httpStatus: 400, detail: "The service key name is taken: %s"},
+	360002: {title: "CF-ServiceKeyInvalid", httpStatus: 400, detail: "The service key is invalid: %s"},
+	360003: {title: "CF-ServiceKeyNotFound", httpStatus: 404, detail: "The service key could not be found: %s"},
+	360004: {title: "CF-ServiceKeyNotSupported", httpStatus: 400, detail: "%s"},
+	360005: {title: "CF-ServiceKeyCredentialStoreUnavailable", httpStatus: 503, detail: "Credential store is unavailable"},
+	370001: {title: "CF-RoutingApiUnavailable", httpStatus: 503, detail: "The Routing API is currently unavailable"},
+	370003: {title: "CF-RoutingApiDisabled", httpStatus: 403, detail: "Routing API is disabled"},
+	380001: {title: "CF-EnvironmentVariableGroupInvalid", httpStatus: 400, detail: "The Environment Variable Group is invalid: %s"},
+	380002: {title: "CF-DropletUploadInvalid", httpStatus: 400, detail: "The droplet upload is invalid: %s"},
+	390001: {title: "CF-ServiceInstanceUnshareFailed", httpStatus: 502, detail: "Unshare of service instance failed: %s"},
+	390002: {title: "CF-ServiceInstanceDeletionSharesExists", httpStatus: 422, detail: "Service instances must be unshared before they can be deleted. Unsharing %s will automatically delete any bindings that have been made to applications in other spaces."},
+	390003: {title: "CF-SharedServiceInstanceCannotBeRenamed", httpStatus: 422, detail: "Service instances that have been shared cannot be renamed"},
+	390004: {title: "CF-SharedServiceInstanceNotUpdatableInTargetSpace", httpStatus: 403, detail: "You cannot update service instances that have been shared with you"},
+	390005: {title: "CF-SharedServiceInstanceNotDeletableInTargetSpace", httpStatus: 403, detail: "You cannot delete service instances that have been shared with you"},
+	390006: {title: "CF-MaintenanceInfoNotSupported", httpStatus: 422, detail: "The service broker does not support upgrades for service instances created from this plan."},
+	390007: {title: "CF-MaintenanceInfoNotSemver", httpStatus: 422, detail: "maintenance_info.version should be a semantic version."},
+	390008: {title: "CF-MaintenanceInfoNotUpdatableWhenChangingPlan", httpStatus: 422, detail: "maintenance_info should not be changed when switching to different plan."},
+	390009: {title: "CF-MaintenanceInfoConflict", httpStatus: 422, detail: "maintenance_info.version requested is invalid. Please ensure the catalog is up to date and you are providing a version supported by this service plan."},
+	390011: {title: "CF-BuildpackStacksDontMatch", httpStatus: 422, detail: "Uploaded buildpack stack (%s) does not match %s"},
+	390012: {title: "CF-BuildpackStackDoesNotExist", httpStatus: 422, detail: "Uploaded buildpack stack (%s) does not exist"},
+	390013: {title: "CF-BuildpackZipError", httpStatus: 422, detail: "Buildpack zip error: %s"},
+	390014: {title: "CF-DeploymentsDisabled", httpStatus: 403, detail: "Deployments cannot be created due to manifest property 'temporary_disable_deployments'"},
+	390015: {title: "CF-NoCurrentEncryptionKey", httpStatus: 422, detail: "Please set the desired encryption key in the manifest at ‘cc.database_encryption.current_key_label’"},
+	390016: {title: "CF-ScaleDisabledDuringDeployment", httpStatus: 422, detail: "Cannot scale this process while a deployment is in flight."},
+	390017: {title: "CF-ProcessUpdateDisabledDuringDeployment", httpStatus: 422, detail: "Cannot update this process while a deployment is in flight."},
+	390020: {title: "CF-LabelLimitExceeded", httpStatus: 422, detail: "Failed to add %d labels because it would exceed maximum of %d"},
+	390023: {title: "CF-AnnotationLimitExceeded", httpStatus: 422, detail: "Failed to add %d annotations because it would exceed maximum of %d"},
+	390024: {title: "CF-StopDisabledDuringDeployment", httpStatus: 422, detail: "Cannot stop the app while it is deploying, please cancel the deployment before stopping the app."},
+	400001: {title: "CF-KubernetesRouteResourceError", httpStatus: 422, detail: "Failed to create/update/delete Route resource with guid '%s' on Kubernetes"},
+	400002: {title: "CF-KpackImageError", httpStatus: 422, detail: "Failed to %s Image resource for staging: '%s'"},
+	400003: {title: "CF-KpackBuilderError", httpStatus: 422, detail: "Failed to %s Builder resource: '%s'"},
+	410001: {title: "CF-EiriniLRPError", httpStatus: 422, detail: "Failed to %s LRP resource: '%s'"},
+}
+
 func cloudFoundryError(err error) (cferr CloudFoundryError, ok bool) {
 	ok = errors.As(err, &cferr)
 	return cferr, ok
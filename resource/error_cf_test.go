@@ -0,0 +1,307 @@
+// Code generated by go generate. DO NOT EDIT.
+// This file was generated by robots from tools/errors_v2.yml, cloud_controller_ng commit unknown
+
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		newError   func() CloudFoundryError
+		isError    func(error) bool
+		code       int
+		httpStatus int
+	}{
+		{"InvalidAuthToken", NewInvalidAuthTokenError, IsInvalidAuthTokenError, 1000, 401},
+		{"MessageParseError", NewMessageParseError, IsMessageParseError, 1001, 400},
+		{"InvalidRelation", NewInvalidRelationError, IsInvalidRelationError, 1002, 400},
+		{"InvalidContentType", NewInvalidContentTypeError, IsInvalidContentTypeError, 1003, 400},
+		{"BadRequest", NewBadRequestError, IsBadRequestError, 1004, 400},
+		{"NotFound", NewNotFoundError, IsNotFoundError, 10000, 404},
+		{"ServerError", NewServerError, IsServerError, 10001, 500},
+		{"NotAuthenticated", NewNotAuthenticatedError, IsNotAuthenticatedError, 10002, 401},
+		{"NotAuthorized", NewNotAuthorizedError, IsNotAuthorizedError, 10003, 403},
+		{"InvalidRequest", NewInvalidRequestError, IsInvalidRequestError, 10004, 400},
+		{"BadQueryParameter", NewBadQueryParameterError, IsBadQueryParameterError, 10005, 400},
+		{"AssociationNotEmpty", NewAssociationNotEmptyError, IsAssociationNotEmptyError, 10006, 400},
+		{"InsufficientScope", NewInsufficientScopeError, IsInsufficientScopeError, 10007, 403},
+		{"UnprocessableEntity", NewUnprocessableEntityError, IsUnprocessableEntityError, 10008, 422},
+		{"UnableToPerform", NewUnableToPerformError, IsUnableToPerformError, 10009, 400},
+		{"ResourceNotFound", NewResourceNotFoundError, IsResourceNotFoundError, 10010, 404},
+		{"DatabaseError", NewDatabaseError, IsDatabaseError, 10011, 500},
+		{"OrderByParameterInvalid", NewOrderByParameterInvalidError, IsOrderByParameterInvalidError, 10012, 500},
+		{"RateLimitExceeded", NewRateLimitExceededError, IsRateLimitExceededError, 10013, 429},
+		{"IPBasedRateLimitExceeded", NewIPBasedRateLimitExceededError, IsIPBasedRateLimitExceededError, 10014, 429},
+		{"ServiceUnavailable", NewServiceUnavailableError, IsServiceUnavailableError, 10015, 503},
+		{"ServiceBrokerRateLimitExceeded", NewServiceBrokerRateLimitExceededError, IsServiceBrokerRateLimitExceededError, 10016, 429},
+		{"OrgSuspended", NewOrgSuspendedError, IsOrgSuspendedError, 10017, 403},
+		{"RateLimitV2APIExceeded", NewRateLimitV2APIExceededError, IsRateLimitV2APIExceededError, 10018, 429},
+		{"UserInvalid", NewUserInvalidError, IsUserInvalidError, 20001, 400},
+		{"UaaIdTaken", NewUAAIDTakenError, IsUAAIDTakenError, 20002, 400},
+		{"UserNotFound", NewUserNotFoundError, IsUserNotFoundError, 20003, 404},
+		{"UaaUnavailable", NewUAAUnavailableError, IsUAAUnavailableError, 20004, 503},
+		{"UaaEndpointDisabled", NewUAAEndpointDisabledError, IsUAAEndpointDisabledError, 20005, 501},
+		{"UserIsInMultipleOrigins", NewUserIsInMultipleOriginsError, IsUserIsInMultipleOriginsError, 20006, 400},
+		{"UserWithOriginNotFound", NewUserWithOriginNotFoundError, IsUserWithOriginNotFoundError, 20007, 404},
+		{"OutOfRouterGroupPorts", NewOutOfRouterGroupPortsError, IsOutOfRouterGroupPortsError, 21008, 403},
+		{"OrganizationInvalid", NewOrganizationInvalidError, IsOrganizationInvalidError, 30001, 400},
+		{"OrganizationNameTaken", NewOrganizationNameTakenError, IsOrganizationNameTakenError, 30002, 400},
+		{"OrganizationNotFound", NewOrganizationNotFoundError, IsOrganizationNotFoundError, 30003, 404},
+		{"LastManagerInOrg", NewLastManagerInOrgError, IsLastManagerInOrgError, 30004, 403},
+		{"LastBillingManagerInOrg", NewLastBillingManagerInOrgError, IsLastBillingManagerInOrgError, 30005, 403},
+		{"LastUserInOrg", NewLastUserInOrgError, IsLastUserInOrgError, 30006, 403},
+		{"OrganizationAlreadySet", NewOrganizationAlreadySetError, IsOrganizationAlreadySetError, 30007, 400},
+		{"SpaceInvalid", NewSpaceInvalidError, IsSpaceInvalidError, 40001, 400},
+		{"SpaceNameTaken", NewSpaceNameTakenError, IsSpaceNameTakenError, 40002, 400},
+		{"SpaceUserNotInOrg", NewSpaceUserNotInOrgError, IsSpaceUserNotInOrgError, 40003, 400},
+		{"SpaceNotFound", NewSpaceNotFoundError, IsSpaceNotFoundError, 40004, 404},
+		{"ServiceInstanceNameEmpty", NewServiceInstanceNameEmptyError, IsServiceInstanceNameEmptyError, 60001, 400},
+		{"ServiceInstanceNameTaken", NewServiceInstanceNameTakenError, IsServiceInstanceNameTakenError, 60002, 400},
+		{"ServiceInstanceInvalid", NewServiceInstanceInvalidError, IsServiceInstanceInvalidError, 60003, 400},
+		{"ServiceInstanceNotFound", NewServiceInstanceNotFoundError, IsServiceInstanceNotFoundError, 60004, 404},
+		{"ServiceInstanceQuotaExceeded", NewServiceInstanceQuotaExceededError, IsServiceInstanceQuotaExceededError, 60005, 400},
+		{"PreviouslyUsedAs_ServiceInstancePaidQuotaExceeded", NewPreviouslyUsedAs_ServiceInstancePaidQuotaExceededError, IsPreviouslyUsedAs_ServiceInstancePaidQuotaExceededError, 60006, 400},
+		{"ServiceInstanceServicePlanNotAllowed", NewServiceInstanceServicePlanNotAllowedError, IsServiceInstanceServicePlanNotAllowedError, 60007, 400},
+		{"ServiceInstanceDuplicateNotAllowed", NewServiceInstanceDuplicateNotAllowedError, IsServiceInstanceDuplicateNotAllowedError, 60008, 400},
+		{"ServiceInstanceNameTooLong", NewServiceInstanceNameTooLongError, IsServiceInstanceNameTooLongError, 60009, 400},
+		{"ServiceInstanceOrganizationNotAuthorized", NewServiceInstanceOrganizationNotAuthorizedError, IsServiceInstanceOrganizationNotAuthorizedError, 60010, 403},
+		{"ServiceInstanceDeprovisionFailed", NewServiceInstanceDeprovisionFailedError, IsServiceInstanceDeprovisionFailedError, 60011, 409},
+		{"ServiceInstanceSpaceQuotaExceeded", NewServiceInstanceSpaceQuotaExceededError, IsServiceInstanceSpaceQuotaExceededError, 60012, 400},
+		{"ServiceInstanceServicePlanNotAllowedBySpaceQuota", NewServiceInstanceServicePlanNotAllowedBySpaceQuotaError, IsServiceInstanceServicePlanNotAllowedBySpaceQuotaError, 60013, 400},
+		{"ServiceInstanceSpaceChangeNotAllowed", NewServiceInstanceSpaceChangeNotAllowedError, IsServiceInstanceSpaceChangeNotAllowedError, 60014, 400},
+		{"ServiceInstanceTagsTooLong", NewServiceInstanceTagsTooLongError, IsServiceInstanceTagsTooLongError, 60015, 400},
+		{"AsyncServiceInstanceOperationInProgress", NewAsyncServiceInstanceOperationInProgressError, IsAsyncServiceInstanceOperationInProgressError, 60016, 409},
+		{"ServiceInstanceRouteBindingSpaceMismatch", NewServiceInstanceRouteBindingSpaceMismatchError, IsServiceInstanceRouteBindingSpaceMismatchError, 60017, 400},
+		{"ServiceInstanceSpaceNotAuthorized", NewServiceInstanceSpaceNotAuthorizedError, IsServiceInstanceSpaceNotAuthorizedError, 60018, 403},
+		{"ServiceInstanceRouteServiceURLInvalid", NewServiceInstanceRouteServiceURLInvalidError, IsServiceInstanceRouteServiceURLInvalidError, 60019, 400},
+		{"ServiceInstanceRouteServiceRequiresDiego", NewServiceInstanceRouteServiceRequiresDiegoError, IsServiceInstanceRouteServiceRequiresDiegoError, 60020, 400},
+		{"ServiceInstanceRouteServiceDisabled", NewServiceInstanceRouteServiceDisabledError, IsServiceInstanceRouteServiceDisabledError, 60021, 403},
+		{"AppPortMappingRequiresDiego", NewAppPortMappingRequiresDiegoError, IsAppPortMappingRequiresDiegoError, 60022, 400},
+		{"RoutePortNotEnabledOnApp", NewRoutePortNotEnabledOnAppError, IsRoutePortNotEnabledOnAppError, 60023, 400},
+		{"MultipleAppPortsMappedDiegoToDea", NewMultipleAppPortsMappedDiegoToDeaError, IsMultipleAppPortsMappedDiegoToDeaError, 60024, 400},
+		{"VolumeMountServiceDisabled", NewVolumeMountServiceDisabledError, IsVolumeMountServiceDisabledError, 60025, 403},
+		{"DockerAppToDea", NewDockerAppToDeaError, IsDockerAppToDeaError, 60026, 400},
+		{"ServiceInstanceRecursiveDeleteFailed", NewServiceInstanceRecursiveDeleteFailedError, IsServiceInstanceRecursiveDeleteFailedError, 60027, 502},
+		{"ManagedServiceInstanceNotFound", NewManagedServiceInstanceNotFoundError, IsManagedServiceInstanceNotFoundError, 60028, 404},
+		{"ServiceInstanceWithInaccessiblePlanNotUpdateable", NewServiceInstanceWithInaccessiblePlanNotUpdateableError, IsServiceInstanceWithInaccessiblePlanNotUpdateableError, 60029, 403},
+		{"ServiceInstanceProvisionFailed", NewServiceInstanceProvisionFailedError, IsServiceInstanceProvisionFailedError, 60030, 400},
+		{"RuntimeInvalid", NewRuntimeInvalidError, IsRuntimeInvalidError, 70001, 400},
+		{"RuntimeNameTaken", NewRuntimeNameTakenError, IsRuntimeNameTakenError, 70002, 400},
+		{"RuntimeNotFound", NewRuntimeNotFoundError, IsRuntimeNotFoundError, 70003, 404},
+		{"FrameworkInvalid", NewFrameworkInvalidError, IsFrameworkInvalidError, 80001, 400},
+		{"FrameworkNameTaken", NewFrameworkNameTakenError, IsFrameworkNameTakenError, 80002, 400},
+		{"FrameworkNotFound", NewFrameworkNotFoundError, IsFrameworkNotFoundError, 80003, 404},
+		{"ServiceBindingInvalid", NewServiceBindingInvalidError, IsServiceBindingInvalidError, 90001, 400},
+		{"ServiceBindingDifferentSpaces", NewServiceBindingDifferentSpacesError, IsServiceBindingDifferentSpacesError, 90002, 400},
+		{"ServiceBindingAppServiceTaken", NewServiceBindingAppServiceTakenError, IsServiceBindingAppServiceTakenError, 90003, 400},
+		{"ServiceBindingNotFound", NewServiceBindingNotFoundError, IsServiceBindingNotFoundError, 90004, 404},
+		{"UnbindableService", NewUnbindableServiceError, IsUnbindableServiceError, 90005, 400},
+		{"InvalidLoggingServiceBinding", NewInvalidLoggingServiceBindingError, IsInvalidLoggingServiceBindingError, 90006, 502},
+		{"ServiceFetchBindingParametersNotSupported", NewServiceFetchBindingParametersNotSupportedError, IsServiceFetchBindingParametersNotSupportedError, 90007, 400},
+		{"AsyncServiceBindingOperationInProgress", NewAsyncServiceBindingOperationInProgressError, IsAsyncServiceBindingOperationInProgressError, 90008, 409},
+		{"AppInvalid", NewAppInvalidError, IsAppInvalidError, 100001, 400},
+		{"AppNameTaken", NewAppNameTakenError, IsAppNameTakenError, 100002, 400},
+		{"AppNotFound", NewAppNotFoundError, IsAppNotFoundError, 100004, 404},
+		{"AppMemoryQuotaExceeded", NewAppMemoryQuotaExceededError, IsAppMemoryQuotaExceededError, 100005, 400},
+		{"AppMemoryInvalid", NewAppMemoryInvalidError, IsAppMemoryInvalidError, 100006, 400},
+		{"QuotaInstanceMemoryLimitExceeded", NewQuotaInstanceMemoryLimitExceededError, IsQuotaInstanceMemoryLimitExceededError, 100007, 400},
+		{"QuotaInstanceLimitExceeded", NewQuotaInstanceLimitExceededError, IsQuotaInstanceLimitExceededError, 100008, 400},
+		{"AppMemoryInsufficientForSidecars", NewAppMemoryInsufficientForSidecarsError, IsAppMemoryInsufficientForSidecarsError, 100009, 400},
+		{"OrgQuotaLogRateLimitExceeded", NewOrgQuotaLogRateLimitExceededError, IsOrgQuotaLogRateLimitExceededError, 100010, 400},
+		{"ServicePlanInvalid", NewServicePlanInvalidError, IsServicePlanInvalidError, 110001, 400},
+		{"ServicePlanNameTaken", NewServicePlanNameTakenError, IsServicePlanNameTakenError, 110002, 400},
+		{"ServicePlanNotFound", NewServicePlanNotFoundError, IsServicePlanNotFoundError, 110003, 404},
+		{"ServicePlanNotUpdateable", NewServicePlanNotUpdateableError, IsServicePlanNotUpdateableError, 110004, 400},
+		{"ServiceInvalid", NewServiceInvalidError, IsServiceInvalidError, 120001, 400},
+		{"ServiceLabelTaken", NewServiceLabelTakenError, IsServiceLabelTakenError, 120002, 400},
+		{"ServiceNotFound", NewServiceNotFoundError, IsServiceNotFoundError, 120003, 404},
+		{"ServiceFetchInstanceParametersNotSupported", NewServiceFetchInstanceParametersNotSupportedError, IsServiceFetchInstanceParametersNotSupportedError, 120004, 400},
+		{"DomainInvalid", NewDomainInvalidError, IsDomainInvalidError, 130001, 400},
+		{"DomainNotFound", NewDomainNotFoundError, IsDomainNotFoundError, 130002, 404},
+		{"DomainNameTaken", NewDomainNameTakenError, IsDomainNameTakenError, 130003, 400},
+		{"PathInvalid", NewPathInvalidError, IsPathInvalidError, 130004, 400},
+		{"TotalPrivateDomainsExceeded", NewTotalPrivateDomainsExceededError, IsTotalPrivateDomainsExceededError, 130005, 400},
+		{"ServiceDoesNotSupportRoutes", NewServiceDoesNotSupportRoutesError, IsServiceDoesNotSupportRoutesError, 130006, 400},
+		{"RouteAlreadyBoundToServiceInstance", NewRouteAlreadyBoundToServiceInstanceError, IsRouteAlreadyBoundToServiceInstanceError, 130007, 400},
+		{"ServiceInstanceAlreadyBoundToSameRoute", NewServiceInstanceAlreadyBoundToSameRouteError, IsServiceInstanceAlreadyBoundToSameRouteError, 130008, 400},
+		{"InternalDomainCannotBeDeleted", NewInternalDomainCannotBeDeletedError, IsInternalDomainCannotBeDeletedError, 130009, 422},
+		{"RouteServiceCannotBeBoundToInternalRoute", NewRouteServiceCannotBeBoundToInternalRouteError, IsRouteServiceCannotBeBoundToInternalRouteError, 130010, 400},
+		{"LegacyApiWithoutDefaultSpace", NewLegacyApiWithoutDefaultSpaceError, IsLegacyApiWithoutDefaultSpaceError, 140001, 400},
+		{"AppPackageInvalid", NewAppPackageInvalidError, IsAppPackageInvalidError, 150001, 400},
+		{"AppPackageNotFound", NewAppPackageNotFoundError, IsAppPackageNotFoundError, 150002, 404},
+		{"InsufficientRunningResourcesAvailable", NewInsufficientRunningResourcesAvailableError, IsInsufficientRunningResourcesAvailableError, 150003, 503},
+		{"PackageBitsAlreadyUploaded", NewPackageBitsAlreadyUploadedError, IsPackageBitsAlreadyUploadedError, 150004, 400},
+		{"BlobstoreNotLocal", NewBlobstoreNotLocalError, IsBlobstoreNotLocalError, 150005, 400},
+		{"BlobstoreUnavailable", NewBlobstoreUnavailableError, IsBlobstoreUnavailableError, 150006, 502},
+		{"BlobstoreError", NewBlobstoreError, IsBlobstoreError, 150007, 500},
+		{"DockerImageMissing", NewDockerImageMissingError, IsDockerImageMissingError, 150008, 400},
+		{"AppRecursiveDeleteFailed", NewAppRecursiveDeleteFailedError, IsAppRecursiveDeleteFailedError, 150009, 502},
+		{"AppBitsUploadInvalid", NewAppBitsUploadInvalidError, IsAppBitsUploadInvalidError, 160001, 400},
+		{"AppBitsCopyInvalid", NewAppBitsCopyInvalidError, IsAppBitsCopyInvalidError, 160002, 400},
+		{"AppResourcesFileModeInvalid", NewAppResourcesFileModeInvalidError, IsAppResourcesFileModeInvalidError, 160003, 400},
+		{"AppResourcesFilePathInvalid", NewAppResourcesFilePathInvalidError, IsAppResourcesFilePathInvalidError, 160004, 400},
+		{"StagingError", NewStagingError, IsStagingError, 170001, 400},
+		{"NotStaged", NewNotStagedError, IsNotStagedError, 170002, 400},
+		{"NoAppDetectedError", NewNoAppDetectedError, IsNoAppDetectedError, 170003, 400},
+		{"BuildpackCompileFailed", NewBuildpackCompileFailedError, IsBuildpackCompileFailedError, 170004, 400},
+		{"BuildpackReleaseFailed", NewBuildpackReleaseFailedError, IsBuildpackReleaseFailedError, 170005, 400},
+		{"NoBuildpacksFound", NewNoBuildpacksFoundError, IsNoBuildpacksFoundError, 170006, 400},
+		{"StagingTimeExpired", NewStagingTimeExpiredError, IsStagingTimeExpiredError, 170007, 504},
+		{"InsufficientResources", NewInsufficientResourcesError, IsInsufficientResourcesError, 170008, 400},
+		{"NoCompatibleCell", NewNoCompatibleCellError, IsNoCompatibleCellError, 170009, 400},
+		{"StagerUnavailable", NewStagerUnavailableError, IsStagerUnavailableError, 170010, 503},
+		{"StagerError", NewStagerError, IsStagerError, 170011, 500},
+		{"RunnerInvalidRequest", NewRunnerInvalidRequestError, IsRunnerInvalidRequestError, 170014, 500},
+		{"RunnerUnavailable", NewRunnerUnavailableError, IsRunnerUnavailableError, 170015, 503},
+		{"RunnerError", NewRunnerError, IsRunnerError, 170016, 500},
+		{"StagingInProgress", NewStagingInProgressError, IsStagingInProgressError, 170017, 422},
+		{"InvalidTaskAddress", NewInvalidTaskAddressError, IsInvalidTaskAddressError, 170018, 500},
+		{"TaskError", NewTaskError, IsTaskError, 170019, 500},
+		{"TaskWorkersUnavailable", NewTaskWorkersUnavailableError, IsTaskWorkersUnavailableError, 170020, 503},
+		{"InvalidTaskRequest", NewInvalidTaskRequestError, IsInvalidTaskRequestError, 170021, 422},
+		{"ServiceGatewayError", NewServiceGatewayError, IsServiceGatewayError, 180002, 503},
+		{"ServiceNotImplemented", NewServiceNotImplementedError, IsServiceNotImplementedError, 180003, 501},
+		{"SDSNotAvailable", NewSDSNotAvailableError, IsSDSNotAvailableError, 180004, 501},
+		{"FileError", NewFileError, IsFileError, 190001, 400},
+		{"StatsError", NewStatsError, IsStatsError, 200001, 400},
+		{"StatsUnavailable", NewStatsUnavailableError, IsStatsUnavailableError, 200002, 503},
+		{"AppStoppedStatsError", NewAppStoppedStatsError, IsAppStoppedStatsError, 200003, 400},
+		{"RouteInvalid", NewRouteInvalidError, IsRouteInvalidError, 210001, 400},
+		{"RouteNotFound", NewRouteNotFoundError, IsRouteNotFoundError, 210002, 404},
+		{"RouteHostTaken", NewRouteHostTakenError, IsRouteHostTakenError, 210003, 400},
+		{"RoutePathTaken", NewRoutePathTakenError, IsRoutePathTakenError, 210004, 400},
+		{"RoutePortTaken", NewRoutePortTakenError, IsRoutePortTakenError, 210005, 400},
+		{"RouteMappingTaken", NewRouteMappingTakenError, IsRouteMappingTakenError, 210006, 400},
+		{"RouteMappingNotFound", NewRouteMappingNotFoundError, IsRouteMappingNotFoundError, 210007, 404},
+		{"RouterGroupNotFound", NewRouterGroupNotFoundError, IsRouterGroupNotFoundError, 210009, 404},
+		{"InstancesError", NewInstancesError, IsInstancesError, 220001, 400},
+		{"InstancesUnavailable", NewInstancesUnavailableError, IsInstancesUnavailableError, 220002, 503},
+		{"EventNotFound", NewEventNotFoundError, IsEventNotFoundError, 230002, 404},
+		{"QuotaDefinitionNotFound", NewQuotaDefinitionNotFoundError, IsQuotaDefinitionNotFoundError, 240001, 404},
+		{"QuotaDefinitionNameTaken", NewQuotaDefinitionNameTakenError, IsQuotaDefinitionNameTakenError, 240002, 400},
+		{"QuotaDefinitionInvalid", NewQuotaDefinitionInvalidError, IsQuotaDefinitionInvalidError, 240003, 400},
+		{"QuotaDefinitionMemoryLimitInvalid", NewQuotaDefinitionMemoryLimitInvalidError, IsQuotaDefinitionMemoryLimitInvalidError, 240004, 400},
+		{"StackInvalid", NewStackInvalidError, IsStackInvalidError, 250001, 400},
+		{"StackNameTaken", NewStackNameTakenError, IsStackNameTakenError, 250002, 400},
+		{"StackNotFound", NewStackNotFoundError, IsStackNotFoundError, 250003, 404},
+		{"ServicePlanVisibilityInvalid", NewServicePlanVisibilityInvalidError, IsServicePlanVisibilityInvalidError, 260001, 400},
+		{"ServicePlanVisibilityAlreadyExists", NewServicePlanVisibilityAlreadyExistsError, IsServicePlanVisibilityAlreadyExistsError, 260002, 400},
+		{"ServicePlanVisibilityNotFound", NewServicePlanVisibilityNotFoundError, IsServicePlanVisibilityNotFoundError, 260003, 404},
+		{"ServiceBrokerInvalid", NewServiceBrokerInvalidError, IsServiceBrokerInvalidError, 270001, 400},
+		{"ServiceBrokerNameTaken", NewServiceBrokerNameTakenError, IsServiceBrokerNameTakenError, 270002, 400},
+		{"ServiceBrokerUrlTaken", NewServiceBrokerURLTakenError, IsServiceBrokerURLTakenError, 270003, 400},
+		{"ServiceBrokerNotFound", NewServiceBrokerNotFoundError, IsServiceBrokerNotFoundError, 270004, 404},
+		{"ServiceBrokerNotRemovable", NewServiceBrokerNotRemovableError, IsServiceBrokerNotRemovableError, 270010, 400},
+		{"ServiceBrokerUrlInvalid", NewServiceBrokerURLInvalidError, IsServiceBrokerURLInvalidError, 270011, 400},
+		{"ServiceBrokerCatalogInvalid", NewServiceBrokerCatalogInvalidError, IsServiceBrokerCatalogInvalidError, 270012, 502},
+		{"ServiceBrokerDashboardClientFailure", NewServiceBrokerDashboardClientFailureError, IsServiceBrokerDashboardClientFailureError, 270013, 502},
+		{"ServiceBrokerAsyncRequired", NewServiceBrokerAsyncRequiredError, IsServiceBrokerAsyncRequiredError, 270014, 400},
+		{"ServiceDashboardClientMissingUrl", NewServiceDashboardClientMissingURLError, IsServiceDashboardClientMissingURLError, 270015, 502},
+		{"ServiceBrokerUrlBasicAuthNotSupported", NewServiceBrokerURLBasicAuthNotSupportedError, IsServiceBrokerURLBasicAuthNotSupportedError, 270016, 400},
+		{"ServiceBrokerRespondedAsyncWhenNotAllowed", NewServiceBrokerRespondedAsyncWhenNotAllowedError, IsServiceBrokerRespondedAsyncWhenNotAllowedError, 270017, 502},
+		{"ServiceBrokerConcurrencyError", NewServiceBrokerConcurrencyError, IsServiceBrokerConcurrencyError, 270018, 422},
+		{"ServiceBrokerCatalogIncompatible", NewServiceBrokerCatalogIncompatibleError, IsServiceBrokerCatalogIncompatibleError, 270019, 502},
+		{"ServiceBrokerRequestRejected", NewServiceBrokerRequestRejectedError, IsServiceBrokerRequestRejectedError, 270020, 502},
+		{"ServiceBrokerRequestMalformed", NewServiceBrokerRequestMalformedError, IsServiceBrokerRequestMalformedError, 270021, 502},
+		{"ServiceBrokerSyncFailed", NewServiceBrokerSyncFailedError, IsServiceBrokerSyncFailedError, 270022, 502},
+		{"BuildpackNameStackTaken", NewBuildpackNameStackTakenError, IsBuildpackNameStackTakenError, 290000, 422},
+		{"BuildpackNameTaken", NewBuildpackNameTakenError, IsBuildpackNameTakenError, 290001, 400},
+		{"BuildpackBitsUploadInvalid", NewBuildpackBitsUploadInvalidError, IsBuildpackBitsUploadInvalidError, 290002, 400},
+		{"BuildpackInvalid", NewBuildpackInvalidError, IsBuildpackInvalidError, 290003, 400},
+		{"CustomBuildpacksDisabled", NewCustomBuildpacksDisabledError, IsCustomBuildpacksDisabledError, 290004, 400},
+		{"BuildpackLocked", NewBuildpackLockedError, IsBuildpackLockedError, 290005, 409},
+		{"JobTimeout", NewJobTimeoutError, IsJobTimeoutError, 290006, 524},
+		{"SpaceDeleteTimeout", NewSpaceDeleteTimeoutError, IsSpaceDeleteTimeoutError, 290007, 524},
+		{"SpaceDeletionFailed", NewSpaceDeletionFailedError, IsSpaceDeletionFailedError, 290008, 502},
+		{"OrganizationDeleteTimeout", NewOrganizationDeleteTimeoutError, IsOrganizationDeleteTimeoutError, 290009, 524},
+		{"OrganizationDeletionFailed", NewOrganizationDeletionFailedError, IsOrganizationDeletionFailedError, 290010, 502},
+		{"NonrecursiveSpaceDeletionFailed", NewNonrecursiveSpaceDeletionFailedError, IsNonrecursiveSpaceDeletionFailedError, 290011, 400},
+		{"SpaceRolesDeletionTimeout", NewSpaceRolesDeletionTimeoutError, IsSpaceRolesDeletionTimeoutError, 290013, 524},
+		{"OrganizationRolesDeletionFailed", NewOrganizationRolesDeletionFailedError, IsOrganizationRolesDeletionFailedError, 290014, 502},
+		{"SpaceRolesDeletionFailed", NewSpaceRolesDeletionFailedError, IsSpaceRolesDeletionFailedError, 290016, 502},
+		{"SecurityGroupInvalid", NewSecurityGroupInvalidError, IsSecurityGroupInvalidError, 300001, 400},
+		{"SecurityGroupNotFound", NewSecurityGroupNotFoundError, IsSecurityGroupNotFoundError, 300002, 404},
+		{"SecurityGroupStagingDefaultInvalid", NewSecurityGroupStagingDefaultInvalidError, IsSecurityGroupStagingDefaultInvalidError, 300003, 400},
+		{"SecurityGroupRunningDefaultInvalid", NewSecurityGroupRunningDefaultInvalidError, IsSecurityGroupRunningDefaultInvalidError, 300004, 400},
+		{"SecurityGroupNameTaken", NewSecurityGroupNameTakenError, IsSecurityGroupNameTakenError, 300005, 400},
+		{"SpaceQuotaDefinitionInvalid", NewSpaceQuotaDefinitionInvalidError, IsSpaceQuotaDefinitionInvalidError, 310001, 400},
+		{"SpaceQuotaDefinitionNameTaken", NewSpaceQuotaDefinitionNameTakenError, IsSpaceQuotaDefinitionNameTakenError, 310002, 400},
+		{"SpaceQuotaMemoryLimitExceeded", NewSpaceQuotaMemoryLimitExceededError, IsSpaceQuotaMemoryLimitExceededError, 310003, 400},
+		{"SpaceQuotaInstanceMemoryLimitExceeded", NewSpaceQuotaInstanceMemoryLimitExceededError, IsSpaceQuotaInstanceMemoryLimitExceededError, 310004, 400},
+		{"SpaceQuotaTotalRoutesExceeded", NewSpaceQuotaTotalRoutesExceededError, IsSpaceQuotaTotalRoutesExceededError, 310005, 400},
+		{"OrgQuotaTotalRoutesExceeded", NewOrgQuotaTotalRoutesExceededError, IsOrgQuotaTotalRoutesExceededError, 310006, 400},
+		{"SpaceQuotaDefinitionNotFound", NewSpaceQuotaDefinitionNotFoundError, IsSpaceQuotaDefinitionNotFoundError, 310007, 404},
+		{"SpaceQuotaInstanceLimitExceeded", NewSpaceQuotaInstanceLimitExceededError, IsSpaceQuotaInstanceLimitExceededError, 310008, 400},
+		{"OrgQuotaTotalReservedRoutePortsExceeded", NewOrgQuotaTotalReservedRoutePortsExceededError, IsOrgQuotaTotalReservedRoutePortsExceededError, 310009, 400},
+		{"SpaceQuotaTotalReservedRoutePortsExceeded", NewSpaceQuotaTotalReservedRoutePortsExceededError, IsSpaceQuotaTotalReservedRoutePortsExceededError, 310010, 400},
+		{"SpaceQuotaLogRateLimitExceeded", NewSpaceQuotaLogRateLimitExceededError, IsSpaceQuotaLogRateLimitExceededError, 310011, 400},
+		{"DiegoDisabled", NewDiegoDisabledError, IsDiegoDisabledError, 320001, 400},
+		{"DiegoDockerBuildpackConflict", NewDiegoDockerBuildpackConflictError, IsDiegoDockerBuildpackConflictError, 320002, 400},
+		{"DockerDisabled", NewDockerDisabledError, IsDockerDisabledError, 320003, 400},
+		{"StagingBackendInvalid", NewStagingBackendInvalidError, IsStagingBackendInvalidError, 320004, 403},
+		{"BackendSelectionNotAuthorized", NewBackendSelectionNotAuthorizedError, IsBackendSelectionNotAuthorizedError, 320005, 403},
+		{"RevisionsEnabled", NewRevisionsEnabledError, IsRevisionsEnabledError, 320006, 400},
+		{"FeatureFlagNotFound", NewFeatureFlagNotFoundError, IsFeatureFlagNotFoundError, 330000, 404},
+		{"FeatureFlagInvalid", NewFeatureFlagInvalidError, IsFeatureFlagInvalidError, 330001, 400},
+		{"FeatureDisabled", NewFeatureDisabledError, IsFeatureDisabledError, 330002, 403},
+		{"UserProvidedServiceInstanceNotFound", NewUserProvidedServiceInstanceNotFoundError, IsUserProvidedServiceInstanceNotFoundError, 340001, 404},
+		{"UserProvidedServiceInstanceHandlerNeeded", NewUserProvidedServiceInstanceHandlerNeededError, IsUserProvidedServiceInstanceHandlerNeededError, 340002, 400},
+		{"ProcessInvalid", NewProcessInvalidError, IsProcessInvalidError, 350001, 400},
+		{"UnableToDelete", NewUnableToDeleteError, IsUnableToDeleteError, 350002, 400},
+		{"ProcessNotFound", NewProcessNotFoundError, IsProcessNotFoundError, 350003, 404},
+		{"ServiceKeyNameTaken", NewServiceKeyNameTakenError, IsServiceKeyNameTakenError, 360001, 400},
+		{"ServiceKeyInvalid", NewServiceKeyInvalidError, IsServiceKeyInvalidError, 360002, 400},
+		{"ServiceKeyNotFound", NewServiceKeyNotFoundError, IsServiceKeyNotFoundError, 360003, 404},
+		{"ServiceKeyNotSupported", NewServiceKeyNotSupportedError, IsServiceKeyNotSupportedError, 360004, 400},
+		{"ServiceKeyCredentialStoreUnavailable", NewServiceKeyCredentialStoreUnavailableError, IsServiceKeyCredentialStoreUnavailableError, 360005, 503},
+		{"RoutingApiUnavailable", NewRoutingApiUnavailableError, IsRoutingApiUnavailableError, 370001, 503},
+		{"RoutingApiDisabled", NewRoutingApiDisabledError, IsRoutingApiDisabledError, 370003, 403},
+		{"EnvironmentVariableGroupInvalid", NewEnvironmentVariableGroupInvalidError, IsEnvironmentVariableGroupInvalidError, 380001, 400},
+		{"DropletUploadInvalid", NewDropletUploadInvalidError, IsDropletUploadInvalidError, 380002, 400},
+		{"ServiceInstanceUnshareFailed", NewServiceInstanceUnshareFailedError, IsServiceInstanceUnshareFailedError, 390001, 502},
+		{"ServiceInstanceDeletionSharesExists", NewServiceInstanceDeletionSharesExistsError, IsServiceInstanceDeletionSharesExistsError, 390002, 422},
+		{"SharedServiceInstanceCannotBeRenamed", NewSharedServiceInstanceCannotBeRenamedError, IsSharedServiceInstanceCannotBeRenamedError, 390003, 422},
+		{"SharedServiceInstanceNotUpdatableInTargetSpace", NewSharedServiceInstanceNotUpdatableInTargetSpaceError, IsSharedServiceInstanceNotUpdatableInTargetSpaceError, 390004, 403},
+		{"SharedServiceInstanceNotDeletableInTargetSpace", NewSharedServiceInstanceNotDeletableInTargetSpaceError, IsSharedServiceInstanceNotDeletableInTargetSpaceError, 390005, 403},
+		{"MaintenanceInfoNotSupported", NewMaintenanceInfoNotSupportedError, IsMaintenanceInfoNotSupportedError, 390006, 422},
+		{"MaintenanceInfoNotSemver", NewMaintenanceInfoNotSemverError, IsMaintenanceInfoNotSemverError, 390007, 422},
+		{"MaintenanceInfoNotUpdatableWhenChangingPlan", NewMaintenanceInfoNotUpdatableWhenChangingPlanError, IsMaintenanceInfoNotUpdatableWhenChangingPlanError, 390008, 422},
+		{"MaintenanceInfoConflict", NewMaintenanceInfoConflictError, IsMaintenanceInfoConflictError, 390009, 422},
+		{"BuildpackStacksDontMatch", NewBuildpackStacksDontMatchError, IsBuildpackStacksDontMatchError, 390011, 422},
+		{"BuildpackStackDoesNotExist", NewBuildpackStackDoesNotExistError, IsBuildpackStackDoesNotExistError, 390012, 422},
+		{"BuildpackZipError", NewBuildpackZipError, IsBuildpackZipError, 390013, 422},
+		{"DeploymentsDisabled", NewDeploymentsDisabledError, IsDeploymentsDisabledError, 390014, 403},
+		{"NoCurrentEncryptionKey", NewNoCurrentEncryptionKeyError, IsNoCurrentEncryptionKeyError, 390015, 422},
+		{"ScaleDisabledDuringDeployment", NewScaleDisabledDuringDeploymentError, IsScaleDisabledDuringDeploymentError, 390016, 422},
+		{"ProcessUpdateDisabledDuringDeployment", NewProcessUpdateDisabledDuringDeploymentError, IsProcessUpdateDisabledDuringDeploymentError, 390017, 422},
+		{"LabelLimitExceeded", NewLabelLimitExceededError, IsLabelLimitExceededError, 390020, 422},
+		{"AnnotationLimitExceeded", NewAnnotationLimitExceededError, IsAnnotationLimitExceededError, 390023, 422},
+		{"StopDisabledDuringDeployment", NewStopDisabledDuringDeploymentError, IsStopDisabledDuringDeploymentError, 390024, 422},
+		{"KubernetesRouteResourceError", NewKubernetesRouteResourceError, IsKubernetesRouteResourceError, 400001, 422},
+		{"KpackImageError", NewKpackImageError, IsKpackImageError, 400002, 422},
+		{"KpackBuilderError", NewKpackBuilderError, IsKpackBuilderError, 400003, 422},
+		{"EiriniLRPError", NewEiriniLRPError, IsEiriniLRPError, 410001, 422},
+	}
+	require.Len(t, knownErrors, len(tests))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.newError()
+			require.Equal(t, tt.code, err.Code)
+			require.Equal(t, "CF-"+tt.name, err.Title)
+			require.Equal(t, tt.httpStatus, err.HTTPStatus())
+			require.True(t, tt.isError(err))
+			require.True(t, tt.isError(fmt.Errorf("wrapped: %w", err)))
+			require.False(t, tt.isError(CloudFoundryError{Code: -1}))
+
+			found, ok := LookupError(tt.code)
+			require.True(t, ok)
+			require.Equal(t, err, found)
+		})
+	}
+}
@@ -1,9 +1,15 @@
 package resource
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"syscall"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsSpaceNotFoundError(t *testing.T) {
@@ -29,3 +35,99 @@ func TestIsSpaceNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFoundryAPIError(t *testing.T) {
+	notFound := CloudFoundryAPIError{
+		StatusCode: http.StatusNotFound,
+		Method:     http.MethodGet,
+		URL:        "https://api.example.org/v3/spaces/guid",
+		RequestID:  "request-id",
+		Errors:     []CloudFoundryError{{Code: 40004, Title: "CF-SpaceNotFound", Detail: "Space not found"}},
+	}
+	wrapped := fmt.Errorf("get space: %w", notFound)
+
+	require.EqualError(t, notFound, "cfclient error (CF-SpaceNotFound|40004): Space not found "+
+		"[GET https://api.example.org/v3/spaces/guid, status 404, request ID request-id]")
+	require.True(t, IsSpaceNotFoundError(wrapped))
+	require.ErrorIs(t, wrapped, ErrNotFound)
+	require.NotErrorIs(t, wrapped, ErrServerError)
+	var httpErr CloudFoundryHTTPError
+	require.ErrorAs(t, wrapped, &httpErr)
+	require.Equal(t, http.StatusNotFound, httpErr.StatusCode)
+
+	tests := []struct {
+		status   int
+		sentinel error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrUnprocessable},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServerError},
+		{http.StatusServiceUnavailable, ErrServerError},
+	}
+	for _, tt := range tests {
+		err := CloudFoundryAPIError{StatusCode: tt.status, Status: http.StatusText(tt.status)}
+		require.ErrorIs(t, err, tt.sentinel, "status %d", tt.status)
+		require.NotErrorIs(t, err, ErrNotFound, "status %d", tt.status)
+	}
+}
+
+func TestCloudFoundryAPIErrorAs(t *testing.T) {
+	err := error(CloudFoundryAPIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Status:     "422 Unprocessable Entity",
+		Errors: []CloudFoundryError{
+			{Code: 10008, Title: "CF-UnprocessableEntity", Detail: "first"},
+			{Code: 10008, Title: "CF-UnprocessableEntity", Detail: "second"},
+		},
+		Body: []byte(`{"errors":[]}`),
+	})
+
+	// the errors.As pattern used with the CloudFoundryError that used to be returned
+	var cfErr CloudFoundryError
+	require.True(t, errors.As(err, &cfErr))
+	require.Equal(t, "first", cfErr.Detail)
+	cfErr = CloudFoundryError{}
+	require.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &cfErr))
+	require.Equal(t, 10008, cfErr.Code)
+
+	var httpErr CloudFoundryHTTPError
+	require.True(t, errors.As(err, &httpErr))
+	require.Equal(t, http.StatusUnprocessableEntity, httpErr.StatusCode)
+	require.Equal(t, []byte(`{"errors":[]}`), httpErr.Body)
+
+	// a response without CF errors in the body only matches the HTTP error
+	err = CloudFoundryAPIError{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"}
+	require.False(t, errors.As(err, &CloudFoundryError{}))
+	require.True(t, errors.As(err, &httpErr))
+	require.Equal(t, http.StatusBadGateway, httpErr.StatusCode)
+
+	// type assertions on the old types no longer match
+	_, ok := err.(CloudFoundryHTTPError)
+	require.False(t, ok)
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"std/errors error", errors.New("is not"), false},
+		{"not found", CloudFoundryAPIError{StatusCode: http.StatusNotFound}, false},
+		{"rate limited", CloudFoundryAPIError{StatusCode: http.StatusTooManyRequests}, true},
+		{"internal server error", CloudFoundryAPIError{StatusCode: http.StatusInternalServerError}, false},
+		{"wrapped service unavailable", fmt.Errorf("%w", CloudFoundryAPIError{StatusCode: http.StatusServiceUnavailable}), true},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"unexpected EOF", io.ErrUnexpectedEOF, true},
+		{"canceled", context.Canceled, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
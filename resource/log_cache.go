@@ -0,0 +1,202 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// EnvelopeType is the type of Loggregator v2 envelope
+type EnvelopeType string
+
+const (
+	EnvelopeTypeLog     EnvelopeType = "LOG"
+	EnvelopeTypeCounter EnvelopeType = "COUNTER"
+	EnvelopeTypeGauge   EnvelopeType = "GAUGE"
+	EnvelopeTypeTimer   EnvelopeType = "TIMER"
+	EnvelopeTypeEvent   EnvelopeType = "EVENT"
+)
+
+// LogType is the output stream a log line was written to
+type LogType string
+
+const (
+	LogTypeOut LogType = "OUT"
+	LogTypeErr LogType = "ERR"
+)
+
+// Envelope is a Loggregator v2 envelope, exactly one of Log, Counter, Gauge, Timer or Event is set
+type Envelope struct {
+	// Timestamp in nanoseconds since the Unix epoch
+	Timestamp int64 `json:"timestamp,string"`
+
+	// The GUID of the app or other source that emitted the envelope
+	SourceID string `json:"source_id"`
+
+	// The app instance index that emitted the envelope
+	InstanceID string `json:"instance_id"`
+
+	Tags map[string]string `json:"tags,omitempty"`
+
+	Log     *EnvelopeLog     `json:"log,omitempty"`
+	Counter *EnvelopeCounter `json:"counter,omitempty"`
+	Gauge   *EnvelopeGauge   `json:"gauge,omitempty"`
+	Timer   *EnvelopeTimer   `json:"timer,omitempty"`
+	Event   *EnvelopeEvent   `json:"event,omitempty"`
+}
+
+// Time returns the envelope timestamp as a time.Time
+func (e *Envelope) Time() time.Time {
+	return time.Unix(0, e.Timestamp)
+}
+
+// Type returns the type of the envelope based on which of its payloads is set
+func (e *Envelope) Type() EnvelopeType {
+	switch {
+	case e.Log != nil:
+		return EnvelopeTypeLog
+	case e.Counter != nil:
+		return EnvelopeTypeCounter
+	case e.Gauge != nil:
+		return EnvelopeTypeGauge
+	case e.Timer != nil:
+		return EnvelopeTypeTimer
+	case e.Event != nil:
+		return EnvelopeTypeEvent
+	}
+	return ""
+}
+
+type EnvelopeLog struct {
+	Payload []byte  `json:"payload"`
+	Type    LogType `json:"type"`
+}
+
+type EnvelopeCounter struct {
+	Name  string `json:"name"`
+	Delta uint64 `json:"delta,string,omitempty"`
+	Total uint64 `json:"total,string,omitempty"`
+}
+
+type EnvelopeGauge struct {
+	Metrics map[string]EnvelopeGaugeValue `json:"metrics"`
+}
+
+type EnvelopeGaugeValue struct {
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+}
+
+type EnvelopeTimer struct {
+	Name string `json:"name"`
+
+	// Start and Stop in nanoseconds since the Unix epoch
+	Start int64 `json:"start,string"`
+	Stop  int64 `json:"stop,string"`
+}
+
+type EnvelopeEvent struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type EnvelopeBatch struct {
+	Batch []*Envelope `json:"batch"`
+}
+
+// LogCacheRead is the response of the log cache read endpoint
+type LogCacheRead struct {
+	Envelopes EnvelopeBatch `json:"envelopes"`
+}
+
+// PromQLResult is the Prometheus compatible response of a log cache PromQL query
+type PromQLResult struct {
+	Status    string     `json:"status"`
+	Data      PromQLData `json:"data"`
+	ErrorType string     `json:"errorType,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// PromQLData holds the query result, the populated field depends on the ResultType
+type PromQLData struct {
+	// ResultType is vector for instant queries, matrix for range queries or scalar
+	ResultType string `json:"resultType"`
+
+	Series []PromQLSeries `json:"-"`
+	Scalar *PromQLSample  `json:"-"`
+}
+
+// PromQLSeries is a single time series identified by its metric labels. Instant queries set Value while
+// range queries set Values.
+type PromQLSeries struct {
+	Metric map[string]string `json:"metric"`
+	Value  *PromQLSample     `json:"value,omitempty"`
+	Values []PromQLSample    `json:"values,omitempty"`
+}
+
+// PromQLSample is a single sample encoded by the API as a [<unix seconds>, "<value>"] tuple
+type PromQLSample struct {
+	Time  time.Time
+	Value float64
+}
+
+func (d *PromQLData) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	d.ResultType = raw.ResultType
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	if raw.ResultType == "scalar" {
+		d.Scalar = &PromQLSample{}
+		return json.Unmarshal(raw.Result, d.Scalar)
+	}
+	return json.Unmarshal(raw.Result, &d.Series)
+}
+
+func (d PromQLData) MarshalJSON() ([]byte, error) {
+	var result any = d.Series
+	if d.ResultType == "scalar" {
+		result = d.Scalar
+	}
+	return json.Marshal(struct {
+		ResultType string `json:"resultType"`
+		Result     any    `json:"result"`
+	}{d.ResultType, result})
+}
+
+func (s *PromQLSample) UnmarshalJSON(b []byte) error {
+	var tuple []json.RawMessage
+	if err := json.Unmarshal(b, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return fmt.Errorf("expected a [time, value] PromQL sample, but got %s", b)
+	}
+	var seconds float64
+	if err := json.Unmarshal(tuple[0], &seconds); err != nil {
+		return fmt.Errorf("invalid PromQL sample time: %w", err)
+	}
+	var value string
+	if err := json.Unmarshal(tuple[1], &value); err != nil {
+		return fmt.Errorf("invalid PromQL sample value: %w", err)
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("invalid PromQL sample value: %w", err)
+	}
+	s.Time = time.Unix(0, int64(seconds*float64(time.Second))).UTC()
+	s.Value = v
+	return nil
+}
+
+func (s PromQLSample) MarshalJSON() ([]byte, error) {
+	seconds := float64(s.Time.UnixNano()) / float64(time.Second)
+	return json.Marshal([]any{seconds, strconv.FormatFloat(s.Value, 'f', -1, 64)})
+}
@@ -4,9 +4,11 @@ type ManifestDiff struct {
 	Diff []ManifestDiffItem `json:"diff"`
 }
 
+// ManifestDiffItem is a JSON patch style change, the values are strings for scalar manifest properties or the
+// decoded JSON for lists and objects like routes
 type ManifestDiffItem struct {
 	Op    string `json:"op"`
 	Path  string `json:"path"`
-	Was   string `json:"was,omitempty"`
-	Value string `json:"value,omitempty"`
+	Was   any    `json:"was,omitempty"`
+	Value any    `json:"value,omitempty"`
 }
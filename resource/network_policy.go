@@ -0,0 +1,62 @@
+package resource
+
+// NetworkPolicyProtocol is the protocol allowed by a container to container network policy
+type NetworkPolicyProtocol string
+
+const (
+	NetworkPolicyProtocolTCP NetworkPolicyProtocol = "tcp"
+	NetworkPolicyProtocolUDP NetworkPolicyProtocol = "udp"
+)
+
+// NetworkPolicy allows traffic from the source app to a port range on the destination app over the
+// container network
+type NetworkPolicy struct {
+	Source      NetworkPolicySource      `json:"source"`
+	Destination NetworkPolicyDestination `json:"destination"`
+}
+
+type NetworkPolicySource struct {
+	// The GUID of the app allowed to send traffic
+	ID string `json:"id"`
+}
+
+type NetworkPolicyDestination struct {
+	// The GUID of the app allowed to receive traffic
+	ID       string                `json:"id"`
+	Protocol NetworkPolicyProtocol `json:"protocol"`
+	Ports    NetworkPolicyPorts    `json:"ports"`
+}
+
+// NetworkPolicyPorts is an inclusive range of ports, a single port has the same start and end
+type NetworkPolicyPorts struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// NetworkPolicyList is the policy server's list of policies
+type NetworkPolicyList struct {
+	TotalPolicies int              `json:"total_policies"`
+	Policies      []*NetworkPolicy `json:"policies"`
+}
+
+// NetworkPolicies is the request body used to create or delete policies
+type NetworkPolicies struct {
+	Policies []*NetworkPolicy `json:"policies"`
+}
+
+// NewNetworkPolicy creates a policy allowing the source app to reach the destination app on the inclusive port range
+func NewNetworkPolicy(sourceAppGUID, destinationAppGUID string, protocol NetworkPolicyProtocol, startPort, endPort int) *NetworkPolicy {
+	return &NetworkPolicy{
+		Source: NetworkPolicySource{
+			ID: sourceAppGUID,
+		},
+		Destination: NetworkPolicyDestination{
+			ID:       destinationAppGUID,
+			Protocol: protocol,
+			Ports: NetworkPolicyPorts{
+				Start: startPort,
+				End:   endPort,
+			},
+		},
+	}
+}
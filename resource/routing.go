@@ -0,0 +1,104 @@
+package resource
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RouterGroupType is the type of traffic routed by a router group
+type RouterGroupType string
+
+const (
+	RouterGroupTypeTCP  RouterGroupType = "tcp"
+	RouterGroupTypeHTTP RouterGroupType = "http"
+)
+
+// RouterGroup is a group of routers sharing the same reservable ports, TCP domains are created for a router group
+type RouterGroup struct {
+	GUID string          `json:"guid"`
+	Name string          `json:"name"`
+	Type RouterGroupType `json:"type"`
+
+	// ReservablePorts is a comma separated list of ports and inclusive port ranges, e.g. 1024-1033,2000
+	ReservablePorts string `json:"reservable_ports"`
+}
+
+type RouterGroupUpdate struct {
+	ReservablePorts string `json:"reservable_ports"`
+}
+
+// PortRange is an inclusive range of ports, a single port has the same start and end
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// Contains returns true if the port is within the range
+func (r PortRange) Contains(port int) bool {
+	return port >= r.Start && port <= r.End
+}
+
+// PortRanges parses the router group's reservable ports
+func (r *RouterGroup) PortRanges() ([]PortRange, error) {
+	return ParsePortRanges(r.ReservablePorts)
+}
+
+// ParsePortRanges parses a comma separated list of ports and inclusive port ranges, e.g. 1024-1033,2000
+func ParsePortRanges(ports string) ([]PortRange, error) {
+	var ranges []PortRange
+	for _, s := range strings.Split(ports, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		start, end, isRange := strings.Cut(s, "-")
+		if !isRange {
+			end = start
+		}
+		startPort, err := parsePort(start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port range %q: %w", s, err)
+		}
+		endPort, err := parsePort(end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port range %q: %w", s, err)
+		}
+		if startPort > endPort {
+			return nil, fmt.Errorf("invalid port range %q: start port is greater than end port", s)
+		}
+		ranges = append(ranges, PortRange{Start: startPort, End: endPort})
+	}
+	return ranges, nil
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("port %d is out of range", port)
+	}
+	return port, nil
+}
+
+// TCPRoute maps a port on the TCP routers in a router group to an app instance backend
+type TCPRoute struct {
+	RouterGroupGUID  string           `json:"router_group_guid"`
+	Port             int              `json:"port"`
+	BackendIP        string           `json:"backend_ip"`
+	BackendPort      int              `json:"backend_port"`
+	BackendTLSPort   int              `json:"backend_tls_port,omitempty"`
+	InstanceID       string           `json:"instance_id,omitempty"`
+	HostTLSPort      int              `json:"host_tls_port,omitempty"`
+	IsolationSegment string           `json:"isolation_segment,omitempty"`
+	TTL              *int             `json:"ttl,omitempty"`
+	ModificationTag  *ModificationTag `json:"modification_tag,omitempty"`
+}
+
+// ModificationTag versions a routing API entry so routers can ignore stale updates
+type ModificationTag struct {
+	GUID  string `json:"guid"`
+	Index int    `json:"index"`
+}
@@ -0,0 +1,28 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+func TestParsePortRanges(t *testing.T) {
+	ranges, err := resource.ParsePortRanges("1024-1033, 2000,3000-3000")
+	require.NoError(t, err)
+	require.Equal(t, []resource.PortRange{{Start: 1024, End: 1033}, {Start: 2000, End: 2000}, {Start: 3000, End: 3000}}, ranges)
+	require.True(t, ranges[0].Contains(1033))
+	require.False(t, ranges[0].Contains(1034))
+
+	ranges, err = resource.ParsePortRanges("")
+	require.NoError(t, err)
+	require.Empty(t, ranges)
+
+	_, err = resource.ParsePortRanges("1033-1024")
+	require.Error(t, err)
+	_, err = resource.ParsePortRanges("1024-abc")
+	require.Error(t, err)
+	_, err = resource.ParsePortRanges("70000")
+	require.Error(t, err)
+}
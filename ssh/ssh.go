@@ -0,0 +1,333 @@
+// Package ssh opens SSH connections to Cloud Foundry app instances through the Diego SSH proxy, the same as
+// cf ssh.
+//
+//	conn, err := ssh.New(cf).Dial(ctx, appGUID, "web", 0)
+//	if err != nil {
+//		return err
+//	}
+//	defer conn.Close()
+//	out, err := conn.Output(ctx, "ls -la")
+package ssh
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gossh "golang.org/x/crypto/ssh"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+)
+
+const (
+	// DefaultProcessType is the process type dialed when none is specified
+	DefaultProcessType = "web"
+
+	// DefaultDialTimeout is the maximum time to wait for the SSH connection to be established
+	DefaultDialTimeout = 30 * time.Second
+)
+
+// Client dials app instances using the SSH endpoint and host key fingerprint discovered from the API root
+type Client struct {
+	cf                 *client.Client
+	endpoint           string
+	hostKeyFingerprint string
+	dialTimeout        time.Duration
+}
+
+// Option configures the SSH client
+type Option func(*Client)
+
+// WithEndpoint overrides the host:port of the SSH proxy discovered from the API root
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithHostKeyFingerprint overrides the SSH proxy host key fingerprint discovered from the API root. The
+// fingerprint may be an unpadded base64 SHA256, or a colon separated hex SHA1 or MD5, fingerprint.
+func WithHostKeyFingerprint(fingerprint string) Option {
+	return func(c *Client) {
+		c.hostKeyFingerprint = fingerprint
+	}
+}
+
+// WithDialTimeout sets the maximum time to wait for the SSH connection to be established, zero or less waits until
+// the dial context is done
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.dialTimeout = timeout
+	}
+}
+
+// New creates a new SSH client that authenticates using the CF client
+func New(cf *client.Client, opts ...Option) *Client {
+	c := &Client{
+		cf:          cf,
+		dialTimeout: DefaultDialTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Dial opens an SSH connection to the specified app process instance. An empty process type dials the web process.
+//
+// The connection authenticates as cf:<process-guid>/<index> using a one time SSH code and the SSH proxy host key
+// is verified against the fingerprint published by the API root.
+func (c *Client) Dial(ctx context.Context, appGUID, processType string, index int) (*Conn, error) {
+	if processType == "" {
+		processType = DefaultProcessType
+	}
+	opts := client.NewProcessOptions()
+	opts.Types.EqualTo(processType)
+	process, err := c.cf.Processes.SingleForApp(ctx, appGUID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error finding the %s process of app %s: %w", processType, appGUID, err)
+	}
+
+	endpoint, fingerprint := c.endpoint, c.hostKeyFingerprint
+	if endpoint == "" || fingerprint == "" {
+		root, err := c.cf.Root.Get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error discovering the SSH endpoint: %w", err)
+		}
+		if endpoint == "" {
+			endpoint = root.Links.AppSSH.Href
+		}
+		if fingerprint == "" {
+			fingerprint = root.Links.AppSSH.Meta.HostKeyFingerprint
+		}
+	}
+	if endpoint == "" {
+		return nil, errors.New("the Cloud Foundry API root doesn't link to an SSH endpoint")
+	}
+	if fingerprint == "" {
+		return nil, errors.New("the SSH proxy host key fingerprint is unknown")
+	}
+
+	code, err := c.cf.SSHCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &gossh.ClientConfig{
+		User:            "cf:" + process.GUID + "/" + strconv.Itoa(index),
+		Auth:            []gossh.AuthMethod{gossh.Password(code)},
+		HostKeyCallback: hostKeyCallback(fingerprint),
+		Timeout:         c.dialTimeout,
+	}
+	sshClient, err := dial(ctx, endpoint, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to %s instance %d of app %s: %w", processType, index, appGUID, err)
+	}
+	return &Conn{client: sshClient}, nil
+}
+
+// dial connects and performs the SSH handshake, aborting if the context is done
+func dial(ctx context.Context, endpoint string, cfg *gossh.ClientConfig) (*gossh.Client, error) {
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	var d net.Dialer
+	netConn, err := d.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = netConn.Close()
+	})
+	sshConn, chans, reqs, err := gossh.NewClientConn(netConn, endpoint, cfg)
+	if !stop() || err != nil {
+		_ = netConn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return gossh.NewClient(sshConn, chans, reqs), nil
+}
+
+// hostKeyCallback verifies the host key matches the fingerprint, the fingerprint format is determined by its length
+func hostKeyCallback(fingerprint string) gossh.HostKeyCallback {
+	return func(_ string, _ net.Addr, key gossh.PublicKey) error {
+		var actual string
+		switch len(fingerprint) {
+		case base64.RawStdEncoding.EncodedLen(sha256.Size):
+			sum := sha256.Sum256(key.Marshal())
+			actual = base64.RawStdEncoding.EncodeToString(sum[:])
+		case sha1.Size*3 - 1:
+			sum := sha1.Sum(key.Marshal())
+			actual = colonHex(sum[:])
+		case md5.Size*3 - 1:
+			sum := md5.Sum(key.Marshal())
+			actual = colonHex(sum[:])
+		default:
+			return fmt.Errorf("unsupported SSH host key fingerprint format: %s", fingerprint)
+		}
+		if !strings.EqualFold(actual, fingerprint) {
+			return fmt.Errorf("SSH host key fingerprint mismatch, expected %s but got %s", fingerprint, actual)
+		}
+		return nil
+	}
+}
+
+func colonHex(b []byte) string {
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02x", v)
+	}
+	return strings.Join(parts, ":")
+}
+
+// Conn is an SSH connection to a single app instance
+type Conn struct {
+	client *gossh.Client
+}
+
+// Terminal requests a pseudo terminal for an interactive shell
+type Terminal struct {
+	// Term is the terminal type, e.g. xterm
+	Term   string
+	Width  int
+	Height int
+}
+
+// Client returns the underlying SSH client
+func (c *Conn) Client() *gossh.Client {
+	return c.client
+}
+
+// Close the connection, any port forwarding listeners must be closed separately
+func (c *Conn) Close() error {
+	return c.client.Close()
+}
+
+// Run the command on the app instance writing its output to stdout and stderr. If the command exits with a
+// non-zero status a *ssh.ExitError from golang.org/x/crypto/ssh is returned.
+func (c *Conn) Run(ctx context.Context, command string, stdout, stderr io.Writer) error {
+	session, err := c.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("error creating SSH session: %w", err)
+	}
+	defer session.Close()
+	session.Stdout = stdout
+	session.Stderr = stderr
+	return wait(ctx, session, func() error {
+		return session.Run(command)
+	})
+}
+
+// Output runs the command on the app instance and returns its standard output
+func (c *Conn) Output(ctx context.Context, command string) ([]byte, error) {
+	var stdout strings.Builder
+	err := c.Run(ctx, command, &stdout, io.Discard)
+	return []byte(stdout.String()), err
+}
+
+// Shell starts an interactive login shell on the app instance connected to stdin, stdout and stderr, blocking
+// until the shell exits. A pseudo terminal is allocated if term is not nil.
+func (c *Conn) Shell(ctx context.Context, term *Terminal, stdin io.Reader, stdout, stderr io.Writer) error {
+	session, err := c.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("error creating SSH session: %w", err)
+	}
+	defer session.Close()
+	session.Stdin = stdin
+	session.Stdout = stdout
+	session.Stderr = stderr
+	if term != nil {
+		modes := gossh.TerminalModes{
+			gossh.ECHO:          1,
+			gossh.TTY_OP_ISPEED: 14400,
+			gossh.TTY_OP_OSPEED: 14400,
+		}
+		if err := session.RequestPty(term.Term, term.Height, term.Width, modes); err != nil {
+			return fmt.Errorf("error requesting pseudo terminal: %w", err)
+		}
+	}
+	if err := session.Shell(); err != nil {
+		return fmt.Errorf("error starting shell: %w", err)
+	}
+	return wait(ctx, session, session.Wait)
+}
+
+// ForwardLocal listens on the local address and forwards each connection to the remote address as seen from
+// the app instance, like cf ssh -L. Forwarding stops when the context is done or the returned listener is closed.
+func (c *Conn) ForwardLocal(ctx context.Context, localAddr, remoteAddr string) (net.Listener, error) {
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", localAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on %s: %w", localAddr, err)
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = listener.Close()
+	})
+	go func() {
+		defer stop()
+		for {
+			local, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go c.forward(local, remoteAddr)
+		}
+	}()
+	return listener, nil
+}
+
+func (c *Conn) forward(local net.Conn, remoteAddr string) {
+	defer local.Close()
+	remote, err := c.client.Dial("tcp", remoteAddr)
+	if err != nil {
+		return
+	}
+	defer remote.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	pipe := func(dst, src net.Conn) {
+		defer wg.Done()
+		_, _ = io.Copy(dst, src)
+		// signal EOF to the other side while allowing the rest of the response through
+		if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+			_ = cw.CloseWrite()
+		} else {
+			_ = dst.Close()
+		}
+	}
+	go pipe(remote, local)
+	go pipe(local, remote)
+	wg.Wait()
+}
+
+// wait runs fn, closing the session if the context is done first
+func wait(ctx context.Context, session *gossh.Session, fn func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		_ = session.Signal(gossh.SIGKILL)
+		_ = session.Close()
+		return ctx.Err()
+	}
+}
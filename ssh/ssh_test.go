@@ -0,0 +1,324 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestSSH(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(1)
+	appGUID := testutil.RandomGUID()
+	process := g.Process().JSON
+	var p struct {
+		GUID string `json:"guid"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(process), &p))
+
+	// each dial looks up the process
+	var processes []string
+	for i := 0; i < 6; i++ {
+		processes = append(processes, g.Paged([]string{process})...)
+	}
+	serverURL := testutil.Setup(testutil.MockRoute{
+		Method:      "GET",
+		Endpoint:    "/v3/apps/" + appGUID + "/processes",
+		Output:      processes,
+		Status:      http.StatusOK,
+		QueryString: "page=1&per_page=50&types=web",
+	}, t)
+	defer testutil.Teardown()
+
+	cfg, err := config.New(serverURL, config.Token("", "fake-refresh-token"))
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+
+	server := newTestSSHServer(t, "cf:"+p.GUID+"/1")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("Run command", func(t *testing.T) {
+		conn, err := New(cf, WithEndpoint(server.addr), WithHostKeyFingerprint(server.sha256)).Dial(ctx, appGUID, "", 1)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		out, err := conn.Output(ctx, "echo hello")
+		require.NoError(t, err)
+		require.Equal(t, "ran echo hello\n", string(out))
+
+		var stdout, stderr bytes.Buffer
+		err = conn.Run(ctx, "exit 3", &stdout, &stderr)
+		var exitErr *gossh.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		require.Equal(t, 3, exitErr.ExitStatus())
+		require.Equal(t, "exiting\n", stderr.String())
+	})
+
+	t.Run("Shell", func(t *testing.T) {
+		conn, err := New(cf, WithEndpoint(server.addr), WithHostKeyFingerprint(server.sha1)).Dial(ctx, appGUID, "web", 1)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		var stdout bytes.Buffer
+		term := &Terminal{Term: "xterm", Width: 80, Height: 24}
+		err = conn.Shell(ctx, term, strings.NewReader("ls\npwd\n"), &stdout, io.Discard)
+		require.NoError(t, err)
+		require.Equal(t, "xterm 80x24\nls\npwd\n", stdout.String())
+	})
+
+	t.Run("Forward local port", func(t *testing.T) {
+		conn, err := New(cf, WithEndpoint(server.addr), WithHostKeyFingerprint(server.md5)).Dial(ctx, appGUID, "web", 1)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		remote := newEchoServer(t)
+		listener, err := conn.ForwardLocal(ctx, "127.0.0.1:0", remote)
+		require.NoError(t, err)
+		defer listener.Close()
+
+		local, err := net.Dial("tcp", listener.Addr().String())
+		require.NoError(t, err)
+		defer local.Close()
+		_, err = local.Write([]byte("ping"))
+		require.NoError(t, err)
+		require.NoError(t, local.(*net.TCPConn).CloseWrite())
+		reply, err := io.ReadAll(local)
+		require.NoError(t, err)
+		require.Equal(t, "ping", string(reply))
+	})
+
+	t.Run("No dial timeout", func(t *testing.T) {
+		conn, err := New(cf, WithEndpoint(server.addr), WithHostKeyFingerprint(server.sha256), WithDialTimeout(0)).
+			Dial(ctx, appGUID, "web", 1)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		out, err := conn.Output(ctx, "echo hello")
+		require.NoError(t, err)
+		require.Equal(t, "ran echo hello\n", string(out))
+	})
+
+	t.Run("Wrong instance", func(t *testing.T) {
+		_, err := New(cf, WithEndpoint(server.addr), WithHostKeyFingerprint(server.sha256)).Dial(ctx, appGUID, "web", 0)
+		require.ErrorContains(t, err, "unable to authenticate")
+	})
+
+	t.Run("Host key mismatch", func(t *testing.T) {
+		// the fingerprint published by the API root doesn't match the test server's host key
+		_, err := New(cf, WithEndpoint(server.addr)).Dial(ctx, appGUID, "web", 1)
+		require.ErrorContains(t, err, "SSH host key fingerprint mismatch")
+	})
+}
+
+func TestHostKeyCallback(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := gossh.NewPublicKey(pub)
+	require.NoError(t, err)
+
+	sha256Sum := sha256.Sum256(key.Marshal())
+	sha256Fingerprint := base64.RawStdEncoding.EncodeToString(sha256Sum[:])
+	require.Equal(t, "SHA256:"+sha256Fingerprint, gossh.FingerprintSHA256(key))
+	require.NoError(t, hostKeyCallback(sha256Fingerprint)("", nil, key))
+
+	md5Fingerprint := gossh.FingerprintLegacyMD5(key)
+	require.NoError(t, hostKeyCallback(md5Fingerprint)("", nil, key))
+	require.NoError(t, hostKeyCallback(strings.ToUpper(md5Fingerprint))("", nil, key))
+
+	sha1Sum := sha1.Sum(key.Marshal())
+	require.NoError(t, hostKeyCallback(colonHex(sha1Sum[:]))("", nil, key))
+
+	require.ErrorContains(t, hostKeyCallback("Y411oivJwZCUQnXHq83mdM5SKCK4ftyoSXI31RRe4Zs")("", nil, key),
+		"SSH host key fingerprint mismatch")
+	require.ErrorContains(t, hostKeyCallback("abc")("", nil, key), "unsupported SSH host key fingerprint format")
+}
+
+type testSSHServer struct {
+	addr   string
+	sha256 string
+	sha1   string
+	md5    string
+}
+
+// newTestSSHServer starts an SSH server that accepts the user with the fake SSH code, runs "commands" by echoing
+// them back, echoes shell input and supports direct-tcpip port forwarding
+func newTestSSHServer(t *testing.T, user string) *testSSHServer {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := gossh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+
+	cfg := &gossh.ServerConfig{
+		PasswordCallback: func(conn gossh.ConnMetadata, password []byte) (*gossh.Permissions, error) {
+			if conn.User() == user && string(password) == testutil.SSHCode {
+				return nil, nil
+			}
+			return nil, errors.New("access denied")
+		},
+	}
+	cfg.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSHConn(conn, cfg)
+		}
+	}()
+
+	key := signer.PublicKey()
+	sha256Sum := sha256.Sum256(key.Marshal())
+	sha1Sum := sha1.Sum(key.Marshal())
+	md5Sum := md5.Sum(key.Marshal())
+	return &testSSHServer{
+		addr:   listener.Addr().String(),
+		sha256: base64.RawStdEncoding.EncodeToString(sha256Sum[:]),
+		sha1:   colonHex(sha1Sum[:]),
+		md5:    colonHex(md5Sum[:]),
+	}
+}
+
+func serveSSHConn(conn net.Conn, cfg *gossh.ServerConfig) {
+	defer conn.Close()
+	_, chans, reqs, err := gossh.NewServerConn(conn, cfg)
+	if err != nil {
+		return
+	}
+	go gossh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		switch newChannel.ChannelType() {
+		case "session":
+			ch, requests, err := newChannel.Accept()
+			if err != nil {
+				return
+			}
+			go serveSession(ch, requests)
+		case "direct-tcpip":
+			var target struct {
+				Host       string
+				Port       uint32
+				OriginHost string
+				OriginPort uint32
+			}
+			if err := gossh.Unmarshal(newChannel.ExtraData(), &target); err != nil {
+				_ = newChannel.Reject(gossh.ConnectionFailed, err.Error())
+				continue
+			}
+			remote, err := net.Dial("tcp", net.JoinHostPort(target.Host, fmt.Sprint(target.Port)))
+			if err != nil {
+				_ = newChannel.Reject(gossh.ConnectionFailed, err.Error())
+				continue
+			}
+			ch, requests, err := newChannel.Accept()
+			if err != nil {
+				_ = remote.Close()
+				return
+			}
+			go gossh.DiscardRequests(requests)
+			go func() {
+				defer ch.Close()
+				defer remote.Close()
+				go func() {
+					_, _ = io.Copy(remote, ch)
+					_ = remote.(*net.TCPConn).CloseWrite()
+				}()
+				_, _ = io.Copy(ch, remote)
+			}()
+		default:
+			_ = newChannel.Reject(gossh.UnknownChannelType, "unsupported channel type")
+		}
+	}
+}
+
+func serveSession(ch gossh.Channel, requests <-chan *gossh.Request) {
+	defer ch.Close()
+	exit := func(status uint32) {
+		_, _ = ch.SendRequest("exit-status", false, gossh.Marshal(struct{ Status uint32 }{status}))
+	}
+	var pty string
+	for req := range requests {
+		switch req.Type {
+		case "pty-req":
+			var r struct {
+				Term          string
+				Columns, Rows uint32
+				Width, Height uint32
+				Modes         string
+			}
+			_ = gossh.Unmarshal(req.Payload, &r)
+			pty = fmt.Sprintf("%s %dx%d\n", r.Term, r.Columns, r.Rows)
+			_ = req.Reply(true, nil)
+		case "exec":
+			var r struct{ Command string }
+			_ = gossh.Unmarshal(req.Payload, &r)
+			_ = req.Reply(true, nil)
+			if r.Command == "exit 3" {
+				_, _ = io.WriteString(ch.Stderr(), "exiting\n")
+				exit(3)
+				return
+			}
+			_, _ = io.WriteString(ch, "ran "+r.Command+"\n")
+			exit(0)
+			return
+		case "shell":
+			_ = req.Reply(true, nil)
+			_, _ = io.WriteString(ch, pty)
+			_, _ = io.Copy(ch, ch)
+			exit(0)
+			return
+		default:
+			_ = req.Reply(false, nil)
+		}
+	}
+}
+
+// newEchoServer starts a TCP server that echoes everything it receives
+func newEchoServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
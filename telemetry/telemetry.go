@@ -0,0 +1,164 @@
+// Package telemetry instruments the Cloud Foundry client with OpenTelemetry tracing and metrics.
+//
+// A span is started for each Cloud Controller API call made by a sub-client method and named after
+// it, e.g. Applications.Start. The W3C trace context is propagated to the Cloud Controller via the
+// request headers and the call latency and errors are recorded as metrics.
+//
+//	cfg, err := config.New(apiURL, config.ClientCredentials(id, secret), telemetry.Instrument())
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+const (
+	// ScopeName is the instrumentation scope name of the tracer and meter
+	ScopeName = "github.com/cloudfoundry-community/go-cfclient/v3/telemetry"
+
+	// Cloud Foundry specific span and metric attributes
+	OperationKey     = attribute.Key("cf.operation")
+	ResourcePathKey  = attribute.Key("cf.resource_path")
+	ErrorCodeKey     = attribute.Key("cf.error.code")
+	ErrorTitleKey    = attribute.Key("cf.error.title")
+	JobGUIDKey       = attribute.Key("cf.job.guid")
+	durationMetric   = "cfclient.request.duration"
+	errorCountMetric = "cfclient.request.errors"
+)
+
+// Option configures the instrumentation
+type Option func(*options)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans, defaults to the global TracerProvider
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to record metrics, defaults to the global MeterProvider
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace context into requests, defaults to the
+// global TextMapPropagator
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+// Instrument returns a config option that adds the telemetry interceptor to the client
+func Instrument(opts ...Option) config.Option {
+	return func(c *config.Config) error {
+		interceptor, err := NewInterceptor(opts...)
+		if err != nil {
+			return err
+		}
+		return config.Middleware(interceptor)(c)
+	}
+}
+
+// NewInterceptor creates an interceptor that traces each API call and records its latency and errors
+func NewInterceptor(opts ...Option) (config.Interceptor, error) {
+	o := &options{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	tracer := o.tracerProvider.Tracer(ScopeName)
+	meter := o.meterProvider.Meter(ScopeName)
+	duration, err := meter.Float64Histogram(durationMetric,
+		metric.WithDescription("Duration of Cloud Controller API calls"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s histogram: %w", durationMetric, err)
+	}
+	errorCount, err := meter.Int64Counter(errorCountMetric,
+		metric.WithDescription("Number of failed Cloud Controller API calls"),
+		metric.WithUnit("{error}"))
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s counter: %w", errorCountMetric, err)
+	}
+
+	return func(ctx context.Context, call *config.Call, next config.Invoker) error {
+		ctx, span := tracer.Start(ctx, spanName(call),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				OperationKey.String(call.Operation),
+				ResourcePathKey.String(call.ResourcePath),
+				semconv.HTTPRequestMethodKey.String(call.Method),
+				semconv.URLFull(call.Request.URL.String()),
+			))
+		defer span.End()
+
+		call.Request = call.Request.WithContext(ctx)
+		o.propagator.Inject(ctx, propagation.HeaderCarrier(call.Request.Header))
+
+		start := time.Now()
+		err := next(ctx, call)
+		elapsed := time.Since(start)
+
+		attrs := []attribute.KeyValue{
+			OperationKey.String(call.Operation),
+			semconv.HTTPRequestMethodKey.String(call.Method),
+		}
+		if call.Response != nil {
+			status := semconv.HTTPResponseStatusCode(call.Response.StatusCode)
+			span.SetAttributes(status)
+			attrs = append(attrs, status)
+		}
+		if call.JobGUID != "" {
+			span.SetAttributes(JobGUIDKey.String(call.JobGUID))
+		}
+		if err != nil {
+			var cfErr resource.CloudFoundryError
+			if errors.As(err, &cfErr) {
+				span.SetAttributes(ErrorCodeKey.Int(cfErr.Code), ErrorTitleKey.String(cfErr.Title))
+				attrs = append(attrs, ErrorCodeKey.Int(cfErr.Code))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			errorCount.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+		return err
+	}, nil
+}
+
+// spanName returns the sub-client method name or, for calls not made by one, the method and path
+func spanName(call *config.Call) string {
+	if call.Operation != "" {
+		return call.Operation
+	}
+	if call.Request != nil {
+		return call.Method + " " + call.Request.URL.Path
+	}
+	return call.Method
+}
@@ -0,0 +1,141 @@
+package telemetry_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/telemetry"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestInstrument(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(1)
+	app := g.Application()
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:   "POST",
+			Endpoint: "/v3/apps/" + app.GUID + "/actions/start",
+			Output:   g.Single(app.JSON),
+			Status:   http.StatusOK,
+		},
+		{
+			Method:           "DELETE",
+			Endpoint:         "/v3/apps/" + app.GUID,
+			Status:           http.StatusAccepted,
+			RedirectLocation: "https://api.example.org/api/v3/jobs/c33a5caf-77e0-4d6e-b587-5555d339bc9a",
+		},
+		{
+			Method:   "GET",
+			Endpoint: "/v3/apps/not-found",
+			Output:   []string{`{"errors":[{"detail":"App not found","title":"CF-ResourceNotFound","code":10010}]}`},
+			Status:   http.StatusNotFound,
+		},
+	}, t)
+	defer testutil.Teardown()
+
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	var traceParents []string
+	captureHeaders := func(ctx context.Context, call *config.Call, next config.Invoker) error {
+		traceParents = append(traceParents, call.Request.Header.Get("traceparent"))
+		return next(ctx, call)
+	}
+
+	cfg, err := config.New(serverURL,
+		config.Token("", "fake-refresh-token"),
+		telemetry.Instrument(
+			telemetry.WithTracerProvider(tp),
+			telemetry.WithMeterProvider(mp),
+			telemetry.WithPropagator(propagation.TraceContext{})),
+		config.Middleware(captureHeaders))
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, err = cf.Applications.Start(ctx, app.GUID)
+	require.NoError(t, err)
+	_, err = cf.Applications.Delete(ctx, app.GUID)
+	require.NoError(t, err)
+	_, err = cf.Applications.Get(ctx, "not-found")
+	require.Error(t, err)
+	parent.End()
+
+	ended := spans.Ended()
+	require.Len(t, ended, 4)
+	start, del, get := ended[0], ended[1], ended[2]
+
+	require.Equal(t, "Applications.Start", start.Name())
+	require.Equal(t, trace.SpanKindClient, start.SpanKind())
+	require.Equal(t, parent.SpanContext().SpanID(), start.Parent().SpanID())
+	requireAttribute(t, start.Attributes(), "http.request.method", attribute.StringValue("POST"))
+	requireAttribute(t, start.Attributes(), "http.response.status_code", attribute.IntValue(200))
+	requireAttribute(t, start.Attributes(), "cf.resource_path", attribute.StringValue("/v3/apps/"+app.GUID+"/actions/start"))
+
+	require.Equal(t, "Applications.Delete", del.Name())
+	requireAttribute(t, del.Attributes(), "cf.job.guid", attribute.StringValue("c33a5caf-77e0-4d6e-b587-5555d339bc9a"))
+	requireAttribute(t, del.Attributes(), "http.response.status_code", attribute.IntValue(202))
+
+	require.Equal(t, "Applications.Get", get.Name())
+	require.Equal(t, codes.Error, get.Status().Code)
+	requireAttribute(t, get.Attributes(), "http.response.status_code", attribute.IntValue(404))
+	requireAttribute(t, get.Attributes(), "cf.error.code", attribute.IntValue(10010))
+	requireAttribute(t, get.Attributes(), "cf.error.title", attribute.StringValue("CF-ResourceNotFound"))
+
+	// the trace context of each call's span is propagated to the server
+	require.Len(t, traceParents, 3)
+	for i, tp := range traceParents {
+		sc := ended[i].SpanContext()
+		require.Equal(t, "00-"+sc.TraceID().String()+"-"+sc.SpanID().String()+"-01", tp)
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Equal(t, telemetry.ScopeName, rm.ScopeMetrics[0].Scope.Name)
+	metrics := map[string]metricdata.Metrics{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+
+	durations := metrics["cfclient.request.duration"].Data.(metricdata.Histogram[float64])
+	require.Len(t, durations.DataPoints, 3)
+	var calls uint64
+	for _, dp := range durations.DataPoints {
+		calls += dp.Count
+	}
+	require.EqualValues(t, 3, calls)
+
+	errorCounts := metrics["cfclient.request.errors"].Data.(metricdata.Sum[int64])
+	require.Len(t, errorCounts.DataPoints, 1)
+	require.EqualValues(t, 1, errorCounts.DataPoints[0].Value)
+	op, _ := errorCounts.DataPoints[0].Attributes.Value("cf.operation")
+	require.Equal(t, "Applications.Get", op.AsString())
+}
+
+func requireAttribute(t *testing.T, attrs []attribute.KeyValue, key attribute.Key, expected attribute.Value) {
+	t.Helper()
+	for _, kv := range attrs {
+		if kv.Key == key {
+			require.Equal(t, expected, kv.Value, "attribute %s", key)
+			return
+		}
+	}
+	require.Failf(t, "missing attribute", "span attribute %s not found", key)
+}
@@ -15,6 +15,9 @@ import (
 	"github.com/martini-contrib/render"
 )
 
+// SSHCode is the one time SSH code returned by the fake UAA server
+const SSHCode = "abc123"
+
 var (
 	mux           *http.ServeMux
 	server        *httptest.Server
@@ -58,6 +61,10 @@ func SetupFakeUAAServer(expiresIn int) string {
 		})
 		count = count + 1
 	})
+	r.Get("/oauth/authorize", func(res http.ResponseWriter) {
+		res.Header().Set("Location", fakeUAAServer.URL+"/login?code="+SSHCode)
+		res.WriteHeader(http.StatusFound)
+	})
 	r.NotFound(func() string { return "" })
 	m.Action(r.Handle)
 	uaaMux.Handle("/", m)
@@ -169,6 +176,19 @@ func SetupMultiple(mockEndpoints []MockRoute, t *testing.T) string {
 				count++
 				return status, singleOutput
 			})
+		case "POST-FILE":
+			count := 0
+			r.Post(endpoint, func(res http.ResponseWriter, req *http.Request) (int, string) {
+				testUserAgent(req.Header.Get("User-Agent"), userAgent, t)
+				testBodyContains(req, postFormBody, t)
+				if redirectLocation != "" {
+					res.Header().Add("Location", redirectLocation)
+				}
+				singleOutput := output[count]
+				status = statuses[count]
+				count++
+				return status, singleOutput
+			})
 		case "PUT-FILE":
 			count := 0
 			r.Put(endpoint, func(res http.ResponseWriter, req *http.Request) (int, string) {
@@ -185,6 +205,10 @@ func SetupMultiple(mockEndpoints []MockRoute, t *testing.T) string {
 		}
 	}
 	r.Get("/", func(r render.Render) {
+		networkPolicyV1 := "https://api.example.org/networking/v1/external"
+		if fakePolicyServer != nil {
+			networkPolicyV1 = fakePolicyServer.URL + PolicyServerPath
+		}
 		r.JSON(200, map[string]any{
 			"links": map[string]any{
 				"cloud_controller_v2": map[string]any{
@@ -203,7 +227,7 @@ func SetupMultiple(mockEndpoints []MockRoute, t *testing.T) string {
 					"href": "https://api.example.org/networking/v0/external",
 				},
 				"network_policy_v1": map[string]any{
-					"href": "https://api.example.org/networking/v1/external",
+					"href": networkPolicyV1,
 				},
 				"uaa": map[string]any{
 					"href": fakeUAAServer.URL,
@@ -215,16 +239,16 @@ func SetupMultiple(mockEndpoints []MockRoute, t *testing.T) string {
 					"href": "",
 				},
 				"routing": map[string]any{
-					"href": "https://api.example.org/routing",
+					"href": server.URL + "/routing",
 				},
 				"logging": map[string]any{
 					"href": "wss://doppler.example.org:443",
 				},
 				"log_cache": map[string]any{
-					"href": "https://log-cache.example.org",
+					"href": server.URL,
 				},
 				"log_stream": map[string]any{
-					"href": "https://log-stream.example.org",
+					"href": server.URL,
 				},
 				"app_ssh": map[string]any{
 					"href": "ssh.example.org:2222",
@@ -252,6 +276,10 @@ func Teardown() {
 		fakeUAAServer.Close()
 		fakeUAAServer = nil
 	}
+	if fakePolicyServer != nil {
+		fakePolicyServer.Close()
+		fakePolicyServer = nil
+	}
 }
 
 func testQueryString(QueryString string, QueryStringExp string, t *testing.T) {
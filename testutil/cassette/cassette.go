@@ -0,0 +1,125 @@
+// Package cassette records HTTP interactions with a real Cloud Controller and UAA to a file and replays them, so
+// integration tests can run deterministically without a foundation.
+//
+// A Recorder is a http.RoundTripper that's plugged into the client with config.HttpClient:
+//
+//	rec, err := cassette.New("testdata/push.json", cassette.WithMode(cassette.ModeRecord))
+//	defer rec.Stop()
+//	cfg, err := config.New(apiURL, config.ClientCredentials("cf", "secret"), config.HttpClient(rec.HTTPClient()))
+//	cf, err := client.New(cfg)
+//
+// When recording, the authorization headers, cookies, OAuth tokens, passwords, credentials and CredHub credential
+// values are scrubbed before the cassette is saved, the same values that are redacted from the client's trace logs.
+// In replay mode, the default, no network connections are made and each request is answered with the first unused
+// recorded interaction with the same method and URL.
+package cassette
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+// Mode is whether a Recorder records or replays interactions
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette without making any network connections
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the real server and saves the interactions to the cassette on Stop
+	ModeRecord
+
+	// ModeReplayOrRecord replays the cassette if it exists, otherwise it records a new one
+	ModeReplayOrRecord
+)
+
+// ErrInteractionNotFound is returned in replay mode when no unused recorded interaction matches a request
+var ErrInteractionNotFound = errors.New("cassette: no recorded interaction matches request")
+
+// Cassette is the file format of the recorded interactions
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and the response to it
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a recorded request or response body, it's saved as a string if it's valid UTF-8 so the cassette is easy
+// to read and diff, otherwise it's saved base64 encoded
+type Body []byte
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// Load reads a cassette file
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("error parsing cassette %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette file, creating any missing parent directories
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0600)
+}
@@ -0,0 +1,175 @@
+package cassette_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil/cassette"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil/cffake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "org.json")
+	ctx := context.Background()
+
+	// record against a fake CC
+	fake := cffake.New()
+	apiURL := fake.URL
+	rec, err := cassette.New(path, cassette.WithMode(cassette.ModeRecord))
+	require.NoError(t, err)
+	cfg, err := fake.Config(config.HttpClient(rec.HTTPClient()))
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+
+	org, err := cf.Organizations.Create(ctx, resource.NewOrganizationCreate("my-org"))
+	require.NoError(t, err)
+	upsi := resource.NewServiceInstanceCreateUserProvided("my-upsi", "missing-space-guid")
+	credentials := json.RawMessage(`{"password":"secret"}`)
+	upsi.Credentials = &credentials
+	_, err = cf.ServiceInstances.CreateUserProvided(ctx, upsi)
+	require.True(t, resource.IsUnprocessableEntityError(err))
+	require.NoError(t, rec.Stop())
+	fake.Close()
+
+	// nothing secret was saved
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"fake-refresh-token", "fake-access-token", `\"secret\"`} {
+		require.NotContains(t, string(data), secret)
+	}
+	require.Contains(t, string(data), "Bearer [PRIVATE DATA HIDDEN]")
+
+	// replay with the fake CC stopped
+	replay, err := cassette.New(path)
+	require.NoError(t, err)
+	require.Equal(t, cassette.ModeReplay, replay.Mode())
+	cfg, err = config.New(apiURL, config.Token("", "fake-refresh-token"), config.HttpClient(replay.HTTPClient()))
+	require.NoError(t, err)
+	cf, err = client.New(cfg)
+	require.NoError(t, err)
+
+	got, err := cf.Organizations.Create(ctx, resource.NewOrganizationCreate("my-org"))
+	require.NoError(t, err)
+	require.Equal(t, org.GUID, got.GUID)
+	_, err = cf.ServiceInstances.CreateUserProvided(ctx, upsi)
+	require.True(t, resource.IsUnprocessableEntityError(err))
+
+	// every interaction has been used
+	_, err = cf.Organizations.Get(ctx, org.GUID)
+	require.ErrorIs(t, err, cassette.ErrInteractionNotFound)
+}
+
+func TestRecordCredHub(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"name":"/c/tls","type":"certificate","value":{"ca":"ca-pem","certificate":"cert-pem","private_key":"key-pem"}}]}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "credhub.json")
+	rec, err := cassette.New(path, cassette.WithMode(cassette.ModeRecord))
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/v1/data",
+		strings.NewReader(`{"name":"/c/db","type":"value","value":"s3cr3t"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := rec.HTTPClient().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, rec.Stop())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"s3cr3t", "ca-pem", "cert-pem", "key-pem"} {
+		require.NotContains(t, string(data), secret)
+	}
+	require.Contains(t, string(data), "/c/tls")
+}
+
+func TestRecordDeployment(t *testing.T) {
+	deployment := `{"guid":"1234","status":{"value":"FINALIZED","reason":"DEPLOYED"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(deployment))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "deployment.json")
+	rec, err := cassette.New(path, cassette.WithMode(cassette.ModeRecord))
+	require.NoError(t, err)
+	resp, err := rec.HTTPClient().Get(server.URL + "/v3/deployments/1234")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, rec.Stop())
+
+	replay, err := cassette.New(path)
+	require.NoError(t, err)
+	resp, err = replay.HTTPClient().Get(server.URL + "/v3/deployments/1234")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var got resource.Deployment
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	require.Equal(t, "FINALIZED", got.Status.Value)
+}
+
+func TestReplayOrRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "root.json")
+
+	rec, err := cassette.New(path)
+	require.Error(t, err)
+	require.Nil(t, rec)
+
+	fake := cffake.New()
+	defer fake.Close()
+	rec, err = cassette.New(path, cassette.WithMode(cassette.ModeReplayOrRecord),
+		cassette.WithScrubber(func(i *cassette.Interaction) {
+			i.Request.URL = strings.Replace(i.Request.URL, fake.URL, "https://api.example.org", 1)
+			i.Response.Body = []byte(strings.ReplaceAll(string(i.Response.Body), fake.URL, "https://api.example.org"))
+		}))
+	require.NoError(t, err)
+	require.Equal(t, cassette.ModeRecord, rec.Mode())
+	resp, err := rec.HTTPClient().Get(fake.URL + "/")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, rec.Stop())
+
+	rec, err = cassette.New(path, cassette.WithMode(cassette.ModeReplayOrRecord))
+	require.NoError(t, err)
+	require.Equal(t, cassette.ModeReplay, rec.Mode())
+	resp, err = rec.HTTPClient().Get("https://api.example.org/")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var root resource.Root
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&root))
+	require.Equal(t, "https://api.example.org/v3", root.Links.CloudControllerV3.Href)
+}
+
+func TestBinaryBody(t *testing.T) {
+	c := &cassette.Cassette{
+		Interactions: []*cassette.Interaction{{
+			Request:  cassette.Request{Method: http.MethodGet, URL: "https://api.example.org/v3/droplets/guid/download"},
+			Response: cassette.Response{StatusCode: http.StatusOK, Body: []byte{0x1f, 0x8b, 0x08, 0xff}},
+		}},
+	}
+	path := filepath.Join(t.TempDir(), "binary.json")
+	require.NoError(t, c.Save(path))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"base64"`)
+
+	loaded, err := cassette.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, c.Interactions[0].Response.Body, loaded.Interactions[0].Response.Body)
+}
@@ -0,0 +1,218 @@
+package cassette
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"sync"
+
+	internal "github.com/cloudfoundry-community/go-cfclient/v3/internal/http"
+)
+
+// Matcher returns true if the recorded request matches the request being replayed
+type Matcher func(req *http.Request, recorded Request) bool
+
+// Scrubber modifies an interaction before it's saved, for example to remove environment specific values
+type Scrubber func(*Interaction)
+
+// Option configures a Recorder
+type Option func(*Recorder)
+
+// WithMode sets whether the Recorder records or replays, the default is ModeReplay
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithTransport sets the transport used to send requests when recording, the default is a clone of
+// http.DefaultTransport. Use this to skip TLS validation as config.SkipTLSValidation only configures the
+// default transport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithMatcher replaces the default matcher, which matches the request method and URL
+func WithMatcher(matcher Matcher) Option {
+	return func(r *Recorder) {
+		r.matcher = matcher
+	}
+}
+
+// WithScrubber adds a scrubber that's run on each recorded interaction after the default scrubbing
+func WithScrubber(scrubber Scrubber) Option {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, scrubber)
+	}
+}
+
+// Recorder is a http.RoundTripper that records or replays the interactions in a cassette file
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	matcher   Matcher
+	scrubbers []Scrubber
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New creates a Recorder for the cassette file, in replay mode the cassette must exist
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:     path,
+		mode:     ModeReplay,
+		matcher:  MatchMethodAndURL,
+		cassette: &Cassette{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode != ModeRecord {
+		c, err := Load(path)
+		switch {
+		case err == nil:
+			r.mode = ModeReplay
+			r.cassette = c
+			r.used = make([]bool, len(c.Interactions))
+		case errors.Is(err, fs.ErrNotExist) && r.mode == ModeReplayOrRecord:
+			r.mode = ModeRecord
+		default:
+			return nil, err
+		}
+	}
+	if r.mode == ModeRecord && r.transport == nil {
+		r.transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	return r, nil
+}
+
+// Mode returns whether the Recorder is recording or replaying
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// HTTPClient returns a http.Client that uses the Recorder as its transport, pass it to config.HttpClient
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop saves the recorded interactions to the cassette file, it does nothing in replay mode
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip records or replays the request
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	i := &Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     internal.RedactURL(req.URL.String()),
+			Headers: internal.RedactHeader(req.Header),
+			Body:    scrubBody(req, req.Header.Get("Content-Type"), reqBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    internal.RedactHeader(resp.Header),
+			Body:       scrubBody(req, resp.Header.Get("Content-Type"), respBody),
+		},
+	}
+	for _, scrub := range r.scrubbers {
+		scrub(i)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, i)
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for idx, i := range r.cassette.Interactions {
+		if r.used[idx] || !r.matcher(req, i.Request) {
+			continue
+		}
+		r.used[idx] = true
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        i.Response.Headers.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, req.URL)
+}
+
+// MatchMethodAndURL is the default Matcher, it matches requests with the same method and URL. Secret query
+// parameters are scrubbed from recorded URLs so they're ignored.
+func MatchMethodAndURL(req *http.Request, recorded Request) bool {
+	return req.Method == recorded.Method && internal.RedactURL(req.URL.String()) == recorded.URL
+}
+
+// readRequestBody reads the request body and replaces it so it can still be sent
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// scrubBody redacts any secrets in a JSON or form body of the request or its response, other bodies like package
+// zips are recorded as is
+func scrubBody(req *http.Request, contentType string, body []byte) Body {
+	if len(body) == 0 {
+		return nil
+	}
+	return Body(internal.RedactBodyForURL(req.URL, contentType, body))
+}
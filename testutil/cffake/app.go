@@ -0,0 +1,306 @@
+package cffake
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+const (
+	defaultStack = "cflinuxfs4"
+	appStarted   = "STARTED"
+	appStopped   = "STOPPED"
+)
+
+func (s *Server) registerAppRoutes() {
+	s.handle(http.MethodPost, "/v3/apps", s.createApp)
+	s.handle(http.MethodGet, "/v3/apps", s.listApps)
+	s.handle(http.MethodGet, "/v3/apps/:guid", s.getApp)
+	s.handle(http.MethodPatch, "/v3/apps/:guid", s.updateApp)
+	s.handle(http.MethodDelete, "/v3/apps/:guid", s.deleteApp)
+	s.handle(http.MethodPost, "/v3/apps/:guid/actions/start", s.setAppState(appStarted))
+	s.handle(http.MethodPost, "/v3/apps/:guid/actions/stop", s.setAppState(appStopped))
+	s.handle(http.MethodPost, "/v3/apps/:guid/actions/restart", s.setAppState(appStarted))
+	s.handle(http.MethodGet, "/v3/apps/:guid/environment_variables", s.getAppEnv)
+	s.handle(http.MethodPatch, "/v3/apps/:guid/environment_variables", s.updateAppEnv)
+	s.handle(http.MethodGet, "/v3/apps/:guid/relationships/current_droplet", s.getCurrentDropletRelationship)
+	s.handle(http.MethodPatch, "/v3/apps/:guid/relationships/current_droplet", s.setCurrentDroplet)
+	s.handle(http.MethodGet, "/v3/apps/:guid/droplets/current", s.getCurrentDroplet)
+}
+
+func (s *Server) createApp(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r resource.AppCreate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name == "" {
+		writeUnprocessable(w, "Name can't be blank")
+		return
+	}
+	spaceGUID := relationshipGUID(&r.Relationships.Space)
+	if _, ok := s.spaces.get(spaceGUID); !ok {
+		writeUnprocessable(w, "Invalid space. Ensure that the space exists and you have access to it.")
+		return
+	}
+	if s.appNameTaken(spaceGUID, r.Name) {
+		writeUnprocessable(w, "App with the name '%s' already exists.", r.Name)
+		return
+	}
+
+	lifecycle := resource.Lifecycle{Type: resource.LifecycleBuildpack.String()}
+	if r.Lifecycle != nil {
+		lifecycle = *r.Lifecycle
+	}
+	if lifecycle.Type == resource.LifecycleBuildpack.String() && lifecycle.BuildpackData.Stack == "" {
+		lifecycle.BuildpackData.Stack = defaultStack
+	}
+	app := &resource.App{
+		Name:          r.Name,
+		State:         appStopped,
+		Lifecycle:     lifecycle,
+		Relationships: resource.SpaceRelationship{Space: toOne(spaceGUID)},
+		Metadata:      r.Metadata,
+		Resource:      s.newResource("/v3/apps"),
+	}
+	s.apps.add(app.GUID, app)
+
+	env := make(map[string]*string)
+	for k, v := range r.EnvironmentVariables {
+		v := v
+		env[k] = &v
+	}
+	s.appEnv[app.GUID] = env
+	s.addProcess(app.GUID, "web", 1)
+	writeJSON(w, http.StatusCreated, app)
+}
+
+func (s *Server) listApps(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	names, guids := queryFilter(q, "names"), queryFilter(q, "guids")
+	spaceGUIDs, orgGUIDs := queryFilter(q, "space_guids"), queryFilter(q, "organization_guids")
+	writeList(w, req, s.URL, s.apps.list(func(a *resource.App) bool {
+		return names.matches(a.Name) && guids.matches(a.GUID) && spaceGUIDs.matches(appSpaceGUID(a)) &&
+			orgGUIDs.matches(s.spaceOrgGUID(appSpaceGUID(a)))
+	}))
+}
+
+func (s *Server) getApp(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	app, ok := s.apps.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) updateApp(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	app, ok := s.apps.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	var r resource.AppUpdate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name != "" && r.Name != app.Name {
+		if s.appNameTaken(appSpaceGUID(app), r.Name) {
+			writeUnprocessable(w, "App with the name '%s' already exists.", r.Name)
+			return
+		}
+		app.Name = r.Name
+	}
+	if r.Lifecycle != nil {
+		app.Lifecycle = *r.Lifecycle
+	}
+	if r.Metadata != nil {
+		app.Metadata = r.Metadata
+	}
+	touch(&app.Resource)
+	writeJSON(w, http.StatusOK, app)
+}
+
+// deleteApp deletes the app, its processes, packages, builds and droplets and unmaps its routes once the job
+// completes
+func (s *Server) deleteApp(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	s.writeJob(w, "app.delete", func() *resource.CloudFoundryError {
+		s.removeApp(guid)
+		return nil
+	})
+}
+
+func (s *Server) removeApp(guid string) {
+	for _, p := range s.processes.list(func(p *resource.Process) bool { return processAppGUID(p) == guid }) {
+		s.processes.remove(p.GUID)
+	}
+	for _, p := range s.packages.list(func(p *resource.Package) bool { return relationshipGUID(&p.Relationships.App) == guid }) {
+		s.packages.remove(p.GUID)
+	}
+	for _, b := range s.builds.list(func(b *resource.Build) bool { return relationshipGUID(&b.Relationships.App) == guid }) {
+		s.builds.remove(b.GUID)
+	}
+	for _, d := range s.droplets.list(func(d *resource.Droplet) bool { return relationshipGUID(&d.Relationships.App) == guid }) {
+		s.droplets.remove(d.GUID)
+	}
+	for _, r := range s.routesByGUID.list(nil) {
+		r.Destinations = removeAppDestinations(r.Destinations, guid)
+	}
+	delete(s.appEnv, guid)
+	delete(s.currentDroplets, guid)
+	delete(s.stagingErrors, guid)
+	delete(s.crashes, guid)
+	s.apps.remove(guid)
+}
+
+// setAppState starts or stops the app, an app can only be started once it has a current droplet
+func (s *Server) setAppState(state string) handlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+		app, ok := s.apps.get(params["guid"])
+		if !ok {
+			writeNotFound(w, "App")
+			return
+		}
+		if state == appStarted && s.currentDroplets[app.GUID] == "" {
+			writeUnprocessable(w, "Assign a droplet before starting this app.")
+			return
+		}
+		if state == appStopped {
+			delete(s.crashes, app.GUID)
+		}
+		app.State = state
+		touch(&app.Resource)
+		writeJSON(w, http.StatusOK, app)
+	}
+}
+
+func (s *Server) getAppEnv(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.appEnvResponse(guid))
+}
+
+// updateAppEnv merges the environment variables into the app's, a nil value removes the variable
+func (s *Server) updateAppEnv(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	var r resource.EnvVar
+	if !decode(w, req, &r) {
+		return
+	}
+	for k, v := range r.Var {
+		if v == nil {
+			delete(s.appEnv[guid], k)
+		} else {
+			s.appEnv[guid][k] = v
+		}
+	}
+	writeJSON(w, http.StatusOK, s.appEnvResponse(guid))
+}
+
+func (s *Server) appEnvResponse(guid string) resource.EnvVarResponse {
+	return resource.EnvVarResponse{
+		EnvVar: resource.EnvVar{Var: s.appEnv[guid]},
+		Links: map[string]resource.Link{
+			"self": {Href: s.URL + "/v3/apps/" + guid + "/environment_variables"},
+			"app":  {Href: s.URL + "/v3/apps/" + guid},
+		},
+	}
+}
+
+func (s *Server) getCurrentDropletRelationship(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	dropletGUID := s.currentDroplets[guid]
+	if dropletGUID == "" {
+		writeNotFound(w, "Droplet")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.currentDropletResponse(guid, dropletGUID))
+}
+
+// setCurrentDroplet sets the app's current droplet, creating any of the droplet's process types the app doesn't
+// have yet
+func (s *Server) setCurrentDroplet(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	var r resource.ToOneRelationship
+	if !decode(w, req, &r) {
+		return
+	}
+	droplet, ok := s.droplets.get(relationshipGUID(&r))
+	if !ok || relationshipGUID(&droplet.Relationships.App) != guid {
+		writeUnprocessable(w, "Unable to assign current droplet. Ensure the droplet exists and belongs to this app.")
+		return
+	}
+	if droplet.State != resource.DropletState(resource.DropletStateStaged) {
+		writeUnprocessable(w, "Unable to assign current droplet. Ensure the droplet is staged.")
+		return
+	}
+	for processType := range droplet.ProcessTypes {
+		if s.appProcess(guid, processType) == nil {
+			s.addProcess(guid, processType, 0)
+		}
+	}
+	s.currentDroplets[guid] = droplet.GUID
+	writeJSON(w, http.StatusOK, s.currentDropletResponse(guid, droplet.GUID))
+}
+
+func (s *Server) currentDropletResponse(appGUID, dropletGUID string) resource.DropletCurrent {
+	return resource.DropletCurrent{
+		Data: resource.Relationship{GUID: dropletGUID},
+		Links: map[string]resource.Link{
+			"self":    {Href: s.URL + "/v3/apps/" + appGUID + "/relationships/current_droplet"},
+			"related": {Href: s.URL + "/v3/apps/" + appGUID + "/droplets/current"},
+		},
+	}
+}
+
+func (s *Server) getCurrentDroplet(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	droplet, ok := s.droplets.get(s.currentDroplets[guid])
+	if !ok {
+		writeNotFound(w, "Droplet")
+		return
+	}
+	writeJSON(w, http.StatusOK, droplet)
+}
+
+func (s *Server) appNameTaken(spaceGUID, name string) bool {
+	return len(s.apps.list(func(a *resource.App) bool {
+		return appSpaceGUID(a) == spaceGUID && strings.EqualFold(a.Name, name)
+	})) > 0
+}
+
+func (s *Server) spaceOrgGUID(spaceGUID string) string {
+	space, ok := s.spaces.get(spaceGUID)
+	if !ok {
+		return ""
+	}
+	return spaceOrgGUID(space)
+}
+
+func appSpaceGUID(app *resource.App) string {
+	return relationshipGUID(&app.Relationships.Space)
+}
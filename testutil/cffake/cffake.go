@@ -0,0 +1,344 @@
+// Package cffake is a stateful in memory fake Cloud Controller for unit testing code that uses client.Client.
+//
+// Unlike testutil.SetupMultiple, which replays canned JSON per endpoint, objects created through the client are
+// persisted so create-then-get flows work, relationships are enforced, lists are paginated, deletes and managed
+// service instances run as async jobs and builds stage a droplet. Each Server is independent so tests can run
+// in parallel.
+//
+//	fake := cffake.New()
+//	defer fake.Close()
+//	cfg, _ := fake.Config()
+//	cf, _ := client.New(cfg)
+package cffake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+// SharedDomainName is the name of the shared domain every fake Cloud Controller starts with
+const SharedDomainName = "apps.example.org"
+
+// DefaultAsyncPolls is the number of times a job or build is polled before it finishes
+const DefaultAsyncPolls = 1
+
+// Fault is an error response injected for matching requests instead of handling them
+type Fault struct {
+	// Method is the HTTP method to match, empty matches any method
+	Method string
+
+	// Path is a path.Match pattern for the request path e.g. /v3/apps/*
+	Path string
+
+	// Status is the HTTP status code to respond with
+	Status int
+
+	// Error is the Cloud Foundry error to respond with, if empty a CF-ServiceUnavailable error is used
+	Error resource.CloudFoundryError
+
+	// Times is the number of requests to fail, 0 fails every matching request until ClearFaults is called
+	Times int
+}
+
+// Server is a fake Cloud Controller, including the UAA token endpoint, backed by an httptest.Server
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	routes        []route
+	faults        []*Fault
+	asyncPolls    int
+	stagingErrors map[string]string
+	crashes       map[string]string
+
+	orgs             *store[*resource.Organization]
+	spaces           *store[*resource.Space]
+	apps             *store[*resource.App]
+	appEnv           map[string]map[string]*string
+	currentDroplets  map[string]string
+	processes        *store[*resource.Process]
+	packages         *store[*resource.Package]
+	builds           *store[*resource.Build]
+	buildPolls       map[string]int
+	droplets         *store[*resource.Droplet]
+	domains          *store[*resource.Domain]
+	routesByGUID     *store[*resource.Route]
+	serviceInstances *store[*resource.ServiceInstance]
+	credentials      map[string]json.RawMessage
+	jobs             *store[*fakeJob]
+}
+
+// New starts a fake Cloud Controller with a single shared domain, Close must be called to shut it down
+func New() *Server {
+	s := &Server{
+		asyncPolls:       DefaultAsyncPolls,
+		stagingErrors:    make(map[string]string),
+		crashes:          make(map[string]string),
+		orgs:             newStore[*resource.Organization](),
+		spaces:           newStore[*resource.Space](),
+		apps:             newStore[*resource.App](),
+		appEnv:           make(map[string]map[string]*string),
+		currentDroplets:  make(map[string]string),
+		processes:        newStore[*resource.Process](),
+		packages:         newStore[*resource.Package](),
+		builds:           newStore[*resource.Build](),
+		buildPolls:       make(map[string]int),
+		droplets:         newStore[*resource.Droplet](),
+		domains:          newStore[*resource.Domain](),
+		routesByGUID:     newStore[*resource.Route](),
+		serviceInstances: newStore[*resource.ServiceInstance](),
+		credentials:      make(map[string]json.RawMessage),
+		jobs:             newStore[*fakeJob](),
+	}
+	s.registerRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	domain := &resource.Domain{
+		Name:               SharedDomainName,
+		SupportedProtocols: []string{"http"},
+		Resource:           s.newResource("/v3/domains"),
+	}
+	s.domains.add(domain.GUID, domain)
+	return s
+}
+
+// Config returns a client config for the fake Cloud Controller with the options applied
+func (s *Server) Config(options ...config.Option) (*config.Config, error) {
+	return config.New(s.URL, append([]config.Option{config.Token("", "fake-refresh-token")}, options...)...)
+}
+
+// SetAsyncPolls sets the number of times a job or build is polled before it finishes, 0 finishes them on creation
+func (s *Server) SetAsyncPolls(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.asyncPolls = n
+}
+
+// FailStaging makes every build of the app fail with the error
+func (s *Server) FailStaging(appGUID, errMsg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stagingErrors[appGUID] = errMsg
+}
+
+// InjectFault responds to matching requests with the fault's error until it's been returned Times times
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all the injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// handlerFunc handles a request to a route with the path parameters, it's called with the server lock held
+type handlerFunc func(w http.ResponseWriter, req *http.Request, params map[string]string)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+func (s *Server) handle(method, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) registerRoutes() {
+	s.registerOrgRoutes()
+	s.registerSpaceRoutes()
+	s.registerAppRoutes()
+	s.registerProcessRoutes()
+	s.registerPackageRoutes()
+	s.registerBuildRoutes()
+	s.registerDropletRoutes()
+	s.registerDomainRoutes()
+	s.registerRouteRoutes()
+	s.registerServiceInstanceRoutes()
+	s.registerJobRoutes()
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case req.URL.Path == "/" && req.Method == http.MethodGet:
+		s.root(w)
+		return
+	case req.URL.Path == "/oauth/token" && req.Method == http.MethodPost:
+		writeJSON(w, http.StatusOK, map[string]any{
+			"token_type":    "bearer",
+			"access_token":  "fake-access-token",
+			"refresh_token": "fake-refresh-token",
+			"expires_in":    3600,
+		})
+		return
+	}
+	if !strings.HasPrefix(strings.ToLower(req.Header.Get("Authorization")), "bearer ") {
+		writeError(w, http.StatusUnauthorized, resource.CloudFoundryError{
+			Code:   10002,
+			Title:  "CF-NotAuthenticated",
+			Detail: "Authentication error",
+		})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f := s.fault(req); f != nil {
+		cfErr := f.Error
+		if cfErr.Code == 0 {
+			cfErr = resource.CloudFoundryError{
+				Code:   10015,
+				Title:  "CF-ServiceUnavailable",
+				Detail: "Injected fault",
+			}
+		}
+		writeError(w, f.Status, cfErr)
+		return
+	}
+
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for _, r := range s.routes {
+		if r.method != req.Method {
+			continue
+		}
+		if params, ok := matchSegments(r.segments, segments); ok {
+			r.handler(w, req, params)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, resource.NewNotFoundError())
+}
+
+// fault returns the first injected fault matching the request, if any, counting it against the fault's Times
+func (s *Server) fault(req *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != req.Method {
+			continue
+		}
+		if ok, _ := path.Match(f.Path, req.URL.Path); !ok {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+func matchSegments(pattern, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, p := range pattern {
+		if strings.HasPrefix(p, ":") {
+			params[p[1:]] = segments[i]
+		} else if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (s *Server) root(w http.ResponseWriter) {
+	link := func(href string) map[string]any {
+		return map[string]any{"href": href}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"links": map[string]any{
+			"self": link(s.URL),
+			"cloud_controller_v3": map[string]any{
+				"href": s.URL + "/v3",
+				"meta": map[string]any{"version": "3.180.0"},
+			},
+			"uaa":   link(s.URL),
+			"login": link(s.URL),
+			"app_ssh": map[string]any{
+				"href": "ssh.example.org:2222",
+				"meta": map[string]any{"oauth_client": "ssh-proxy"},
+			},
+		},
+	})
+}
+
+// newResource returns the common fields of a new resource in the collection
+func (s *Server) newResource(collection string) resource.Resource {
+	guid := strings.ToLower(testutil.RandomGUID())
+	now := time.Now().UTC().Truncate(time.Second)
+	return resource.Resource{
+		GUID:      guid,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Links: resource.Links{
+			"self": resource.Link{Href: s.URL + collection + "/" + guid},
+		},
+	}
+}
+
+func touch(r *resource.Resource) {
+	r.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err resource.CloudFoundryError) {
+	writeJSON(w, status, resource.CloudFoundryErrors{Errors: []resource.CloudFoundryError{err}})
+}
+
+// writeNotFound writes the CC's not found error for the kind of resource e.g. App
+func writeNotFound(w http.ResponseWriter, kind string) {
+	writeError(w, http.StatusNotFound, resource.CloudFoundryError{
+		Code:   10010,
+		Title:  "CF-ResourceNotFound",
+		Detail: kind + " not found",
+	})
+}
+
+func writeUnprocessable(w http.ResponseWriter, format string, a ...any) {
+	writeError(w, http.StatusUnprocessableEntity, resource.CloudFoundryError{
+		Code:   10008,
+		Title:  "CF-UnprocessableEntity",
+		Detail: fmt.Sprintf(format, a...),
+	})
+}
+
+// decode reads the JSON request body into v, writing a bad request error if it can't
+func decode(w http.ResponseWriter, req *http.Request, v any) bool {
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, resource.CloudFoundryError{
+			Code:   1001,
+			Title:  "CF-MessageParseError",
+			Detail: "Request invalid due to parse error: invalid request body",
+		})
+		return false
+	}
+	return true
+}
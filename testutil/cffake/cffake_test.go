@@ -0,0 +1,311 @@
+package cffake_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil/cffake"
+	"github.com/stretchr/testify/require"
+)
+
+var fastPolling = &client.PollingOptions{
+	FailedState:   "FAILED",
+	Timeout:       5 * time.Second,
+	CheckInterval: time.Millisecond,
+}
+
+func newClient(t *testing.T) (*cffake.Server, *client.Client) {
+	t.Helper()
+	fake := cffake.New()
+	t.Cleanup(fake.Close)
+	cfg, err := fake.Config()
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+	return fake, cf
+}
+
+func createSpace(t *testing.T, cf *client.Client) (*resource.Organization, *resource.Space) {
+	t.Helper()
+	ctx := context.Background()
+	org, err := cf.Organizations.Create(ctx, resource.NewOrganizationCreate("my-org"))
+	require.NoError(t, err)
+	space, err := cf.Spaces.Create(ctx, resource.NewSpaceCreate("my-space", org.GUID))
+	require.NoError(t, err)
+	return org, space
+}
+
+func TestCreateAndGet(t *testing.T) {
+	t.Parallel()
+	_, cf := newClient(t)
+	ctx := context.Background()
+
+	org, space := createSpace(t, cf)
+	app, err := cf.Applications.Create(ctx, resource.NewAppCreate("my-app", space.GUID))
+	require.NoError(t, err)
+	require.Equal(t, "STOPPED", app.State)
+
+	got, err := cf.Applications.Get(ctx, app.GUID)
+	require.NoError(t, err)
+	require.Equal(t, app.GUID, got.GUID)
+	require.Equal(t, "my-app", got.Name)
+	require.Equal(t, space.GUID, got.Relationships.Space.Data.GUID)
+
+	gotSpace, err := cf.Spaces.Get(ctx, got.Relationships.Space.Data.GUID)
+	require.NoError(t, err)
+	require.Equal(t, org.GUID, gotSpace.Relationships.Organization.Data.GUID)
+
+	processes, err := cf.Processes.ListForAppAll(ctx, app.GUID, nil)
+	require.NoError(t, err)
+	require.Len(t, processes, 1)
+	require.Equal(t, "web", processes[0].Type)
+}
+
+func TestRelationships(t *testing.T) {
+	t.Parallel()
+	_, cf := newClient(t)
+	ctx := context.Background()
+
+	_, err := cf.Spaces.Create(ctx, resource.NewSpaceCreate("my-space", "missing-org-guid"))
+	require.True(t, resource.IsUnprocessableEntityError(err), "expected unprocessable entity, got %v", err)
+
+	_, space := createSpace(t, cf)
+	_, err = cf.Spaces.Create(ctx, resource.NewSpaceCreate("my-space", space.Relationships.Organization.Data.GUID))
+	require.True(t, resource.IsUnprocessableEntityError(err), "expected name taken, got %v", err)
+
+	_, err = cf.Applications.Get(ctx, "missing-app-guid")
+	require.True(t, resource.IsResourceNotFoundError(err), "expected not found, got %v", err)
+}
+
+func TestPagination(t *testing.T) {
+	t.Parallel()
+	_, cf := newClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"org-1", "org-2", "org-3", "org-4", "org-5"} {
+		_, err := cf.Organizations.Create(ctx, resource.NewOrganizationCreate(name))
+		require.NoError(t, err)
+	}
+
+	opts := client.NewOrganizationListOptions()
+	opts.PerPage = 2
+	orgs, pager, err := cf.Organizations.List(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, orgs, 2)
+	require.True(t, pager.HasNextPage())
+
+	orgs, err = cf.Organizations.ListAll(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, orgs, 5)
+	require.Equal(t, "org-5", orgs[4].Name)
+
+	opts = client.NewOrganizationListOptions()
+	opts.Names.EqualTo("org-3")
+	orgs, err = cf.Organizations.ListAll(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	require.Equal(t, "org-3", orgs[0].Name)
+}
+
+func TestStaging(t *testing.T) {
+	t.Parallel()
+	fake, cf := newClient(t)
+	ctx := context.Background()
+
+	_, space := createSpace(t, cf)
+	app, err := cf.Applications.Create(ctx, resource.NewAppCreate("my-app", space.GUID))
+	require.NoError(t, err)
+
+	_, err = cf.Applications.Start(ctx, app.GUID)
+	require.True(t, resource.IsUnprocessableEntityError(err), "expected start without droplet to fail, got %v", err)
+
+	pkg, err := cf.Packages.Create(ctx, resource.NewPackageCreate(app.GUID))
+	require.NoError(t, err)
+	require.Equal(t, resource.PackageStateAwaitingUpload, pkg.State)
+	pkg, err = cf.Packages.Upload(ctx, pkg.GUID, bytes.NewReader([]byte("zip")))
+	require.NoError(t, err)
+	require.Equal(t, resource.PackageStateReady, pkg.State)
+
+	build, err := cf.Builds.Create(ctx, resource.NewBuildCreate(pkg.GUID))
+	require.NoError(t, err)
+	require.Equal(t, resource.BuildStateStaging, build.State)
+	require.NoError(t, cf.Builds.PollStaged(ctx, build.GUID, fastPolling))
+	build, err = cf.Builds.Get(ctx, build.GUID)
+	require.NoError(t, err)
+	require.NotNil(t, build.Droplet)
+
+	_, err = cf.Droplets.SetCurrentAssociationForApp(ctx, app.GUID, build.Droplet.GUID)
+	require.NoError(t, err)
+	droplet, err := cf.Droplets.GetCurrentForApp(ctx, app.GUID)
+	require.NoError(t, err)
+	require.Equal(t, build.Droplet.GUID, droplet.GUID)
+
+	app, err = cf.Applications.Start(ctx, app.GUID)
+	require.NoError(t, err)
+	require.Equal(t, "STARTED", app.State)
+	require.NoError(t, cf.Applications.WaitForRunning(ctx, app.GUID, fastPolling))
+
+	fake.CrashInstances(app.GUID, "exited with status 1")
+	err = cf.Applications.WaitForRunning(ctx, app.GUID, fastPolling)
+	require.ErrorIs(t, err, client.ErrAppInstanceCrashed)
+}
+
+func TestStagingFailure(t *testing.T) {
+	t.Parallel()
+	fake, cf := newClient(t)
+	ctx := context.Background()
+
+	_, space := createSpace(t, cf)
+	app, err := cf.Applications.Create(ctx, resource.NewAppCreate("my-app", space.GUID))
+	require.NoError(t, err)
+	fake.FailStaging(app.GUID, "NoAppDetectedError")
+
+	pkg, err := cf.Packages.Create(ctx, resource.NewDockerPackageCreate(app.GUID, "nginx", "", ""))
+	require.NoError(t, err)
+	require.Equal(t, resource.PackageStateReady, pkg.State)
+	build, err := cf.Builds.Create(ctx, resource.NewBuildCreate(pkg.GUID))
+	require.NoError(t, err)
+	require.Error(t, cf.Builds.PollStaged(ctx, build.GUID, fastPolling))
+
+	build, err = cf.Builds.Get(ctx, build.GUID)
+	require.NoError(t, err)
+	require.Equal(t, resource.BuildStateFailed, build.State)
+	require.Equal(t, "NoAppDetectedError", *build.Error)
+}
+
+func TestAsyncDelete(t *testing.T) {
+	t.Parallel()
+	fake, cf := newClient(t)
+	fake.SetAsyncPolls(3)
+	ctx := context.Background()
+
+	org, space := createSpace(t, cf)
+	app, err := cf.Applications.Create(ctx, resource.NewAppCreate("my-app", space.GUID))
+	require.NoError(t, err)
+
+	jobGUID, err := cf.Organizations.Delete(ctx, org.GUID)
+	require.NoError(t, err)
+	job, err := cf.Jobs.Get(ctx, jobGUID)
+	require.NoError(t, err)
+	require.Equal(t, resource.JobStateProcessing, job.State)
+	_, err = cf.Spaces.Get(ctx, space.GUID)
+	require.NoError(t, err)
+
+	require.NoError(t, cf.Jobs.PollComplete(ctx, jobGUID, fastPolling))
+	_, err = cf.Organizations.Get(ctx, org.GUID)
+	require.True(t, resource.IsResourceNotFoundError(err), "expected org to be deleted, got %v", err)
+	_, err = cf.Spaces.Get(ctx, space.GUID)
+	require.True(t, resource.IsResourceNotFoundError(err), "expected space to be deleted, got %v", err)
+	_, err = cf.Applications.Get(ctx, app.GUID)
+	require.True(t, resource.IsResourceNotFoundError(err), "expected app to be deleted, got %v", err)
+}
+
+func TestFaults(t *testing.T) {
+	t.Parallel()
+	fake, cf := newClient(t)
+	ctx := context.Background()
+
+	fake.InjectFault(cffake.Fault{
+		Method: http.MethodPost,
+		Path:   "/v3/organizations",
+		Status: http.StatusUnprocessableEntity,
+		Error:  resource.NewOrganizationNameTakenError(),
+		Times:  1,
+	})
+	_, err := cf.Organizations.Create(ctx, resource.NewOrganizationCreate("my-org"))
+	var cfErr resource.CloudFoundryError
+	require.True(t, errors.As(err, &cfErr), "expected a cloud foundry error, got %v", err)
+	require.Equal(t, resource.NewOrganizationNameTakenError().Code, cfErr.Code)
+
+	org, err := cf.Organizations.Create(ctx, resource.NewOrganizationCreate("my-org"))
+	require.NoError(t, err)
+
+	fake.InjectFault(cffake.Fault{Path: "/v3/organizations/*", Status: http.StatusServiceUnavailable})
+	_, err = cf.Organizations.Get(ctx, org.GUID)
+	require.Error(t, err)
+	_, err = cf.Organizations.Get(ctx, org.GUID)
+	require.Error(t, err)
+
+	fake.ClearFaults()
+	_, err = cf.Organizations.Get(ctx, org.GUID)
+	require.NoError(t, err)
+}
+
+func TestRoutes(t *testing.T) {
+	t.Parallel()
+	_, cf := newClient(t)
+	ctx := context.Background()
+
+	_, space := createSpace(t, cf)
+	app, err := cf.Applications.Create(ctx, resource.NewAppCreate("my-app", space.GUID))
+	require.NoError(t, err)
+
+	domains, err := cf.Domains.ListAll(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, domains, 1)
+	require.Equal(t, cffake.SharedDomainName, domains[0].Name)
+
+	route, err := cf.Routes.Create(ctx, resource.NewRouteCreateWithHost(domains[0].GUID, space.GUID, "my-app", "", 0))
+	require.NoError(t, err)
+	require.Equal(t, "my-app."+cffake.SharedDomainName, route.URL)
+	_, err = cf.Routes.Create(ctx, resource.NewRouteCreateWithHost(domains[0].GUID, space.GUID, "my-app", "", 0))
+	require.True(t, resource.IsUnprocessableEntityError(err), "expected route taken, got %v", err)
+
+	_, err = cf.Routes.InsertDestinations(ctx, route.GUID, []*resource.RouteDestinationInsertOrReplace{
+		resource.NewRouteDestinationInsertOrReplace(app.GUID),
+	})
+	require.NoError(t, err)
+	destinations, err := cf.Routes.GetDestinations(ctx, route.GUID)
+	require.NoError(t, err)
+	require.Len(t, destinations.Destinations, 1)
+	require.Equal(t, app.GUID, *destinations.Destinations[0].App.GUID)
+
+	routes, err := cf.Routes.ListForAppAll(ctx, app.GUID, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, route.GUID, routes[0].GUID)
+}
+
+func TestServiceInstances(t *testing.T) {
+	t.Parallel()
+	_, cf := newClient(t)
+	ctx := context.Background()
+
+	_, space := createSpace(t, cf)
+
+	upsi := resource.NewServiceInstanceCreateUserProvided("my-upsi", space.GUID)
+	credentials := json.RawMessage(`{"password":"secret"}`)
+	upsi.Credentials = &credentials
+	si, err := cf.ServiceInstances.CreateUserProvided(ctx, upsi)
+	require.NoError(t, err)
+	require.Equal(t, "user-provided", si.Type)
+	got, err := cf.ServiceInstances.GetUserProvidedCredentials(ctx, si.GUID)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"password":"secret"}`, string(*got))
+
+	jobGUID, err := cf.ServiceInstances.CreateManaged(ctx, resource.NewServiceInstanceCreateManaged("my-db", space.GUID, "plan-guid"))
+	require.NoError(t, err)
+	opts := client.NewServiceInstanceListOptions()
+	opts.Names.EqualTo("my-db")
+	managed, err := cf.ServiceInstances.Single(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, "in progress", managed.LastOperation.State)
+
+	require.NoError(t, cf.Jobs.PollComplete(ctx, jobGUID, fastPolling))
+	managed, err = cf.ServiceInstances.Get(ctx, managed.GUID)
+	require.NoError(t, err)
+	require.Equal(t, "succeeded", managed.LastOperation.State)
+
+	_, err = cf.ServiceInstances.Delete(ctx, si.GUID)
+	require.NoError(t, err)
+	all, err := cf.ServiceInstances.ListAll(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+}
@@ -0,0 +1,64 @@
+package cffake
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// fakeJob is an async job that runs its operation once it's been polled the async polls times
+type fakeJob struct {
+	job      *resource.Job
+	polls    int
+	complete func() *resource.CloudFoundryError
+}
+
+func (s *Server) registerJobRoutes() {
+	s.handle(http.MethodGet, "/v3/jobs/:guid", s.getJob)
+}
+
+// writeJob creates a job for the operation and writes the 202 response with the job location, the operation runs
+// when the job completes
+func (s *Server) writeJob(w http.ResponseWriter, operation string, complete func() *resource.CloudFoundryError) {
+	j := &fakeJob{
+		job: &resource.Job{
+			Operation: operation,
+			State:     resource.JobStateProcessing,
+			Errors:    []resource.CloudFoundryError{},
+			Warnings:  []resource.JobWarning{},
+			Resource:  s.newResource("/v3/jobs"),
+		},
+		complete: complete,
+	}
+	s.jobs.add(j.job.GUID, j)
+	if s.asyncPolls == 0 {
+		s.finishJob(j)
+	}
+	w.Header().Set("Location", j.job.Links.Self().Href)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) getJob(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	j, ok := s.jobs.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Job")
+		return
+	}
+	if j.job.State == resource.JobStateProcessing {
+		j.polls++
+		if j.polls >= s.asyncPolls {
+			s.finishJob(j)
+		}
+	}
+	writeJSON(w, http.StatusOK, j.job)
+}
+
+func (s *Server) finishJob(j *fakeJob) {
+	touch(&j.job.Resource)
+	if err := j.complete(); err != nil {
+		j.job.State = resource.JobStateFailed
+		j.job.Errors = append(j.job.Errors, *err)
+		return
+	}
+	j.job.State = resource.JobStateComplete
+}
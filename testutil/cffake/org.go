@@ -0,0 +1,217 @@
+package cffake
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+func (s *Server) registerOrgRoutes() {
+	s.handle(http.MethodPost, "/v3/organizations", s.createOrg)
+	s.handle(http.MethodGet, "/v3/organizations", s.listOrgs)
+	s.handle(http.MethodGet, "/v3/organizations/:guid", s.getOrg)
+	s.handle(http.MethodPatch, "/v3/organizations/:guid", s.updateOrg)
+	s.handle(http.MethodDelete, "/v3/organizations/:guid", s.deleteOrg)
+}
+
+func (s *Server) registerSpaceRoutes() {
+	s.handle(http.MethodPost, "/v3/spaces", s.createSpace)
+	s.handle(http.MethodGet, "/v3/spaces", s.listSpaces)
+	s.handle(http.MethodGet, "/v3/spaces/:guid", s.getSpace)
+	s.handle(http.MethodPatch, "/v3/spaces/:guid", s.updateSpace)
+	s.handle(http.MethodDelete, "/v3/spaces/:guid", s.deleteSpace)
+}
+
+func (s *Server) createOrg(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r resource.OrganizationCreate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name == "" {
+		writeUnprocessable(w, "Name can't be blank")
+		return
+	}
+	if len(s.orgs.list(func(o *resource.Organization) bool { return o.Name == r.Name })) > 0 {
+		writeUnprocessable(w, "Organization '%s' already exists.", r.Name)
+		return
+	}
+	org := &resource.Organization{
+		Name:      r.Name,
+		Suspended: r.Suspended,
+		Metadata:  r.Metadata,
+		Resource:  s.newResource("/v3/organizations"),
+	}
+	if org.Suspended == nil {
+		suspended := false
+		org.Suspended = &suspended
+	}
+	s.orgs.add(org.GUID, org)
+	writeJSON(w, http.StatusCreated, org)
+}
+
+func (s *Server) listOrgs(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	names, guids := queryFilter(q, "names"), queryFilter(q, "guids")
+	writeList(w, req, s.URL, s.orgs.list(func(o *resource.Organization) bool {
+		return names.matches(o.Name) && guids.matches(o.GUID)
+	}))
+}
+
+func (s *Server) getOrg(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	org, ok := s.orgs.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Organization")
+		return
+	}
+	writeJSON(w, http.StatusOK, org)
+}
+
+func (s *Server) updateOrg(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	org, ok := s.orgs.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Organization")
+		return
+	}
+	var r resource.OrganizationUpdate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name != "" && r.Name != org.Name {
+		if len(s.orgs.list(func(o *resource.Organization) bool { return o.Name == r.Name })) > 0 {
+			writeUnprocessable(w, "Organization '%s' already exists.", r.Name)
+			return
+		}
+		org.Name = r.Name
+	}
+	if r.Suspended != nil {
+		org.Suspended = r.Suspended
+	}
+	if r.Metadata != nil {
+		org.Metadata = r.Metadata
+	}
+	touch(&org.Resource)
+	writeJSON(w, http.StatusOK, org)
+}
+
+// deleteOrg deletes the org and everything in it once the job completes
+func (s *Server) deleteOrg(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.orgs.get(guid); !ok {
+		writeNotFound(w, "Organization")
+		return
+	}
+	s.writeJob(w, "organization.delete", func() *resource.CloudFoundryError {
+		for _, space := range s.spaces.list(func(sp *resource.Space) bool { return spaceOrgGUID(sp) == guid }) {
+			s.removeSpace(space.GUID)
+		}
+		s.orgs.remove(guid)
+		return nil
+	})
+}
+
+func (s *Server) createSpace(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r resource.SpaceCreate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name == "" {
+		writeUnprocessable(w, "Name can't be blank")
+		return
+	}
+	var orgGUID string
+	if r.Relationships != nil {
+		orgGUID = relationshipGUID(r.Relationships.Organization)
+	}
+	if _, ok := s.orgs.get(orgGUID); !ok {
+		writeUnprocessable(w, "Invalid organization. Ensure the organization exists and you have access to it.")
+		return
+	}
+	if len(s.spaces.list(func(sp *resource.Space) bool { return spaceOrgGUID(sp) == orgGUID && sp.Name == r.Name })) > 0 {
+		writeUnprocessable(w, "Name must be unique per organization")
+		return
+	}
+	org := toOne(orgGUID)
+	space := &resource.Space{
+		Name:          r.Name,
+		Relationships: &resource.SpaceRelationships{Organization: &org},
+		Metadata:      r.Metadata,
+		Resource:      s.newResource("/v3/spaces"),
+	}
+	s.spaces.add(space.GUID, space)
+	writeJSON(w, http.StatusCreated, space)
+}
+
+func (s *Server) listSpaces(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	names, guids, orgGUIDs := queryFilter(q, "names"), queryFilter(q, "guids"), queryFilter(q, "organization_guids")
+	writeList(w, req, s.URL, s.spaces.list(func(sp *resource.Space) bool {
+		return names.matches(sp.Name) && guids.matches(sp.GUID) && orgGUIDs.matches(spaceOrgGUID(sp))
+	}))
+}
+
+func (s *Server) getSpace(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	space, ok := s.spaces.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Space")
+		return
+	}
+	writeJSON(w, http.StatusOK, space)
+}
+
+func (s *Server) updateSpace(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	space, ok := s.spaces.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Space")
+		return
+	}
+	var r resource.SpaceUpdate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name != "" && r.Name != space.Name {
+		orgGUID := spaceOrgGUID(space)
+		if len(s.spaces.list(func(sp *resource.Space) bool { return spaceOrgGUID(sp) == orgGUID && sp.Name == r.Name })) > 0 {
+			writeUnprocessable(w, "Name must be unique per organization")
+			return
+		}
+		space.Name = r.Name
+	}
+	if r.Metadata != nil {
+		space.Metadata = r.Metadata
+	}
+	touch(&space.Resource)
+	writeJSON(w, http.StatusOK, space)
+}
+
+// deleteSpace deletes the space and everything in it once the job completes
+func (s *Server) deleteSpace(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.spaces.get(guid); !ok {
+		writeNotFound(w, "Space")
+		return
+	}
+	s.writeJob(w, "space.delete", func() *resource.CloudFoundryError {
+		s.removeSpace(guid)
+		return nil
+	})
+}
+
+func (s *Server) removeSpace(guid string) {
+	for _, app := range s.apps.list(func(a *resource.App) bool { return appSpaceGUID(a) == guid }) {
+		s.removeApp(app.GUID)
+	}
+	for _, r := range s.routesByGUID.list(func(r *resource.Route) bool { return relationshipGUID(&r.Relationships.Space) == guid }) {
+		s.routesByGUID.remove(r.GUID)
+	}
+	for _, si := range s.serviceInstances.list(func(si *resource.ServiceInstance) bool { return relationshipGUID(si.Relationships.Space) == guid }) {
+		s.serviceInstances.remove(si.GUID)
+	}
+	s.spaces.remove(guid)
+}
+
+func spaceOrgGUID(space *resource.Space) string {
+	if space.Relationships == nil {
+		return ""
+	}
+	return relationshipGUID(space.Relationships.Organization)
+}
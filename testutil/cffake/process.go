@@ -0,0 +1,218 @@
+package cffake
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// CrashInstances makes every instance of the started app report CRASHED with the details until it's stopped
+func (s *Server) CrashInstances(appGUID, details string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.crashes[appGUID] = details
+}
+
+func (s *Server) registerProcessRoutes() {
+	s.handle(http.MethodGet, "/v3/processes", s.listProcesses)
+	s.handle(http.MethodGet, "/v3/processes/:guid", s.getProcess)
+	s.handle(http.MethodPatch, "/v3/processes/:guid", s.updateProcess)
+	s.handle(http.MethodGet, "/v3/processes/:guid/stats", s.getProcessStats)
+	s.handle(http.MethodPost, "/v3/processes/:guid/actions/scale", s.scaleProcess)
+	s.handle(http.MethodGet, "/v3/apps/:guid/processes", s.listAppProcesses)
+	s.handle(http.MethodGet, "/v3/apps/:guid/processes/:type", s.getAppProcess)
+	s.handle(http.MethodGet, "/v3/apps/:guid/processes/:type/stats", s.getAppProcessStats)
+	s.handle(http.MethodPost, "/v3/apps/:guid/processes/:type/actions/scale", s.scaleAppProcess)
+}
+
+func (s *Server) listProcesses(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	guids, types, appGUIDs := queryFilter(q, "guids"), queryFilter(q, "types"), queryFilter(q, "app_guids")
+	writeList(w, req, s.URL, s.processes.list(func(p *resource.Process) bool {
+		return guids.matches(p.GUID) && types.matches(p.Type) && appGUIDs.matches(processAppGUID(p))
+	}))
+}
+
+func (s *Server) listAppProcesses(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	types := queryFilter(req.URL.Query(), "types")
+	writeList(w, req, s.URL, s.processes.list(func(p *resource.Process) bool {
+		return processAppGUID(p) == guid && types.matches(p.Type)
+	}))
+}
+
+func (s *Server) getProcess(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.processes.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Process")
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) getAppProcess(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p := s.appProcess(params["guid"], params["type"])
+	if p == nil {
+		writeNotFound(w, "Process")
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) updateProcess(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	p, ok := s.processes.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Process")
+		return
+	}
+	var r resource.ProcessUpdate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Command != nil {
+		p.Command = r.Command
+	}
+	if r.HealthCheck != nil {
+		p.HealthCheck = *r.HealthCheck
+	}
+	if r.Metadata != nil {
+		p.Metadata = r.Metadata
+	}
+	touch(&p.Resource)
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) scaleProcess(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	p, ok := s.processes.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Process")
+		return
+	}
+	s.scale(w, req, p)
+}
+
+func (s *Server) scaleAppProcess(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	p := s.appProcess(params["guid"], params["type"])
+	if p == nil {
+		writeNotFound(w, "Process")
+		return
+	}
+	s.scale(w, req, p)
+}
+
+func (s *Server) scale(w http.ResponseWriter, req *http.Request, p *resource.Process) {
+	var r resource.ProcessScale
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Instances != nil {
+		if *r.Instances < 0 {
+			writeUnprocessable(w, "Instances must be greater than or equal to 0")
+			return
+		}
+		p.Instances = *r.Instances
+	}
+	if r.MemoryInMB != nil {
+		p.MemoryInMB = *r.MemoryInMB
+	}
+	if r.DiskInMB != nil {
+		p.DiskInMB = *r.DiskInMB
+	}
+	if r.LogRateLimitInBytesPerSecond != nil {
+		p.LogRateLimitInBytesPerSecond = *r.LogRateLimitInBytesPerSecond
+	}
+	touch(&p.Resource)
+	writeJSON(w, http.StatusAccepted, p)
+}
+
+func (s *Server) getProcessStats(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.processes.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Process")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.processStats(p))
+}
+
+func (s *Server) getAppProcessStats(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p := s.appProcess(params["guid"], params["type"])
+	if p == nil {
+		writeNotFound(w, "Process")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.processStats(p))
+}
+
+// processStats reports every instance of a started app as RUNNING, or CRASHED if CrashInstances was called, and
+// instances of a stopped app as DOWN
+func (s *Server) processStats(p *resource.Process) resource.ProcessStats {
+	appGUID := processAppGUID(p)
+	state := "DOWN"
+	var details *string
+	if app, ok := s.apps.get(appGUID); ok && app.State == appStarted {
+		state = "RUNNING"
+		if d, crashed := s.crashes[appGUID]; crashed {
+			state = "CRASHED"
+			details = &d
+		}
+	}
+	stats := resource.ProcessStats{Stats: []resource.ProcessStat{}}
+	for i := 0; i < p.Instances; i++ {
+		stats.Stats = append(stats.Stats, resource.ProcessStat{
+			Type:                p.Type,
+			Index:               i,
+			State:               state,
+			Usage:               resource.Usage{Time: time.Now().UTC().Truncate(time.Second)},
+			Host:                "10.0.0." + strconv.Itoa(i+1),
+			MemoryQuota:         p.MemoryInMB * 1024 * 1024,
+			DiskQuota:           p.DiskInMB * 1024 * 1024,
+			FileDescriptorQuota: 16384,
+			Details:             details,
+		})
+	}
+	return stats
+}
+
+func (s *Server) appProcess(appGUID, processType string) *resource.Process {
+	processes := s.processes.list(func(p *resource.Process) bool {
+		return processAppGUID(p) == appGUID && p.Type == processType
+	})
+	if len(processes) == 0 {
+		return nil
+	}
+	return processes[0]
+}
+
+func (s *Server) addProcess(appGUID, processType string, instances int) *resource.Process {
+	timeout := 60
+	p := &resource.Process{
+		Type:                         processType,
+		Instances:                    instances,
+		MemoryInMB:                   1024,
+		DiskInMB:                     1024,
+		LogRateLimitInBytesPerSecond: -1,
+		HealthCheck: resource.ProcessHealthCheck{
+			Type: "port",
+			Data: resource.ProcessData{Timeout: &timeout},
+		},
+		Relationships: resource.ProcessRelationships{
+			App: toOne(appGUID),
+		},
+		Resource: s.newResource("/v3/processes"),
+	}
+	if processType != "web" {
+		p.HealthCheck.Type = "process"
+	}
+	s.processes.add(p.GUID, p)
+	return p
+}
+
+func processAppGUID(p *resource.Process) string {
+	return relationshipGUID(&p.Relationships.App)
+}
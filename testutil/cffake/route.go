@@ -0,0 +1,334 @@
+package cffake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+const defaultAppPort = 8080
+
+func (s *Server) registerDomainRoutes() {
+	s.handle(http.MethodPost, "/v3/domains", s.createDomain)
+	s.handle(http.MethodGet, "/v3/domains", s.listDomains)
+	s.handle(http.MethodGet, "/v3/domains/:guid", s.getDomain)
+}
+
+func (s *Server) registerRouteRoutes() {
+	s.handle(http.MethodPost, "/v3/routes", s.createRoute)
+	s.handle(http.MethodGet, "/v3/routes", s.listRoutes)
+	s.handle(http.MethodGet, "/v3/routes/:guid", s.getRoute)
+	s.handle(http.MethodPatch, "/v3/routes/:guid", s.updateRoute)
+	s.handle(http.MethodDelete, "/v3/routes/:guid", s.deleteRoute)
+	s.handle(http.MethodGet, "/v3/routes/:guid/destinations", s.getRouteDestinations)
+	s.handle(http.MethodPost, "/v3/routes/:guid/destinations", s.setRouteDestinations(false))
+	s.handle(http.MethodPatch, "/v3/routes/:guid/destinations", s.setRouteDestinations(true))
+	s.handle(http.MethodDelete, "/v3/routes/:guid/destinations/:destination_guid", s.removeRouteDestination)
+	s.handle(http.MethodGet, "/v3/apps/:guid/routes", s.listAppRoutes)
+}
+
+// createDomain creates a shared domain, or a private domain if it has an org relationship
+func (s *Server) createDomain(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r resource.DomainCreate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name == "" {
+		writeUnprocessable(w, "Name can't be blank")
+		return
+	}
+	if len(s.domains.list(func(d *resource.Domain) bool { return strings.EqualFold(d.Name, r.Name) })) > 0 {
+		writeUnprocessable(w, "The domain name \"%s\" is already in use", r.Name)
+		return
+	}
+	domain := &resource.Domain{
+		Name:               r.Name,
+		SupportedProtocols: []string{"http"},
+		Metadata:           r.Metadata,
+		Resource:           s.newResource("/v3/domains"),
+	}
+	if r.Internal != nil {
+		domain.Internal = *r.Internal
+	}
+	if r.Relationships != nil && r.Relationships.Organization != nil {
+		orgGUID := relationshipGUID(r.Relationships.Organization)
+		if _, ok := s.orgs.get(orgGUID); !ok {
+			writeUnprocessable(w, "Organization with guid '%s' does not exist or you do not have access to it.", orgGUID)
+			return
+		}
+		org := toOne(orgGUID)
+		domain.Relationships.Organization = &org
+	}
+	s.domains.add(domain.GUID, domain)
+	writeJSON(w, http.StatusCreated, domain)
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	names, guids, orgGUIDs := queryFilter(q, "names"), queryFilter(q, "guids"), queryFilter(q, "organization_guids")
+	writeList(w, req, s.URL, s.domains.list(func(d *resource.Domain) bool {
+		return names.matches(d.Name) && guids.matches(d.GUID) && orgGUIDs.matches(relationshipGUID(d.Relationships.Organization))
+	}))
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	domain, ok := s.domains.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Domain")
+		return
+	}
+	writeJSON(w, http.StatusOK, domain)
+}
+
+// createRoute creates a route in the space on a shared domain or a private domain of the space's org
+func (s *Server) createRoute(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r resource.RouteCreate
+	if !decode(w, req, &r) {
+		return
+	}
+	spaceGUID := relationshipGUID(&r.Relationships.Space)
+	if _, ok := s.spaces.get(spaceGUID); !ok {
+		writeUnprocessable(w, "Invalid space. Ensure that the space exists and you have access to it.")
+		return
+	}
+	domain, ok := s.domains.get(relationshipGUID(&r.Relationships.Domain))
+	if !ok {
+		writeUnprocessable(w, "Invalid domain. Ensure that the domain exists and you have access to it.")
+		return
+	}
+	if orgGUID := relationshipGUID(domain.Relationships.Organization); orgGUID != "" && orgGUID != s.spaceOrgGUID(spaceGUID) {
+		writeUnprocessable(w, "Invalid domain. Domain '%s' is not available in organization.", domain.Name)
+		return
+	}
+
+	var host, routePath string
+	if r.Host != nil {
+		host = *r.Host
+	}
+	if r.Path != nil {
+		routePath = *r.Path
+	}
+	if routePath != "" && !strings.HasPrefix(routePath, "/") {
+		writeUnprocessable(w, "Path must begin with /")
+		return
+	}
+	url := domain.Name + routePath
+	if host != "" {
+		url = host + "." + url
+	}
+	if len(s.routesByGUID.list(func(existing *resource.Route) bool { return existing.URL == url })) > 0 {
+		writeUnprocessable(w, "Route already exists for domain '%s'.", domain.Name)
+		return
+	}
+
+	route := &resource.Route{
+		Host:         host,
+		Path:         routePath,
+		URL:          url,
+		Protocol:     "http",
+		Port:         r.Port,
+		Destinations: []resource.RouteDestination{},
+		Metadata:     r.Metadata,
+		Relationships: resource.RouteRelationships{
+			Space:  toOne(spaceGUID),
+			Domain: toOne(domain.GUID),
+		},
+		Resource: s.newResource("/v3/routes"),
+	}
+	s.routesByGUID.add(route.GUID, route)
+	writeJSON(w, http.StatusCreated, route)
+}
+
+func (s *Server) listRoutes(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	writeList(w, req, s.URL, s.routesByGUID.list(s.routeFilter(req)))
+}
+
+func (s *Server) listAppRoutes(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	match := s.routeFilter(req)
+	writeList(w, req, s.URL, s.routesByGUID.list(func(r *resource.Route) bool {
+		return match(r) && hasAppDestination(r, guid)
+	}))
+}
+
+func (s *Server) routeFilter(req *http.Request) func(r *resource.Route) bool {
+	q := req.URL.Query()
+	guids, hosts, paths := queryFilter(q, "guids"), queryFilter(q, "hosts"), queryFilter(q, "paths")
+	spaceGUIDs, domainGUIDs := queryFilter(q, "space_guids"), queryFilter(q, "domain_guids")
+	orgGUIDs, appGUIDs := queryFilter(q, "organization_guids"), queryFilter(q, "app_guids")
+	return func(r *resource.Route) bool {
+		spaceGUID := relationshipGUID(&r.Relationships.Space)
+		return guids.matches(r.GUID) && hosts.matches(r.Host) && paths.matches(r.Path) &&
+			spaceGUIDs.matches(spaceGUID) && domainGUIDs.matches(relationshipGUID(&r.Relationships.Domain)) &&
+			orgGUIDs.matches(s.spaceOrgGUID(spaceGUID)) &&
+			(appGUIDs == nil || slices.ContainsFunc(appGUIDs, func(appGUID string) bool { return hasAppDestination(r, appGUID) }))
+	}
+}
+
+func (s *Server) getRoute(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	route, ok := s.routesByGUID.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Route")
+		return
+	}
+	writeJSON(w, http.StatusOK, route)
+}
+
+func (s *Server) updateRoute(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	route, ok := s.routesByGUID.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Route")
+		return
+	}
+	var r resource.RouteUpdate
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Metadata != nil {
+		route.Metadata = r.Metadata
+	}
+	touch(&route.Resource)
+	writeJSON(w, http.StatusOK, route)
+}
+
+func (s *Server) deleteRoute(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.routesByGUID.get(guid); !ok {
+		writeNotFound(w, "Route")
+		return
+	}
+	s.writeJob(w, "route.delete", func() *resource.CloudFoundryError {
+		s.routesByGUID.remove(guid)
+		return nil
+	})
+}
+
+func (s *Server) getRouteDestinations(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	route, ok := s.routesByGUID.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Route")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.routeDestinations(route))
+}
+
+// setRouteDestinations inserts the destinations into the route's, skipping any already mapped, or replaces them.
+// Every destination app must be in the route's space.
+func (s *Server) setRouteDestinations(replace bool) handlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, params map[string]string) {
+		route, ok := s.routesByGUID.get(params["guid"])
+		if !ok {
+			writeNotFound(w, "Route")
+			return
+		}
+		var r resource.RouteDestinationsInsertOrReplace
+		if !decode(w, req, &r) {
+			return
+		}
+		var destinations []resource.RouteDestination
+		if !replace {
+			destinations = route.Destinations
+		}
+		for _, d := range r.Destinations {
+			var appGUID string
+			if d.App.GUID != nil {
+				appGUID = *d.App.GUID
+			}
+			app, ok := s.apps.get(appGUID)
+			if !ok || appSpaceGUID(app) != relationshipGUID(&route.Relationships.Space) {
+				writeUnprocessable(w, "App(s) with guid(s) \"%s\" do not exist or you do not have access.", appGUID)
+				return
+			}
+			dest := newRouteDestination(appGUID, d)
+			if !slices.ContainsFunc(destinations, func(existing resource.RouteDestination) bool {
+				return sameDestination(existing, dest)
+			}) {
+				destinations = append(destinations, dest)
+			}
+		}
+		route.Destinations = destinations
+		touch(&route.Resource)
+		writeJSON(w, http.StatusOK, s.routeDestinations(route))
+	}
+}
+
+func (s *Server) removeRouteDestination(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	route, ok := s.routesByGUID.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Route")
+		return
+	}
+	n := len(route.Destinations)
+	route.Destinations = slices.DeleteFunc(route.Destinations, func(d resource.RouteDestination) bool {
+		return d.GUID != nil && *d.GUID == params["destination_guid"]
+	})
+	if len(route.Destinations) == n {
+		writeUnprocessable(w, "Unable to unmap route from destination. Ensure the route has a destination with this guid.")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) routeDestinations(route *resource.Route) resource.RouteDestinations {
+	destinations := make([]*resource.RouteDestination, len(route.Destinations))
+	for i := range route.Destinations {
+		destinations[i] = &route.Destinations[i]
+	}
+	return resource.RouteDestinations{
+		Destinations: destinations,
+		Links: map[string]resource.Link{
+			"self":  {Href: s.URL + "/v3/routes/" + route.GUID + "/destinations"},
+			"route": {Href: s.URL + "/v3/routes/" + route.GUID},
+		},
+	}
+}
+
+// newRouteDestination returns the destination with the CC defaults for anything not set
+func newRouteDestination(appGUID string, d *resource.RouteDestinationInsertOrReplace) resource.RouteDestination {
+	guid := strings.ToLower(testutil.RandomGUID())
+	processType := "web"
+	if d.App.Process != nil && d.App.Process.Type != "" {
+		processType = d.App.Process.Type
+	}
+	port, protocol := defaultAppPort, "http1"
+	if d.Port != nil {
+		port = *d.Port
+	}
+	if d.Protocol != nil {
+		protocol = *d.Protocol
+	}
+	return resource.RouteDestination{
+		GUID: &guid,
+		App: resource.RouteDestinationApp{
+			GUID:    &appGUID,
+			Process: &resource.RouteDestinationAppProcess{Type: processType},
+		},
+		Weight:   d.Weight,
+		Port:     &port,
+		Protocol: &protocol,
+	}
+}
+
+func sameDestination(a, b resource.RouteDestination) bool {
+	return *a.App.GUID == *b.App.GUID && a.App.Process.Type == b.App.Process.Type && *a.Port == *b.Port &&
+		*a.Protocol == *b.Protocol
+}
+
+func hasAppDestination(r *resource.Route, appGUID string) bool {
+	return slices.ContainsFunc(r.Destinations, func(d resource.RouteDestination) bool {
+		return d.App.GUID != nil && *d.App.GUID == appGUID
+	})
+}
+
+func removeAppDestinations(destinations []resource.RouteDestination, appGUID string) []resource.RouteDestination {
+	return slices.DeleteFunc(destinations, func(d resource.RouteDestination) bool {
+		return d.App.GUID != nil && *d.App.GUID == appGUID
+	})
+}
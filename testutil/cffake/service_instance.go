@@ -0,0 +1,234 @@
+package cffake
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+const (
+	managedServiceInstance      = "managed"
+	userProvidedServiceInstance = "user-provided"
+)
+
+// serviceInstanceRequest is the union of the managed and user-provided service instance create and update requests
+type serviceInstanceRequest struct {
+	Type            string                                 `json:"type"`
+	Name            *string                                `json:"name"`
+	Relationships   *resource.ServiceInstanceRelationships `json:"relationships"`
+	Metadata        *resource.Metadata                     `json:"metadata"`
+	Tags            []string                               `json:"tags"`
+	Credentials     *json.RawMessage                       `json:"credentials"`
+	SyslogDrainURL  *string                                `json:"syslog_drain_url"`
+	RouteServiceURL *string                                `json:"route_service_url"`
+}
+
+func (s *Server) registerServiceInstanceRoutes() {
+	s.handle(http.MethodPost, "/v3/service_instances", s.createServiceInstance)
+	s.handle(http.MethodGet, "/v3/service_instances", s.listServiceInstances)
+	s.handle(http.MethodGet, "/v3/service_instances/:guid", s.getServiceInstance)
+	s.handle(http.MethodPatch, "/v3/service_instances/:guid", s.updateServiceInstance)
+	s.handle(http.MethodDelete, "/v3/service_instances/:guid", s.deleteServiceInstance)
+	s.handle(http.MethodGet, "/v3/service_instances/:guid/credentials", s.getServiceInstanceCredentials)
+}
+
+// createServiceInstance creates a user-provided service instance straight away or provisions a managed service
+// instance with an async job
+func (s *Server) createServiceInstance(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r serviceInstanceRequest
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Type != managedServiceInstance && r.Type != userProvidedServiceInstance {
+		writeUnprocessable(w, "Type must be one of 'managed', 'user-provided'")
+		return
+	}
+	if r.Name == nil || *r.Name == "" {
+		writeUnprocessable(w, "Name can't be blank")
+		return
+	}
+	var spaceGUID, planGUID string
+	if r.Relationships != nil {
+		spaceGUID = relationshipGUID(r.Relationships.Space)
+		planGUID = relationshipGUID(r.Relationships.ServicePlan)
+	}
+	if _, ok := s.spaces.get(spaceGUID); !ok {
+		writeUnprocessable(w, "Invalid space. Ensure that the space exists and you have access to it.")
+		return
+	}
+	if r.Type == managedServiceInstance && planGUID == "" {
+		writeUnprocessable(w, "Relationships Service plan can't be blank")
+		return
+	}
+	if s.serviceInstanceNameTaken(spaceGUID, *r.Name) {
+		writeUnprocessable(w, "The service instance name is taken: %s.", *r.Name)
+		return
+	}
+
+	space := toOne(spaceGUID)
+	si := &resource.ServiceInstance{
+		Name:            *r.Name,
+		Tags:            r.Tags,
+		Type:            r.Type,
+		Relationships:   resource.ServiceInstanceRelationships{Space: &space},
+		Metadata:        r.Metadata,
+		SyslogDrainURL:  r.SyslogDrainURL,
+		RouteServiceURL: r.RouteServiceURL,
+		Resource:        s.newResource("/v3/service_instances"),
+	}
+	if si.Tags == nil {
+		si.Tags = []string{}
+	}
+	s.serviceInstances.add(si.GUID, si)
+
+	if r.Type == userProvidedServiceInstance {
+		si.LastOperation = lastOperation("create", "succeeded")
+		s.setCredentials(si.GUID, r.Credentials)
+		writeJSON(w, http.StatusCreated, si)
+		return
+	}
+	plan := toOne(planGUID)
+	si.Relationships.ServicePlan = &plan
+	si.LastOperation = lastOperation("create", "in progress")
+	s.writeJob(w, "service_instance.create", func() *resource.CloudFoundryError {
+		si.LastOperation = lastOperation("create", "succeeded")
+		return nil
+	})
+}
+
+func (s *Server) listServiceInstances(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	names, guids, spaceGUIDs := queryFilter(q, "names"), queryFilter(q, "guids"), queryFilter(q, "space_guids")
+	orgGUIDs, types := queryFilter(q, "organization_guids"), queryFilter(q, "type")
+	writeList(w, req, s.URL, s.serviceInstances.list(func(si *resource.ServiceInstance) bool {
+		spaceGUID := relationshipGUID(si.Relationships.Space)
+		return names.matches(si.Name) && guids.matches(si.GUID) && spaceGUIDs.matches(spaceGUID) &&
+			orgGUIDs.matches(s.spaceOrgGUID(spaceGUID)) && types.matches(si.Type)
+	}))
+}
+
+func (s *Server) getServiceInstance(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	si, ok := s.serviceInstances.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Service instance")
+		return
+	}
+	writeJSON(w, http.StatusOK, si)
+}
+
+// updateServiceInstance updates a user-provided service instance straight away or a managed service instance with
+// an async job
+func (s *Server) updateServiceInstance(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	si, ok := s.serviceInstances.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Service instance")
+		return
+	}
+	var r serviceInstanceRequest
+	if !decode(w, req, &r) {
+		return
+	}
+	if r.Name != nil && *r.Name != si.Name {
+		if s.serviceInstanceNameTaken(relationshipGUID(si.Relationships.Space), *r.Name) {
+			writeUnprocessable(w, "The service instance name is taken: %s.", *r.Name)
+			return
+		}
+	}
+	update := func() {
+		if r.Name != nil {
+			si.Name = *r.Name
+		}
+		if r.Tags != nil {
+			si.Tags = r.Tags
+		}
+		if r.Metadata != nil {
+			si.Metadata = r.Metadata
+		}
+		if r.Relationships != nil && r.Relationships.ServicePlan != nil {
+			si.Relationships.ServicePlan = r.Relationships.ServicePlan
+		}
+		if r.SyslogDrainURL != nil {
+			si.SyslogDrainURL = r.SyslogDrainURL
+		}
+		if r.RouteServiceURL != nil {
+			si.RouteServiceURL = r.RouteServiceURL
+		}
+		touch(&si.Resource)
+	}
+
+	if si.Type == userProvidedServiceInstance {
+		update()
+		if r.Credentials != nil {
+			s.setCredentials(si.GUID, r.Credentials)
+		}
+		si.LastOperation = lastOperation("update", "succeeded")
+		writeJSON(w, http.StatusOK, si)
+		return
+	}
+	si.LastOperation = lastOperation("update", "in progress")
+	s.writeJob(w, "service_instance.update", func() *resource.CloudFoundryError {
+		update()
+		si.LastOperation = lastOperation("update", "succeeded")
+		return nil
+	})
+}
+
+// deleteServiceInstance deletes a user-provided service instance straight away or deprovisions a managed service
+// instance with an async job
+func (s *Server) deleteServiceInstance(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	si, ok := s.serviceInstances.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Service instance")
+		return
+	}
+	if si.Type == userProvidedServiceInstance {
+		s.removeServiceInstance(si.GUID)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	si.LastOperation = lastOperation("delete", "in progress")
+	s.writeJob(w, "service_instance.delete", func() *resource.CloudFoundryError {
+		s.removeServiceInstance(si.GUID)
+		return nil
+	})
+}
+
+func (s *Server) getServiceInstanceCredentials(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	si, ok := s.serviceInstances.get(params["guid"])
+	if !ok || si.Type != userProvidedServiceInstance {
+		writeNotFound(w, "Service instance")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.credentials[si.GUID])
+}
+
+func (s *Server) setCredentials(guid string, credentials *json.RawMessage) {
+	if credentials == nil {
+		s.credentials[guid] = json.RawMessage(`{}`)
+		return
+	}
+	s.credentials[guid] = *credentials
+}
+
+func (s *Server) removeServiceInstance(guid string) {
+	delete(s.credentials, guid)
+	s.serviceInstances.remove(guid)
+}
+
+func (s *Server) serviceInstanceNameTaken(spaceGUID, name string) bool {
+	return len(s.serviceInstances.list(func(si *resource.ServiceInstance) bool {
+		return relationshipGUID(si.Relationships.Space) == spaceGUID && si.Name == name
+	})) > 0
+}
+
+func lastOperation(operation, state string) resource.LastOperation {
+	now := time.Now().UTC().Truncate(time.Second)
+	return resource.LastOperation{
+		Type:      operation,
+		State:     state,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
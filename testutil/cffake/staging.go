@@ -0,0 +1,338 @@
+package cffake
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+const maxUploadSize = 32 << 20
+
+func (s *Server) registerPackageRoutes() {
+	s.handle(http.MethodPost, "/v3/packages", s.createPackage)
+	s.handle(http.MethodGet, "/v3/packages", s.listPackages)
+	s.handle(http.MethodGet, "/v3/packages/:guid", s.getPackage)
+	s.handle(http.MethodDelete, "/v3/packages/:guid", s.deletePackage)
+	s.handle(http.MethodPost, "/v3/packages/:guid/upload", s.uploadPackage)
+	s.handle(http.MethodGet, "/v3/apps/:guid/packages", s.listAppPackages)
+}
+
+func (s *Server) registerBuildRoutes() {
+	s.handle(http.MethodPost, "/v3/builds", s.createBuild)
+	s.handle(http.MethodGet, "/v3/builds", s.listBuilds)
+	s.handle(http.MethodGet, "/v3/builds/:guid", s.getBuild)
+	s.handle(http.MethodGet, "/v3/apps/:guid/builds", s.listAppBuilds)
+}
+
+func (s *Server) registerDropletRoutes() {
+	s.handle(http.MethodGet, "/v3/droplets", s.listDroplets)
+	s.handle(http.MethodGet, "/v3/droplets/:guid", s.getDroplet)
+	s.handle(http.MethodDelete, "/v3/droplets/:guid", s.deleteDroplet)
+	s.handle(http.MethodGet, "/v3/apps/:guid/droplets", s.listAppDroplets)
+	s.handle(http.MethodGet, "/v3/packages/:guid/droplets", s.listPackageDroplets)
+}
+
+// createPackage creates a bits package awaiting upload or a docker package that's ready to stage
+func (s *Server) createPackage(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r resource.PackageCreate
+	if !decode(w, req, &r) {
+		return
+	}
+	appGUID := relationshipGUID(&r.Relationships.App)
+	if _, ok := s.apps.get(appGUID); !ok {
+		writeUnprocessable(w, "Invalid app. Ensure that the app exists and you have access to it.")
+		return
+	}
+	pkg := &resource.Package{
+		Type:          r.Type,
+		Relationships: resource.AppRelationship{App: toOne(appGUID)},
+		Metadata:      r.Metadata,
+		Resource:      s.newResource("/v3/packages"),
+	}
+	switch r.Type {
+	case "bits":
+		pkg.State = resource.PackageStateAwaitingUpload
+		pkg.DataRaw = json.RawMessage(`{"checksum":{"type":"sha256","value":null},"error":null}`)
+	case "docker":
+		if r.Data == nil || r.Data.Image == "" {
+			writeUnprocessable(w, "Image can't be blank")
+			return
+		}
+		pkg.State = resource.PackageStateReady
+		pkg.DataRaw, _ = json.Marshal(map[string]string{"image": r.Data.Image})
+	default:
+		writeUnprocessable(w, "Type must be one of 'bits', 'docker'")
+		return
+	}
+	s.packages.add(pkg.GUID, pkg)
+	writeJSON(w, http.StatusCreated, pkg)
+}
+
+func (s *Server) listPackages(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	guids, appGUIDs := queryFilter(q, "guids"), queryFilter(q, "app_guids")
+	states, types := queryFilter(q, "states"), queryFilter(q, "types")
+	writeList(w, req, s.URL, s.packages.list(func(p *resource.Package) bool {
+		return guids.matches(p.GUID) && appGUIDs.matches(relationshipGUID(&p.Relationships.App)) &&
+			states.matches(string(p.State)) && types.matches(p.Type)
+	}))
+}
+
+func (s *Server) listAppPackages(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	q := req.URL.Query()
+	states, types := queryFilter(q, "states"), queryFilter(q, "types")
+	writeList(w, req, s.URL, s.packages.list(func(p *resource.Package) bool {
+		return relationshipGUID(&p.Relationships.App) == guid && states.matches(string(p.State)) && types.matches(p.Type)
+	}))
+}
+
+func (s *Server) getPackage(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	pkg, ok := s.packages.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Package")
+		return
+	}
+	writeJSON(w, http.StatusOK, pkg)
+}
+
+func (s *Server) deletePackage(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.packages.get(guid); !ok {
+		writeNotFound(w, "Package")
+		return
+	}
+	s.writeJob(w, "package.delete", func() *resource.CloudFoundryError {
+		s.packages.remove(guid)
+		return nil
+	})
+}
+
+// uploadPackage accepts the multipart bits upload, with or without matched resources, and makes the package ready
+func (s *Server) uploadPackage(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	pkg, ok := s.packages.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Package")
+		return
+	}
+	if pkg.Type != "bits" {
+		writeUnprocessable(w, "Package type must be bits.")
+		return
+	}
+	if err := req.ParseMultipartForm(maxUploadSize); err != nil {
+		writeUnprocessable(w, "Upload must include either resources or bits")
+		return
+	}
+	hash := sha256.New()
+	file, _, err := req.FormFile("bits")
+	if err == nil {
+		_, err = io.Copy(hash, file)
+		_ = file.Close()
+	}
+	if err != nil && req.FormValue("resources") == "" {
+		writeUnprocessable(w, "Upload must include either resources or bits")
+		return
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	pkg.State = resource.PackageStateReady
+	pkg.DataRaw, _ = json.Marshal(map[string]any{
+		"checksum": map[string]string{"type": "sha256", "value": checksum},
+		"error":    nil,
+	})
+	touch(&pkg.Resource)
+	writeJSON(w, http.StatusOK, pkg)
+}
+
+// createBuild starts staging a ready package, the build finishes once it's been polled the async polls times
+func (s *Server) createBuild(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	var r resource.BuildCreate
+	if !decode(w, req, &r) {
+		return
+	}
+	pkg, ok := s.packages.get(r.Package.GUID)
+	if !ok {
+		writeUnprocessable(w, "Unable to use package. Ensure that the package exists and you have access to it.")
+		return
+	}
+	if pkg.State != resource.PackageStateReady {
+		writeUnprocessable(w, "Unable to stage package. Package must be in READY state.")
+		return
+	}
+	appGUID := relationshipGUID(&pkg.Relationships.App)
+	app, _ := s.apps.get(appGUID)
+	lifecycle := app.Lifecycle
+	if r.Lifecycle != nil {
+		lifecycle = *r.Lifecycle
+	}
+	if pkg.Type == "docker" {
+		lifecycle = resource.Lifecycle{Type: resource.LifecycleDocker.String()}
+	}
+	build := &resource.Build{
+		State:             resource.BuildStateStaging,
+		StagingMemoryInMB: 1024,
+		StagingDiskInMB:   1024,
+		Lifecycle:         lifecycle,
+		Package:           resource.Relationship{GUID: pkg.GUID},
+		CreatedBy:         resource.CreatedBy{GUID: "fake-user", Name: "fake-user"},
+		Relationships:     resource.AppRelationship{App: toOne(appGUID)},
+		Metadata:          r.Metadata,
+		Resource:          s.newResource("/v3/builds"),
+	}
+	if r.StagingMemoryInMB > 0 {
+		build.StagingMemoryInMB = r.StagingMemoryInMB
+	}
+	if r.StagingDiskInMB > 0 {
+		build.StagingDiskInMB = r.StagingDiskInMB
+	}
+	s.builds.add(build.GUID, build)
+	if s.asyncPolls == 0 {
+		s.finishBuild(build)
+	}
+	writeJSON(w, http.StatusCreated, build)
+}
+
+func (s *Server) getBuild(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	build, ok := s.builds.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Build")
+		return
+	}
+	if build.State == resource.BuildStateStaging {
+		s.buildPolls[build.GUID]++
+		if s.buildPolls[build.GUID] >= s.asyncPolls {
+			s.finishBuild(build)
+		}
+	}
+	writeJSON(w, http.StatusOK, build)
+}
+
+// finishBuild fails the build if FailStaging was called for the app, otherwise it stages a droplet
+func (s *Server) finishBuild(build *resource.Build) {
+	delete(s.buildPolls, build.GUID)
+	touch(&build.Resource)
+	appGUID := relationshipGUID(&build.Relationships.App)
+	if errMsg, ok := s.stagingErrors[appGUID]; ok {
+		build.State = resource.BuildStateFailed
+		build.Error = &errMsg
+		return
+	}
+
+	droplet := &resource.Droplet{
+		State:         resource.DropletState(resource.DropletStateStaged),
+		Lifecycle:     build.Lifecycle,
+		ProcessTypes:  map[string]string{"web": "./start"},
+		Relationships: resource.AppRelationship{App: toOne(appGUID)},
+		Resource:      s.newResource("/v3/droplets"),
+	}
+	if build.Lifecycle.Type == resource.LifecycleDocker.String() {
+		pkg, _ := s.packages.get(build.Package.GUID)
+		var docker resource.DockerPackage
+		_ = json.Unmarshal(pkg.DataRaw, &docker)
+		droplet.Image = &docker.Image
+		droplet.ProcessTypes = map[string]string{"web": ""}
+	} else {
+		sum := sha256.Sum256([]byte(droplet.GUID))
+		droplet.Checksum.Type = "sha256"
+		droplet.Checksum.Value = hex.EncodeToString(sum[:])
+		droplet.Stack = build.Lifecycle.BuildpackData.Stack
+		for _, bp := range build.Lifecycle.BuildpackData.Buildpacks {
+			droplet.Buildpacks = append(droplet.Buildpacks, resource.DetectedBuildpack{Name: bp, BuildpackName: bp})
+		}
+	}
+	s.droplets.add(droplet.GUID, droplet)
+	build.State = resource.BuildStateStaged
+	build.Droplet = &resource.Relationship{GUID: droplet.GUID}
+}
+
+func (s *Server) listBuilds(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	appGUIDs, packageGUIDs, states := queryFilter(q, "app_guids"), queryFilter(q, "package_guids"), queryFilter(q, "states")
+	writeList(w, req, s.URL, s.builds.list(func(b *resource.Build) bool {
+		return appGUIDs.matches(relationshipGUID(&b.Relationships.App)) && packageGUIDs.matches(b.Package.GUID) &&
+			states.matches(string(b.State))
+	}))
+}
+
+func (s *Server) listAppBuilds(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	states := queryFilter(req.URL.Query(), "states")
+	writeList(w, req, s.URL, s.builds.list(func(b *resource.Build) bool {
+		return relationshipGUID(&b.Relationships.App) == guid && states.matches(string(b.State))
+	}))
+}
+
+func (s *Server) listDroplets(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	q := req.URL.Query()
+	guids, appGUIDs, states := queryFilter(q, "guids"), queryFilter(q, "app_guids"), queryFilter(q, "states")
+	writeList(w, req, s.URL, s.droplets.list(func(d *resource.Droplet) bool {
+		return guids.matches(d.GUID) && appGUIDs.matches(relationshipGUID(&d.Relationships.App)) &&
+			states.matches(string(d.State))
+	}))
+}
+
+func (s *Server) listAppDroplets(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.apps.get(guid); !ok {
+		writeNotFound(w, "App")
+		return
+	}
+	states := queryFilter(req.URL.Query(), "states")
+	writeList(w, req, s.URL, s.droplets.list(func(d *resource.Droplet) bool {
+		return relationshipGUID(&d.Relationships.App) == guid && states.matches(string(d.State))
+	}))
+}
+
+// listPackageDroplets lists the droplets staged by builds of the package
+func (s *Server) listPackageDroplets(w http.ResponseWriter, req *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.packages.get(guid); !ok {
+		writeNotFound(w, "Package")
+		return
+	}
+	staged := make(map[string]bool)
+	for _, b := range s.builds.list(func(b *resource.Build) bool { return b.Package.GUID == guid && b.Droplet != nil }) {
+		staged[b.Droplet.GUID] = true
+	}
+	states := queryFilter(req.URL.Query(), "states")
+	writeList(w, req, s.URL, s.droplets.list(func(d *resource.Droplet) bool {
+		return staged[d.GUID] && states.matches(string(d.State))
+	}))
+}
+
+func (s *Server) getDroplet(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	droplet, ok := s.droplets.get(params["guid"])
+	if !ok {
+		writeNotFound(w, "Droplet")
+		return
+	}
+	writeJSON(w, http.StatusOK, droplet)
+}
+
+func (s *Server) deleteDroplet(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	guid := params["guid"]
+	if _, ok := s.droplets.get(guid); !ok {
+		writeNotFound(w, "Droplet")
+		return
+	}
+	s.writeJob(w, "droplet.delete", func() *resource.CloudFoundryError {
+		s.droplets.remove(guid)
+		for appGUID, dropletGUID := range s.currentDroplets {
+			if dropletGUID == guid {
+				delete(s.currentDroplets, appGUID)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,142 @@
+package cffake
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+const (
+	defaultPerPage = 50
+	maxPerPage     = 5000
+)
+
+// store holds one kind of resource in the order they were created
+type store[T any] struct {
+	items map[string]T
+	order []string
+}
+
+func newStore[T any]() *store[T] {
+	return &store[T]{
+		items: make(map[string]T),
+	}
+}
+
+func (s *store[T]) add(guid string, item T) {
+	if _, ok := s.items[guid]; !ok {
+		s.order = append(s.order, guid)
+	}
+	s.items[guid] = item
+}
+
+func (s *store[T]) get(guid string) (T, bool) {
+	item, ok := s.items[guid]
+	return item, ok
+}
+
+func (s *store[T]) remove(guid string) {
+	delete(s.items, guid)
+	s.order = slices.DeleteFunc(s.order, func(g string) bool { return g == guid })
+}
+
+// list returns the items match returns true for, or every item if match is nil
+func (s *store[T]) list(match func(T) bool) []T {
+	var items []T
+	for _, guid := range s.order {
+		item := s.items[guid]
+		if match == nil || match(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// filter is a list query parameter that's a comma separated list of values to match e.g. names=a,b
+type filter []string
+
+func queryFilter(q url.Values, name string) filter {
+	v := q.Get(name)
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+// matches returns true if the filter wasn't specified or contains the value
+func (f filter) matches(value string) bool {
+	return f == nil || slices.Contains(f, value)
+}
+
+// writeList writes the page of items requested by the page and per_page query parameters with pagination links
+// that keep the other query parameters
+func writeList[T any](w http.ResponseWriter, req *http.Request, baseURL string, items []T) {
+	q := req.URL.Query()
+	page, perPage := 1, defaultPerPage
+	var err error
+	if v := q.Get("page"); v != "" {
+		if page, err = strconv.Atoi(v); err != nil || page < 1 {
+			writeBadQuery(w, "Page must be greater than 0")
+			return
+		}
+	}
+	if v := q.Get("per_page"); v != "" {
+		if perPage, err = strconv.Atoi(v); err != nil || perPage < 1 || perPage > maxPerPage {
+			writeBadQuery(w, "Per page must be between 1 and 5000")
+			return
+		}
+	}
+
+	totalPages := (len(items) + perPage - 1) / perPage
+	pageLink := func(p int) resource.Link {
+		q.Set("page", strconv.Itoa(p))
+		q.Set("per_page", strconv.Itoa(perPage))
+		return resource.Link{Href: baseURL + req.URL.Path + "?" + q.Encode()}
+	}
+	pagination := resource.Pagination{
+		TotalResults: len(items),
+		TotalPages:   totalPages,
+		First:        pageLink(1),
+		Last:         pageLink(max(totalPages, 1)),
+	}
+	if page < totalPages {
+		pagination.Next = pageLink(page + 1)
+	}
+	if page > 1 {
+		pagination.Previous = pageLink(page - 1)
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	resources := items[start:end]
+	if resources == nil {
+		resources = []T{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pagination": pagination,
+		"resources":  resources,
+	})
+}
+
+func writeBadQuery(w http.ResponseWriter, detail string) {
+	writeError(w, http.StatusBadRequest, resource.CloudFoundryError{
+		Code:   10005,
+		Title:  "CF-BadQueryParameter",
+		Detail: "The query parameter is invalid: " + detail,
+	})
+}
+
+func relationshipGUID(r *resource.ToOneRelationship) string {
+	if r == nil || r.Data == nil {
+		return ""
+	}
+	return r.Data.GUID
+}
+
+func toOne(guid string) resource.ToOneRelationship {
+	return resource.ToOneRelationship{Data: &resource.Relationship{GUID: guid}}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path"
 	"runtime"
+	"strconv"
 	"strings"
 	"text/template"
 )
@@ -221,6 +222,27 @@ func (o ObjectJSONGenerator) Job(state string) *JSONResource {
 	return o.renderTemplate(r, "job.json")
 }
 
+func (o ObjectJSONGenerator) LogCacheEnvelopes(sourceID string) *JSONResource {
+	r := &JSONResource{
+		GUID: sourceID,
+	}
+	return o.renderTemplate(r, "log_cache_envelopes.json")
+}
+
+func (o ObjectJSONGenerator) LogCacheInstantQuery(sourceID string) *JSONResource {
+	r := &JSONResource{
+		GUID: sourceID,
+	}
+	return o.renderTemplate(r, "log_cache_instant_query.json")
+}
+
+func (o ObjectJSONGenerator) LogCacheRangeQuery(sourceID string) *JSONResource {
+	r := &JSONResource{
+		GUID: sourceID,
+	}
+	return o.renderTemplate(r, "log_cache_range_query.json")
+}
+
 func (o ObjectJSONGenerator) Manifest() *JSONResource {
 	r := &JSONResource{}
 	return o.renderTemplate(r, "manifest.yml")
@@ -228,7 +250,7 @@ func (o ObjectJSONGenerator) Manifest() *JSONResource {
 
 func (o ObjectJSONGenerator) ManifestDiff() *JSONResource {
 	r := &JSONResource{}
-	return o.renderTemplate(r, "manifest_diff.yml")
+	return o.renderTemplate(r, "manifest_diff.json")
 }
 
 func (o ObjectJSONGenerator) Organization() *JSONResource {
@@ -321,6 +343,17 @@ func (o ObjectJSONGenerator) RouteDestinationWithLinks() *JSONResource {
 	return o.renderTemplate(r, "route_destination_with_links.json")
 }
 
+func (o ObjectJSONGenerator) RouterGroup(reservablePorts string) *JSONResource {
+	r := &JSONResource{
+		GUID: RandomGUID(),
+		Name: RandomName(),
+		Params: map[string]string{
+			"reservable_ports": reservablePorts,
+		},
+	}
+	return o.renderTemplate(r, "router_group.json")
+}
+
 func (o ObjectJSONGenerator) ServiceBroker() *JSONResource {
 	r := &JSONResource{
 		GUID: RandomGUID(),
@@ -450,6 +483,16 @@ func (o ObjectJSONGenerator) Task() *JSONResource {
 	return o.renderTemplate(r, "task.json")
 }
 
+func (o ObjectJSONGenerator) TCPRoute(routerGroupGUID string, port int) *JSONResource {
+	r := &JSONResource{
+		GUID: routerGroupGUID,
+		Params: map[string]string{
+			"port": strconv.Itoa(port),
+		},
+	}
+	return o.renderTemplate(r, "tcp_route.json")
+}
+
 func (o ObjectJSONGenerator) User() *JSONResource {
 	r := &JSONResource{
 		GUID: RandomGUID(),
@@ -0,0 +1,150 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// PolicyServerPath is the base path of the fake policy server's external API
+const PolicyServerPath = "/networking/v1/external"
+
+var fakePolicyServer *FakePolicyServer
+
+// FakePolicyServer is an in memory container networking policy server
+type FakePolicyServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	policies []*resource.NetworkPolicy
+}
+
+// SetupFakePolicyServer starts a fake policy server with the initial policies, the fake API root links to it
+// as network_policy_v1 until Teardown
+func SetupFakePolicyServer(policies ...*resource.NetworkPolicy) *FakePolicyServer {
+	s := &FakePolicyServer{
+		policies: policies,
+	}
+	policyMux := http.NewServeMux()
+	policyMux.HandleFunc(PolicyServerPath+"/policies", s.handlePolicies)
+	policyMux.HandleFunc(PolicyServerPath+"/policies/delete", s.handleDelete)
+	s.Server = httptest.NewServer(policyMux)
+	fakePolicyServer = s
+	return s
+}
+
+// Policies returns a copy of the policies currently stored
+func (s *FakePolicyServer) Policies() []*resource.NetworkPolicy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	policies := make([]*resource.NetworkPolicy, len(s.policies))
+	for i, p := range s.policies {
+		cp := *p
+		policies[i] = &cp
+	}
+	return policies
+}
+
+func (s *FakePolicyServer) handlePolicies(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		s.list(w, req)
+	case http.MethodPost:
+		policies, ok := decodePolicies(w, req)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		for _, p := range policies {
+			if !slices.ContainsFunc(s.policies, func(existing *resource.NetworkPolicy) bool { return *existing == *p }) {
+				s.policies = append(s.policies, p)
+			}
+		}
+		s.mu.Unlock()
+		writePolicyServerJSON(w, http.StatusOK, map[string]any{})
+	default:
+		writePolicyServerError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *FakePolicyServer) handleDelete(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		writePolicyServerError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	policies, ok := decodePolicies(w, req)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	s.policies = slices.DeleteFunc(s.policies, func(existing *resource.NetworkPolicy) bool {
+		return slices.ContainsFunc(policies, func(p *resource.NetworkPolicy) bool { return *existing == *p })
+	})
+	s.mu.Unlock()
+	writePolicyServerJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *FakePolicyServer) list(w http.ResponseWriter, req *http.Request) {
+	var ids []string
+	if id := req.URL.Query().Get("id"); id != "" {
+		ids = strings.Split(id, ",")
+	}
+	s.mu.Lock()
+	list := resource.NetworkPolicyList{
+		Policies: []*resource.NetworkPolicy{},
+	}
+	for _, p := range s.policies {
+		if len(ids) == 0 || slices.Contains(ids, p.Source.ID) || slices.Contains(ids, p.Destination.ID) {
+			list.Policies = append(list.Policies, p)
+		}
+	}
+	s.mu.Unlock()
+	list.TotalPolicies = len(list.Policies)
+	writePolicyServerJSON(w, http.StatusOK, list)
+}
+
+// decodePolicies decodes and validates the request policies the same as the policy server, writing an error
+// response if invalid
+func decodePolicies(w http.ResponseWriter, req *http.Request) ([]*resource.NetworkPolicy, bool) {
+	var body resource.NetworkPolicies
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writePolicyServerError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return nil, false
+	}
+	if len(body.Policies) == 0 {
+		writePolicyServerError(w, http.StatusBadRequest, "missing policies")
+		return nil, false
+	}
+	for _, p := range body.Policies {
+		var msg string
+		switch {
+		case p.Source.ID == "" || p.Destination.ID == "":
+			msg = "missing source or destination id"
+		case p.Destination.Protocol != resource.NetworkPolicyProtocolTCP && p.Destination.Protocol != resource.NetworkPolicyProtocolUDP:
+			msg = fmt.Sprintf("invalid destination protocol %q, specify either tcp or udp", p.Destination.Protocol)
+		case p.Destination.Ports.Start < 1 || p.Destination.Ports.End > 65535 || p.Destination.Ports.Start > p.Destination.Ports.End:
+			msg = fmt.Sprintf("invalid destination port range %d-%d", p.Destination.Ports.Start, p.Destination.Ports.End)
+		}
+		if msg != "" {
+			writePolicyServerError(w, http.StatusBadRequest, msg)
+			return nil, false
+		}
+	}
+	return body.Policies, true
+}
+
+func writePolicyServerError(w http.ResponseWriter, status int, msg string) {
+	writePolicyServerJSON(w, status, map[string]string{"error": msg})
+}
+
+func writePolicyServerJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -4,19 +4,46 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"go/format"
-	"io/ioutil"
+	"io"
 	"log"
 	"net/http"
+	"os"
 	"sort"
 	"strings"
 	"text/template"
-	"time"
 
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// commitsURL returns the latest cloud_controller_ng commit that changed the error definitions
+	commitsURL = "https://api.github.com/repos/cloudfoundry/cloud_controller_ng/commits?path=errors/v2.yml&per_page=1"
+
+	// errorsURL is the error definitions at a cloud_controller_ng commit
+	errorsURL = "https://raw.githubusercontent.com/cloudfoundry/cloud_controller_ng/%s/errors/v2.yml"
+
+	// commitPrefix starts the definitions file comment recording the upstream commit they were vendored from
+	commitPrefix = "# upstream commit: "
+)
+
+// header is written to the start of the downloaded definitions file
+const header = `# Cloud Foundry error definitions vendored from
+# https://github.com/cloudfoundry/cloud_controller_ng/blob/%[1]s/errors/v2.yml
+# and used by tools/gen_error.go to generate resource/error_cf.go. The V3 API
+# reports the same errors. Run "go run ../tools/gen_error.go -download" from the
+# resource directory to update this file to the latest upstream commit, or add
+# "-commit <sha>" to pin it to a given commit, and regenerate the code.
+#
+` + commitPrefix + `%[1]s
+
+`
+
 type (
 	CFCode   int
 	HTTPCode int
@@ -29,16 +56,22 @@ type Definition struct {
 	Message  string `yaml:"message"`
 }
 
+// gen_error generates the Cloud Foundry error constructors, predicates, lookup table and their tests from the
+// vendored error definitions. It's run from the resource directory.
 func main() {
 	log.SetFlags(log.Lshortfile)
-	const url = "https://raw.githubusercontent.com/cloudfoundry/cloud_controller_ng/master/errors/v2.yml"
-	resp, err := http.Get(url)
-	if err != nil {
-		log.Fatal(err)
+	file := flag.String("file", "../tools/errors_v2.yml", "the Cloud Foundry error definitions file")
+	download := flag.Bool("download", false, "update the definitions file from the latest cloud_controller_ng commit first")
+	commit := flag.String("commit", "", "the cloud_controller_ng commit to download the definitions from instead of the latest")
+	flag.Parse()
+
+	if *download {
+		if err := downloadDefinitions(*file, *commit); err != nil {
+			log.Fatal(err)
+		}
 	}
-	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := os.ReadFile(*file)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -60,15 +93,85 @@ func main() {
 		return definitions[i].CFCode < definitions[j].CFCode
 	})
 
+	data := templateData{
+		Commit:      upstreamCommit(body),
+		Definitions: definitions,
+	}
+	generate("error_cf.go", packageTemplate, data)
+	generate("error_cf_test.go", testTemplate, data)
+}
+
+type templateData struct {
+	Commit      string
+	Definitions []Definition
+}
+
+// downloadDefinitions replaces the definitions file with the definitions of the Cloud Controller commit, or the
+// latest commit that changed them if none is given, recording the commit SHA in the file's header
+func downloadDefinitions(file, sha string) error {
+	if sha == "" {
+		var err error
+		if sha, err = latestCommit(); err != nil {
+			return err
+		}
+	}
+
+	body, err := get(fmt.Sprintf(errorsURL, sha))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append([]byte(fmt.Sprintf(header, sha)), body...), 0600)
+}
+
+// latestCommit returns the SHA of the latest Cloud Controller commit that changed the error definitions
+func latestCommit() (string, error) {
+	var commits []struct {
+		SHA string `json:"sha"`
+	}
+	body, err := get(commitsURL)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(body, &commits); err != nil {
+		return "", fmt.Errorf("error parsing %s: %w", commitsURL, err)
+	}
+	if len(commits) == 0 || commits[0].SHA == "" {
+		return "", fmt.Errorf("no commits returned by %s", commitsURL)
+	}
+	return commits[0].SHA, nil
+}
+
+func get(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error getting %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// upstreamCommit returns the upstream commit recorded in the comment header of the definitions file
+func upstreamCommit(definitions []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(definitions))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		if commit, ok := strings.CutPrefix(line, commitPrefix); ok {
+			return strings.TrimSpace(commit)
+		}
+	}
+	return "unknown"
+}
+
+func generate(path string, tmpl *template.Template, data templateData) {
 	buf := &bytes.Buffer{}
 
-	if err := packageTemplate.Execute(buf, struct {
-		Timestamp   time.Time
-		Definitions []Definition
-	}{
-		Timestamp:   time.Now(),
-		Definitions: definitions,
-	}); err != nil {
+	if err := tmpl.Execute(buf, data); err != nil {
 		log.Fatal(err)
 	}
 
@@ -78,7 +181,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	if err := ioutil.WriteFile("../resource/error_cf.go", dst, 0600); err != nil {
+	if err := os.WriteFile(path, dst, 0600); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -98,15 +201,15 @@ func cleanMessage(s string) string {
 	return s
 }
 
-var packageTemplate = template.Must(template.New("").Funcs(template.FuncMap{
+var funcs = template.FuncMap{
 	"cleanGoName":  cleanGoName,
 	"cleanMessage": cleanMessage,
-}).Parse(`
-package resource
+}
 
-// Code generated by go generate. DO NOT EDIT.
-// This file was generated by robots at
-// {{ .Timestamp }}
+var packageTemplate = template.Must(template.New("").Funcs(funcs).Parse(`// Code generated by go generate. DO NOT EDIT.
+// This file was generated by robots from tools/errors_v2.yml, cloud_controller_ng commit {{ .Commit }}
+
+package resource
 
 import (
 	"errors"
@@ -121,7 +224,7 @@ func {{ $newMethod }}() CloudFoundryError {
 	return CloudFoundryError{
 		Code: {{ .CFCode }},
 		Title: "CF-{{ .Name }}",
-		Detail: "{{ .Message | cleanMessage }}",
+		Detail: {{ .Message | cleanMessage | printf "%q" }},
 	}
 }
 
@@ -139,8 +242,58 @@ func Is{{ .Name | cleanGoName }}Error(err error) bool {
 }
 {{- end }}
 
+// knownErrors are the known Cloud Foundry errors by code
+var knownErrors = map[int]knownError{
+{{- range .Definitions }}
+	{{ .CFCode }}: {title: "CF-{{ .Name }}", httpStatus: {{ .HTTPCode }}, detail: {{ .Message | cleanMessage | printf "%q" }}},
+{{- end }}
+}
+
 func cloudFoundryError(err error) (cferr CloudFoundryError, ok bool) {
 	ok = errors.As(err, &cferr)
 	return cferr, ok
 }
 `))
+
+var testTemplate = template.Must(template.New("").Funcs(funcs).Parse(`// Code generated by go generate. DO NOT EDIT.
+// This file was generated by robots from tools/errors_v2.yml, cloud_controller_ng commit {{ .Commit }}
+
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		newError   func() CloudFoundryError
+		isError    func(error) bool
+		code       int
+		httpStatus int
+	}{
+{{- range .Definitions }}
+		{"{{ .Name }}", New{{ .Name | cleanGoName }}Error, Is{{ .Name | cleanGoName }}Error, {{ .CFCode }}, {{ .HTTPCode }}},
+{{- end }}
+	}
+	require.Len(t, knownErrors, len(tests))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.newError()
+			require.Equal(t, tt.code, err.Code)
+			require.Equal(t, "CF-"+tt.name, err.Title)
+			require.Equal(t, tt.httpStatus, err.HTTPStatus())
+			require.True(t, tt.isError(err))
+			require.True(t, tt.isError(fmt.Errorf("wrapped: %w", err)))
+			require.False(t, tt.isError(CloudFoundryError{Code: -1}))
+
+			found, ok := LookupError(tt.code)
+			require.True(t, ok)
+			require.Equal(t, err, found)
+		})
+	}
+}
+`))
@@ -0,0 +1,515 @@
+//go:build tools
+// +build tools
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	clientImportPath = "github.com/cloudfoundry-community/go-cfclient/v3/client"
+	mockImportPath   = "github.com/stretchr/testify/mock"
+)
+
+// Service is a sub-client of client.Client and the interface generated from its exported methods
+type Service struct {
+	Field   string // Client struct field e.g. Applications
+	Client  string // concrete client type e.g. AppClient
+	Name    string // interface name e.g. AppService
+	Methods []*Method
+}
+
+type Method struct {
+	Name    string
+	Doc     []string
+	Params  []Param
+	Results []string
+}
+
+type Param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// gen_mocks generates the service interfaces implemented by each client.Client sub-client, the ClientInterface
+// aggregating them and testify mocks for all of them in client/mocks. It's run from the client directory.
+func main() {
+	log.SetFlags(log.Lshortfile)
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != "interfaces.go"
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, ok := pkgs["client"]
+	if !ok {
+		log.Fatal("client package not found, gen_mocks must be run from the client directory")
+	}
+
+	var fileNames []string
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	// the package types used to qualify identifiers in the mocks, the imports used by method signatures and
+	// the sub-clients which are all declared as commonClient
+	types := map[string]bool{}
+	imports := map[string]string{}
+	clients := map[string]*Service{}
+	var clientStruct *ast.StructType
+	for _, name := range fileNames {
+		f := pkg.Files[name]
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			pkgName := packageName(path)
+			if imp.Name != nil {
+				pkgName = imp.Name.Name
+			}
+			imports[pkgName] = path
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				types[ts.Name.Name] = true
+				if ident, ok := ts.Type.(*ast.Ident); ok && ident.Name == "commonClient" {
+					clients[ts.Name.Name] = &Service{
+						Client: ts.Name.Name,
+						Name:   strings.TrimSuffix(ts.Name.Name, "Client") + "Service",
+					}
+				}
+				if st, ok := ts.Type.(*ast.StructType); ok && ts.Name.Name == "Client" {
+					clientStruct = st
+				}
+			}
+		}
+	}
+
+	var clientMethods []*Method
+	for _, name := range fileNames {
+		for _, decl := range pkg.Files[name].Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv := star.X.(*ast.Ident).Name
+			if s, ok := clients[recv]; ok {
+				s.Methods = append(s.Methods, newMethod(fset, fn))
+			} else if recv == "Client" {
+				clientMethods = append(clientMethods, newMethod(fset, fn))
+			}
+		}
+	}
+
+	var services []*Service
+	for _, field := range clientStruct.Fields.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok || len(field.Names) == 0 {
+			continue
+		}
+		ident, ok := star.X.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		s, ok := clients[ident.Name]
+		if !ok {
+			continue
+		}
+		s.Field = field.Names[0].Name
+		sort.Slice(s.Methods, func(i, j int) bool {
+			return s.Methods[i].Name < s.Methods[j].Name
+		})
+		services = append(services, s)
+	}
+	sort.Slice(clientMethods, func(i, j int) bool {
+		return clientMethods[i].Name < clientMethods[j].Name
+	})
+
+	var allMethods []*Method
+	for _, s := range services {
+		allMethods = append(allMethods, s.Methods...)
+	}
+	writeSource("interfaces.go", interfacesTemplate, map[string]any{
+		"Imports":       importLines(append(allMethods, clientMethods...), imports),
+		"Services":      services,
+		"ClientMethods": clientMethods,
+	})
+
+	qualified := func(ms []*Method) []*Method {
+		var q []*Method
+		for _, m := range ms {
+			q = append(q, m.qualify(types))
+		}
+		return q
+	}
+	imports["client"] = clientImportPath
+	imports["mock"] = mockImportPath
+	for _, s := range services {
+		methods := qualified(s.Methods)
+		writeSource(filepath.Join("mocks", snakeCase(s.Name)+".go"), mockTemplate, map[string]any{
+			"Imports":   importLines(methods, imports, "client", "mock"),
+			"Name":      s.Name,
+			"Interface": "client." + s.Name,
+			"Methods":   methods,
+		})
+	}
+
+	// the ClientInterface mock returns the service interfaces so the service mocks can be returned from it
+	serviceMethods := qualified(clientMethods)
+	for _, s := range services {
+		serviceMethods = append(serviceMethods, &Method{
+			Name:    s.Field + "Service",
+			Results: []string{"client." + s.Name},
+		})
+	}
+	sort.Slice(serviceMethods, func(i, j int) bool {
+		return serviceMethods[i].Name < serviceMethods[j].Name
+	})
+	writeSource(filepath.Join("mocks", "client_interface.go"), mockTemplate, map[string]any{
+		"Imports":   importLines(serviceMethods, imports, "client", "mock"),
+		"Name":      "ClientInterface",
+		"Interface": "client.ClientInterface",
+		"Methods":   serviceMethods,
+	})
+}
+
+func newMethod(fset *token.FileSet, fn *ast.FuncDecl) *Method {
+	m := &Method{
+		Name: fn.Name.Name,
+	}
+	if fn.Doc != nil {
+		for _, c := range fn.Doc.List {
+			m.Doc = append(m.Doc, c.Text)
+		}
+	}
+	for _, field := range fn.Type.Params.List {
+		p := Param{
+			Type: exprString(fset, field.Type),
+		}
+		if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+			p.Type = exprString(fset, ellipsis.Elt)
+			p.Variadic = true
+		}
+		if len(field.Names) == 0 {
+			p.Name = "p" + strconv.Itoa(len(m.Params))
+			m.Params = append(m.Params, p)
+			continue
+		}
+		for _, name := range field.Names {
+			p.Name = name.Name
+			if p.Name == "_" {
+				p.Name = "p" + strconv.Itoa(len(m.Params))
+			}
+			m.Params = append(m.Params, p)
+		}
+	}
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.Results = append(m.Results, exprString(fset, field.Type))
+			}
+		}
+	}
+	return m
+}
+
+// qualify returns a copy of the method with the client package types qualified for use outside the package
+func (m *Method) qualify(types map[string]bool) *Method {
+	q := &Method{
+		Name: m.Name,
+	}
+	for _, p := range m.Params {
+		p.Type = qualifyType(p.Type, types)
+		q.Params = append(q.Params, p)
+	}
+	for _, r := range m.Results {
+		q.Results = append(q.Results, qualifyType(r, types))
+	}
+	return q
+}
+
+func qualifyType(s string, types map[string]bool) string {
+	expr, err := parser.ParseExpr(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var qualify func(n ast.Node) bool
+	qualify = func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.SelectorExpr:
+			return false
+		case *ast.Field:
+			ast.Inspect(x.Type, qualify)
+			return false
+		case *ast.Ident:
+			if types[x.Name] {
+				x.Name = "client." + x.Name
+			}
+		}
+		return true
+	}
+	ast.Inspect(expr, qualify)
+	return exprString(token.NewFileSet(), expr)
+}
+
+func (m *Method) Signature() string {
+	var params []string
+	for _, p := range m.Params {
+		if p.Variadic {
+			params = append(params, p.Name+" ..."+p.Type)
+		} else {
+			params = append(params, p.Name+" "+p.Type)
+		}
+	}
+	sig := m.Name + "(" + strings.Join(params, ", ") + ")"
+	switch len(m.Results) {
+	case 0:
+		return sig
+	case 1:
+		return sig + " " + m.Results[0]
+	default:
+		return sig + " (" + strings.Join(m.Results, ", ") + ")"
+	}
+}
+
+// Arguments returns the arguments passed to mock.Called, variadic parameters are passed as a single slice
+func (m *Method) Arguments() string {
+	var args []string
+	for _, p := range m.Params {
+		args = append(args, p.Name)
+	}
+	return strings.Join(args, ", ")
+}
+
+// Returns returns the statements that convert the mock.Called arguments to the method results
+func (m *Method) Returns() []string {
+	if len(m.Results) == 0 {
+		return nil
+	}
+	var stmts []string
+	var results []string
+	for i, r := range m.Results {
+		if r == "error" {
+			results = append(results, "ret.Error("+strconv.Itoa(i)+")")
+			continue
+		}
+		v := "r" + strconv.Itoa(i)
+		stmts = append(stmts, v+", _ := ret.Get("+strconv.Itoa(i)+").("+r+")")
+		results = append(results, v)
+	}
+	return append(stmts, "return "+strings.Join(results, ", "))
+}
+
+// importLines returns the import specs for the packages used by the method signatures and any extra packages
+func importLines(methods []*Method, imports map[string]string, extra ...string) []string {
+	used := map[string]bool{}
+	for _, name := range extra {
+		used[name] = true
+	}
+	collect := func(s string) {
+		expr, err := parser.ParseExpr(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok {
+					used[ident.Name] = true
+				}
+			}
+			return true
+		})
+	}
+	for _, m := range methods {
+		for _, p := range m.Params {
+			collect(p.Type)
+		}
+		for _, r := range m.Results {
+			collect(r)
+		}
+	}
+	var lines []string
+	for name := range used {
+		path, ok := imports[name]
+		if !ok {
+			log.Fatalf("no import found for package %s", name)
+		}
+		if packageName(path) == name {
+			lines = append(lines, strconv.Quote(path))
+		} else {
+			lines = append(lines, name+" "+strconv.Quote(path))
+		}
+	}
+	importPath := func(line string) string {
+		return strings.Trim(line[strings.Index(line, `"`):], `"`)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return importPath(lines[i]) < importPath(lines[j])
+	})
+
+	// standard library imports are grouped before the others
+	var std, other []string
+	for _, line := range lines {
+		if strings.Contains(strings.Split(importPath(line), "/")[0], ".") {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+	if len(std) > 0 && len(other) > 0 {
+		std = append(std, "")
+	}
+	return append(std, other...)
+}
+
+// packageName returns the default package name for an import path e.g. yaml for gopkg.in/yaml.v3
+func packageName(path string) string {
+	name := filepath.Base(path)
+	if strings.HasPrefix(name, "v") && strings.Trim(name[1:], "0123456789") == "" {
+		name = filepath.Base(filepath.Dir(path))
+	}
+	if i := strings.Index(name, ".v"); i > 0 {
+		name = name[:i]
+	}
+	return strings.ReplaceAll(name, "-", "")
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// snakeCase converts a Go name to snake case keeping acronyms together e.g. RoutingAPIService to routing_api_service
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func writeSource(path string, tmpl *template.Template, data any) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		log.Fatal(err)
+	}
+	dst, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Printf("%s", buf.Bytes())
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, dst, 0600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+var interfacesTemplate = template.Must(template.New("").Parse(`// Code generated by go generate. DO NOT EDIT.
+
+package client
+
+import (
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+// ClientInterface is the interface implemented by Client, it allows code that uses the client to be tested
+// with the mocks in the client/mocks package
+type ClientInterface interface {
+{{- range .ClientMethods }}
+{{- range .Doc }}
+	{{ . }}
+{{- end }}
+	{{ .Signature }}
+{{- end }}
+{{ range .Services }}
+	// {{ .Field }}Service returns the {{ .Name }} used to communicate with the {{ .Field }} endpoints
+	{{ .Field }}Service() {{ .Name }}
+{{- end }}
+}
+
+var _ ClientInterface = (*Client)(nil)
+{{ range .Services }}
+// {{ .Field }}Service returns the {{ .Name }} used to communicate with the {{ .Field }} endpoints
+func (c *Client) {{ .Field }}Service() {{ .Name }} {
+	return c.{{ .Field }}
+}
+{{ end }}
+{{- range .Services }}
+// {{ .Name }} is the interface implemented by {{ .Client }}
+type {{ .Name }} interface {
+{{- range .Methods }}
+{{- range .Doc }}
+	{{ . }}
+{{- end }}
+	{{ .Signature }}
+{{- end }}
+}
+
+var _ {{ .Name }} = (*{{ .Client }})(nil)
+{{ end }}
+`))
+
+var mockTemplate = template.Must(template.New("").Parse(`// Code generated by go generate. DO NOT EDIT.
+
+package mocks
+
+import (
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+// {{ .Name }} is a mock implementation of {{ .Interface }}
+type {{ .Name }} struct {
+	mock.Mock
+}
+
+var _ {{ .Interface }} = (*{{ .Name }})(nil)
+{{ range .Methods }}
+// {{ .Name }} provides a mock function for {{ $.Interface }}.{{ .Name }}
+func (m *{{ $.Name }}) {{ .Signature }} {
+	{{ if .Results }}ret := {{ end }}m.Called({{ .Arguments }})
+{{- range .Returns }}
+	{{ . }}
+{{- end }}
+}
+{{ end }}
+`))
@@ -0,0 +1,317 @@
+// Package uaa manages UAA users and group memberships using the CF client's UAA URL and OAuth token, which
+// needs the scim.read and scim.write scopes, and onboards new users to Cloud Foundry orgs and spaces.
+//
+//	u := uaa.New(cf)
+//	onboarded, err := u.OnboardUser(ctx, "jane@example.org", uaa.OriginUAA,
+//		map[string][]resource.OrganizationRoleType{orgGUID: {resource.OrganizationRoleUser}},
+//		map[string][]resource.SpaceRoleType{spaceGUID: {resource.SpaceRoleDeveloper}})
+package uaa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/ios"
+	"github.com/cloudfoundry-community/go-cfclient/v3/internal/path"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+)
+
+// OriginUAA is the origin of users stored in UAA, other origins are external identity providers like ldap
+const OriginUAA = "uaa"
+
+// ErrNotFound is returned when a UAA user or group isn't found
+var ErrNotFound = errors.New("not found")
+
+// User is a UAA SCIM user
+type User struct {
+	ID       string      `json:"id,omitempty"`
+	UserName string      `json:"userName"`
+	Origin   string      `json:"origin,omitempty"`
+	Name     *Name       `json:"name,omitempty"`
+	Emails   []Email     `json:"emails,omitempty"`
+	Active   *bool       `json:"active,omitempty"`
+	Verified *bool       `json:"verified,omitempty"`
+	Groups   []UserGroup `json:"groups,omitempty"`
+
+	// Password is only set when creating a user with the uaa origin
+	Password string `json:"password,omitempty"`
+}
+
+type Name struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+// UserGroup is a group the user is a member of, directly or indirectly
+type UserGroup struct {
+	Value   string `json:"value"`
+	Display string `json:"display"`
+	Type    string `json:"type"`
+}
+
+// Group is a UAA SCIM group, which is granted as a scope in the member's tokens
+type Group struct {
+	ID          string        `json:"id"`
+	DisplayName string        `json:"displayName"`
+	Description string        `json:"description,omitempty"`
+	Members     []GroupMember `json:"members,omitempty"`
+}
+
+type GroupMember struct {
+	Value  string `json:"value"`
+	Type   string `json:"type"`
+	Origin string `json:"origin"`
+}
+
+type passwordChange struct {
+	Password string `json:"password"`
+}
+
+type listResponse[T any] struct {
+	Resources    []*T `json:"resources"`
+	TotalResults int  `json:"totalResults"`
+}
+
+// OnboardedUser is the result of onboarding a user
+type OnboardedUser struct {
+	UAAUser *User
+	User    *resource.User
+	Roles   []*resource.Role
+}
+
+// Client for the UAA SCIM API
+type Client struct {
+	cf *client.Client
+}
+
+// New creates a new UAA client that authenticates using the CF client
+func New(cf *client.Client) *Client {
+	return &Client{
+		cf: cf,
+	}
+}
+
+// CreateUser creates the UAA user, the user is created with the uaa origin if none is set
+func (c *Client) CreateUser(ctx context.Context, user *User) (*User, error) {
+	if user.Origin == "" {
+		user.Origin = OriginUAA
+	}
+	var created User
+	if err := c.do(ctx, http.MethodPost, "/Users", user, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// DeleteUser deletes the UAA user, the CC user must be deleted separately
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, path.Format("/Users/%s", id), nil, nil)
+}
+
+// FindUser finds the UAA user by username and origin, ErrNotFound is returned if the user doesn't exist
+func (c *Client) FindUser(ctx context.Context, username, origin string) (*User, error) {
+	if origin == "" {
+		origin = OriginUAA
+	}
+	filter := fmt.Sprintf(`userName eq "%s" and origin eq "%s"`, escapeFilter(username), escapeFilter(origin))
+	users, err := find[User](ctx, c, "/Users", filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %s with origin %s %w", username, origin, ErrNotFound)
+	}
+	return users[0], nil
+}
+
+// GetUser gets the UAA user by ID, the UAA user ID is the same as the CC user GUID
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, path.Format("/Users/%s", id), nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// SetPassword sets the password of a user with the uaa origin without needing their current password
+func (c *Client) SetPassword(ctx context.Context, id, password string) error {
+	return c.do(ctx, http.MethodPut, path.Format("/Users/%s/password", id), &passwordChange{Password: password}, nil)
+}
+
+// AddGroupMember adds the user to the group
+func (c *Client) AddGroupMember(ctx context.Context, groupID, userID, origin string) error {
+	if origin == "" {
+		origin = OriginUAA
+	}
+	member := &GroupMember{
+		Value:  userID,
+		Type:   "USER",
+		Origin: origin,
+	}
+	return c.do(ctx, http.MethodPost, path.Format("/Groups/%s/members", groupID), member, nil)
+}
+
+// FindGroup finds the group by display name, e.g. cloud_controller.admin, ErrNotFound is returned if the group
+// doesn't exist
+func (c *Client) FindGroup(ctx context.Context, displayName string) (*Group, error) {
+	filter := fmt.Sprintf(`displayName eq "%s"`, escapeFilter(displayName))
+	groups, err := find[Group](ctx, c, "/Groups", filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("group %s %w", displayName, ErrNotFound)
+	}
+	return groups[0], nil
+}
+
+// RemoveGroupMember removes the user from the group
+func (c *Client) RemoveGroupMember(ctx context.Context, groupID, userID string) error {
+	return c.do(ctx, http.MethodDelete, path.Format("/Groups/%s/members/%s", groupID, userID), nil, nil)
+}
+
+// OnboardUser creates the UAA user, the matching CC user and assigns the org roles and then space roles, keyed by
+// org and space GUID. If any step fails everything already created is deleted and the error returned.
+//
+// Users with the uaa origin are created without a password, use SetPassword to set one. The UAA user must not
+// already exist.
+func (c *Client) OnboardUser(ctx context.Context, username, origin string,
+	orgRoles map[string][]resource.OrganizationRoleType,
+	spaceRoles map[string][]resource.SpaceRoleType) (*OnboardedUser, error) {
+	// UAA requires an email, like the CF CLI the username is used
+	uaaUser := &User{
+		UserName: username,
+		Origin:   origin,
+		Emails:   []Email{{Value: username, Primary: true}},
+	}
+	uaaUser, err := c.CreateUser(ctx, uaaUser)
+	if err != nil {
+		return nil, fmt.Errorf("error creating UAA user %s: %w", username, err)
+	}
+	onboarded := &OnboardedUser{
+		UAAUser: uaaUser,
+	}
+
+	err = func() error {
+		user, err := c.cf.Users.Create(ctx, &resource.UserCreate{GUID: uaaUser.ID})
+		if err != nil {
+			return fmt.Errorf("error creating user %s: %w", username, err)
+		}
+		onboarded.User = user
+
+		// the user must be an org member before they can be assigned a space role
+		for _, orgGUID := range sortedKeys(orgRoles) {
+			for _, roleType := range orgRoles[orgGUID] {
+				role, err := c.cf.Roles.CreateOrganizationRole(ctx, orgGUID, user.GUID, roleType)
+				if err != nil {
+					return fmt.Errorf("error assigning %s role in org %s: %w", roleType, orgGUID, err)
+				}
+				onboarded.Roles = append(onboarded.Roles, role)
+			}
+		}
+		for _, spaceGUID := range sortedKeys(spaceRoles) {
+			for _, roleType := range spaceRoles[spaceGUID] {
+				role, err := c.cf.Roles.CreateSpaceRole(ctx, spaceGUID, user.GUID, roleType)
+				if err != nil {
+					return fmt.Errorf("error assigning %s role in space %s: %w", roleType, spaceGUID, err)
+				}
+				onboarded.Roles = append(onboarded.Roles, role)
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		// roll back even if the onboarding failed because ctx was canceled or timed out
+		return nil, errors.Join(err, c.rollback(context.WithoutCancel(ctx), onboarded))
+	}
+	return onboarded, nil
+}
+
+// rollback deletes the partially onboarded user, deleting the CC user asynchronously deletes their roles so the
+// UAA user is only deleted once that's finished
+func (c *Client) rollback(ctx context.Context, onboarded *OnboardedUser) error {
+	var errs []error
+	if onboarded.User != nil {
+		jobGUID, err := c.cf.Users.Delete(ctx, onboarded.User.GUID)
+		if err == nil {
+			err = c.cf.Jobs.PollComplete(ctx, jobGUID, nil)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error rolling back user %s: %w", onboarded.User.GUID, err))
+		}
+	}
+	if err := c.DeleteUser(ctx, onboarded.UAAUser.ID); err != nil {
+		errs = append(errs, fmt.Errorf("error rolling back UAA user %s: %w", onboarded.UAAUser.ID, err))
+	}
+	return errors.Join(errs...)
+}
+
+func find[T any](ctx context.Context, c *Client, resourcePath, filter string) ([]*T, error) {
+	values := url.Values{}
+	values.Set("filter", filter)
+	var list listResponse[T]
+	if err := c.do(ctx, http.MethodGet, path.Format(resourcePath+"?%s", values), nil, &list); err != nil {
+		return nil, err
+	}
+	return list.Resources, nil
+}
+
+func (c *Client) do(ctx context.Context, method, resourcePath string, params, result any) error {
+	var body io.Reader
+	if params != nil {
+		b, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("error marshalling UAA request: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.cf.AuthURL(resourcePath), body)
+	if err != nil {
+		return fmt.Errorf("error creating UAA request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.cf.ExecuteAuthRequest(req)
+	if err != nil {
+		return fmt.Errorf("error executing %s request for %s: %w", method, req.URL.Path, err)
+	}
+	defer ios.Close(resp.Body)
+	if result == nil {
+		return nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("error decoding UAA response: %w", err)
+	}
+	return nil
+}
+
+// escapeFilter escapes a value used in a SCIM filter string
+func escapeFilter(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,278 @@
+package uaa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudfoundry-community/go-cfclient/v3/client"
+	"github.com/cloudfoundry-community/go-cfclient/v3/config"
+	"github.com/cloudfoundry-community/go-cfclient/v3/resource"
+	"github.com/cloudfoundry-community/go-cfclient/v3/testutil"
+)
+
+func TestUsersAndGroups(t *testing.T) {
+	scim := newFakeSCIM(t, "04c9b5c4-4f0e-4c6b-8d7e-0bb1f5d0c6a1")
+	scim.groups["admins-guid"] = &Group{ID: "admins-guid", DisplayName: "cloud_controller.admin"}
+	serverURL := testutil.SetupMultiple(nil, t)
+	defer testutil.Teardown()
+	u := New(newCFClient(t, serverURL, scim.URL))
+	ctx := context.Background()
+
+	user, err := u.CreateUser(ctx, &User{
+		UserName: "jane",
+		Emails:   []Email{{Value: "jane@example.org", Primary: true}},
+		Password: "secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "04c9b5c4-4f0e-4c6b-8d7e-0bb1f5d0c6a1", user.ID)
+	require.Equal(t, OriginUAA, user.Origin)
+	require.Empty(t, user.Password)
+
+	found, err := u.FindUser(ctx, "jane", "")
+	require.NoError(t, err)
+	require.Equal(t, user, found)
+	_, err = u.FindUser(ctx, "jane", "ldap")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	found, err = u.GetUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, user, found)
+
+	require.NoError(t, u.SetPassword(ctx, user.ID, "new-secret"))
+	require.Equal(t, "new-secret", scim.passwords[user.ID])
+
+	group, err := u.FindGroup(ctx, "cloud_controller.admin")
+	require.NoError(t, err)
+	require.NoError(t, u.AddGroupMember(ctx, group.ID, user.ID, ""))
+	require.Equal(t, []GroupMember{{Value: user.ID, Type: "USER", Origin: OriginUAA}}, scim.groups[group.ID].Members)
+	require.NoError(t, u.RemoveGroupMember(ctx, group.ID, user.ID))
+	require.Empty(t, scim.groups[group.ID].Members)
+	_, err = u.FindGroup(ctx, "doppler.firehose")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, u.DeleteUser(ctx, user.ID))
+	_, err = u.FindUser(ctx, "jane", OriginUAA)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestOnboardUser(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(14)
+	user := g.User()
+	orgRole := g.Role().JSON
+	spaceRole := g.Role().JSON
+	orgGUID := testutil.RandomGUID()
+	spaceGUID := testutil.RandomGUID()
+
+	scim := newFakeSCIM(t, user.GUID)
+	serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+		{
+			Method:   "POST",
+			Endpoint: "/v3/users",
+			Output:   g.Single(user.JSON),
+			Status:   http.StatusCreated,
+			PostForm: `{"guid":"` + user.GUID + `"}`,
+		},
+		{
+			Method:   "POST",
+			Endpoint: "/v3/roles",
+			Output:   []string{orgRole, spaceRole},
+			Status:   http.StatusCreated,
+		},
+	}, t)
+	defer testutil.Teardown()
+	u := New(newCFClient(t, serverURL, scim.URL))
+
+	onboarded, err := u.OnboardUser(context.Background(), "jane@example.org", "",
+		map[string][]resource.OrganizationRoleType{orgGUID: {resource.OrganizationRoleUser}},
+		map[string][]resource.SpaceRoleType{spaceGUID: {resource.SpaceRoleDeveloper}})
+	require.NoError(t, err)
+	require.Equal(t, user.GUID, onboarded.UAAUser.ID)
+	require.Equal(t, []Email{{Value: "jane@example.org", Primary: true}}, onboarded.UAAUser.Emails)
+	require.Equal(t, user.GUID, onboarded.User.GUID)
+	require.Len(t, onboarded.Roles, 2)
+	require.Contains(t, scim.users, user.GUID)
+}
+
+func TestOnboardUserRollback(t *testing.T) {
+	g := testutil.NewObjectJSONGenerator(14)
+	user := g.User()
+	orgGUID := testutil.RandomGUID()
+	spaceGUID := testutil.RandomGUID()
+
+	job := g.Job("COMPLETE")
+	onboard := func(t *testing.T, ctx context.Context, opts ...config.Option) (*fakeSCIM, error) {
+		scim := newFakeSCIM(t, user.GUID)
+		serverURL := testutil.SetupMultiple([]testutil.MockRoute{
+			{
+				Method:   "POST",
+				Endpoint: "/v3/users",
+				Output:   g.Single(user.JSON),
+				Status:   http.StatusCreated,
+			},
+			{
+				Method:   "POST",
+				Endpoint: "/v3/roles",
+				Output: []string{g.Role().JSON, `{"errors":[{"code":10008,"title":"CF-UnprocessableEntity",
+				"detail":"Users cannot be assigned roles in a space if they do not have a role in that space's organization."}]}`},
+				Statuses: []int{http.StatusCreated, http.StatusUnprocessableEntity},
+			},
+			{
+				Method:           "DELETE",
+				Endpoint:         "/v3/users/" + user.GUID,
+				Status:           http.StatusAccepted,
+				RedirectLocation: "https://api.example.org/api/v3/jobs/" + job.GUID,
+			},
+			{
+				Method:   "GET",
+				Endpoint: "/v3/jobs/" + job.GUID,
+				Output:   g.Single(job.JSON),
+				Status:   http.StatusOK,
+			},
+		}, t)
+		t.Cleanup(testutil.Teardown)
+		u := New(newCFClient(t, serverURL, scim.URL, opts...))
+
+		_, err := u.OnboardUser(ctx, "jane@example.org", OriginUAA,
+			map[string][]resource.OrganizationRoleType{orgGUID: {resource.OrganizationRoleUser}},
+			map[string][]resource.SpaceRoleType{spaceGUID: {resource.SpaceRoleDeveloper}})
+		return scim, err
+	}
+
+	t.Run("role assignment fails", func(t *testing.T) {
+		scim, err := onboard(t, context.Background())
+		require.ErrorContains(t, err, "error assigning space_developer role in space "+spaceGUID)
+		require.ErrorContains(t, err, "Users cannot be assigned roles in a space")
+		require.NotContains(t, err.Error(), "error rolling back")
+		require.Empty(t, scim.users)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cancelOnSpaceRole := func(ctx context.Context, call *config.Call, next config.Invoker) error {
+			if call.Operation == "Roles.CreateSpaceRole" {
+				cancel()
+			}
+			return next(ctx, call)
+		}
+		scim, err := onboard(t, ctx, config.Middleware(cancelOnSpaceRole))
+		require.ErrorIs(t, err, context.Canceled)
+		require.NotContains(t, err.Error(), "error rolling back")
+		require.Empty(t, scim.users)
+	})
+}
+
+func newCFClient(t *testing.T, serverURL, uaaURL string, opts ...config.Option) *client.Client {
+	opts = append([]config.Option{config.Token("", "fake-refresh-token"), config.AuthTokenURL(uaaURL, uaaURL)}, opts...)
+	cfg, err := config.New(serverURL, opts...)
+	require.NoError(t, err)
+	cf, err := client.New(cfg)
+	require.NoError(t, err)
+	return cf
+}
+
+// fakeSCIM is an in memory UAA that issues tokens and supports enough of the SCIM API to manage users and groups
+type fakeSCIM struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	nextID    string
+	users     map[string]*User
+	passwords map[string]string
+	groups    map[string]*Group
+}
+
+func newFakeSCIM(t *testing.T, nextID string) *fakeSCIM {
+	s := &fakeSCIM{
+		nextID:    nextID,
+		users:     map[string]*User{},
+		passwords: map[string]string{},
+		groups:    map[string]*Group{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeSCIM) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON := func(status int, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+	if r.URL.Path == "/oauth/token" {
+		writeJSON(http.StatusOK, map[string]any{
+			"token_type":    "bearer",
+			"access_token":  "scim-token",
+			"refresh_token": "fake-refresh-token",
+			"expires_in":    3600,
+		})
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer scim-token" {
+		writeJSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/Users":
+		var user User
+		_ = json.NewDecoder(r.Body).Decode(&user)
+		user.ID = s.nextID
+		s.passwords[user.ID] = user.Password
+		user.Password = ""
+		s.users[user.ID] = &user
+		writeJSON(http.StatusCreated, user)
+	case r.Method == http.MethodGet && r.URL.Path == "/Users":
+		var users []*User
+		for _, u := range s.users {
+			if r.URL.Query().Get("filter") == `userName eq "`+u.UserName+`" and origin eq "`+u.Origin+`"` {
+				users = append(users, u)
+			}
+		}
+		writeJSON(http.StatusOK, listResponse[User]{Resources: users, TotalResults: len(users)})
+	case r.Method == http.MethodGet && len(parts) == 2 && parts[0] == "Users":
+		if user, ok := s.users[parts[1]]; ok {
+			writeJSON(http.StatusOK, user)
+			return
+		}
+		writeJSON(http.StatusNotFound, map[string]string{"error": "scim_resource_not_found"})
+	case r.Method == http.MethodDelete && len(parts) == 2 && parts[0] == "Users":
+		user := s.users[parts[1]]
+		delete(s.users, parts[1])
+		writeJSON(http.StatusOK, user)
+	case r.Method == http.MethodPut && len(parts) == 3 && parts[2] == "password":
+		var change passwordChange
+		_ = json.NewDecoder(r.Body).Decode(&change)
+		s.passwords[parts[1]] = change.Password
+		writeJSON(http.StatusOK, map[string]string{"status": "ok", "message": "password updated"})
+	case r.Method == http.MethodGet && r.URL.Path == "/Groups":
+		var groups []*Group
+		for _, g := range s.groups {
+			if r.URL.Query().Get("filter") == `displayName eq "`+g.DisplayName+`"` {
+				groups = append(groups, g)
+			}
+		}
+		writeJSON(http.StatusOK, listResponse[Group]{Resources: groups, TotalResults: len(groups)})
+	case r.Method == http.MethodPost && len(parts) == 3 && parts[2] == "members":
+		var member GroupMember
+		_ = json.NewDecoder(r.Body).Decode(&member)
+		s.groups[parts[1]].Members = append(s.groups[parts[1]].Members, member)
+		writeJSON(http.StatusCreated, member)
+	case r.Method == http.MethodDelete && len(parts) == 4 && parts[2] == "members":
+		s.groups[parts[1]].Members = nil
+		writeJSON(http.StatusOK, map[string]string{"value": parts[3]})
+	default:
+		writeJSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+}